@@ -0,0 +1,4 @@
+data "genesyscloud_flows" "inbound-flows" {
+  name_regex = "^Inbound"
+  type       = "inboundcall"
+}
@@ -0,0 +1,5 @@
+data "genesyscloud_groups" "support-groups" {
+  name_regex = "^Support"
+  type       = "official"
+  visibility = "public"
+}
@@ -0,0 +1,12 @@
+data "genesyscloud_integration_action_test" "get_user_test" {
+  action_id = genesyscloud_integration_action.get_user.id
+  input = jsonencode({
+    "userId" = "example-user-id"
+  })
+  // Fail the plan if the action templates or JSON paths are broken
+  fail_on_error = true
+}
+
+output "resolved_request_url" {
+  value = data.genesyscloud_integration_action_test.get_user_test.resolved_request_url
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "genesyscloud_quality_forms_evaluation_versions" "example-evaluation-form-versions" {
+  form_id = genesyscloud_quality_forms_evaluation.example-evaluation-form.id
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "genesyscloud_quality_forms_survey_versions" "example-survey-form-versions" {
+  form_id = genesyscloud_quality_forms_survey.example-survey-form.id
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "genesyscloud_routing_queues" "sales-queues" {
+  name_regex  = "^Sales"
+  division_id = genesyscloud_auth_division.sales.id
+}
@@ -0,0 +1,3 @@
+data "genesyscloud_routing_skills" "language-skills" {
+  name_regex = "^Language - "
+}
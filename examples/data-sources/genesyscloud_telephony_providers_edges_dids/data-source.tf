@@ -0,0 +1,4 @@
+data "genesyscloud_telephony_providers_edges_dids" "free_numbers" {
+  did_pool_ids = [genesyscloud_telephony_providers_edges_did_pool.mypool.id]
+  type         = "UNASSIGNED"
+}
@@ -0,0 +1,4 @@
+data "genesyscloud_telephony_providers_edges_edge_physical_interface" "eth1" {
+  edge_id = genesyscloud_telephony_providers_edges_edge.edge.id
+  name    = "eth1"
+}
@@ -1,3 +1,14 @@
 data "genesyscloud_user" "user" {
   email = "user@example.com"
 }
+
+data "genesyscloud_user" "employee" {
+  employee_id = "E12345"
+}
+
+data "genesyscloud_user" "directory_user" {
+  external_id {
+    authority_name = "hr-system"
+    external_key   = "00012345"
+  }
+}
@@ -0,0 +1,4 @@
+data "genesyscloud_users" "inactive-users" {
+  name_regex = "Smith$"
+  state      = "inactive"
+}
@@ -0,0 +1,3 @@
+data "genesyscloud_webdeployments_configuration_versions" "exampleConfigurationVersions" {
+  configuration_id = genesyscloud_webdeployments_configuration.exampleConfiguration.id
+}
\ No newline at end of file
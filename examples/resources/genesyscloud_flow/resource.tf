@@ -28,4 +28,28 @@ resource "genesyscloud_flow" "flow" {
     greeting             = "Hello World"
     menu_disconnect_name = "Disconnect"
   }
-}
\ No newline at end of file
+}
+
+resource "genesyscloud_flow" "typed_substitutions_flow" {
+  filepath          = "the flow configuration file path"
+  file_content_hash = filesha256("the flow configuration file path")
+  // Example flow configuration using typed substitutions:
+  /*
+  inboundCall:
+    name: {{flow_name | json}}
+    defaultLanguage: {{languages.0}}
+    supportedLanguages: {{supported_languages}}
+    initialGreeting:
+      tts: {{greeting.text | json}}
+  */
+  substitutions_json = jsonencode({
+    flow_name = "An example flow"
+    languages = ["en-us", "es"]
+    supported_languages = {
+      en-us = { defaultLanguageSkill = { noValue = true } }
+    }
+    greeting = { text = "Hello \"World\"" }
+  })
+  // Fail the plan if a placeholder has no substitution or a substitution is unused
+  substitutions_strict = true
+}
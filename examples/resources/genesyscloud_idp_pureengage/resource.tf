@@ -0,0 +1,7 @@
+resource "genesyscloud_idp_pureengage" "pureengage" {
+  certificates             = ["MIIDgjCCAmoCCQCY7/3Fvy+CmDA..."]
+  issuer_uri               = "https://example.com"
+  target_uri               = "https://example.com/login"
+  relying_party_identifier = "unique-id-from-pureengage"
+  auto_provision_users     = true
+}
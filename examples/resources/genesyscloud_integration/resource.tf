@@ -16,4 +16,27 @@ resource "genesyscloud_integration" "integration" {
       basic_Auth = genesyscloud_integration_credential.example_cred.id
     }
   }
+}
+
+resource "genesyscloud_integration" "smtp_integration" {
+  intended_state   = "ENABLED"
+  integration_type = "custom-smtp-server"
+  config {
+    name = "example smtp integration"
+    properties = jsonencode({
+      "smtpHost" = "smtp.example.com"
+    })
+  }
+  // Credential created, bound to the config under its key and deleted with the integration
+  credential {
+    key                  = "basicAuth"
+    name                 = "example smtp credential"
+    credential_type_name = "basicAuth"
+    fields = {
+      userName = "someUserName"
+    }
+    fields_from_file = {
+      password = "${path.module}/smtp_password.txt"
+    }
+  }
 }
\ No newline at end of file
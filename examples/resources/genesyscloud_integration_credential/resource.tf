@@ -6,4 +6,16 @@ resource "genesyscloud_integration_credential" "credential" {
     userName = "someUserName"
     password = "$tr0ngP@s$w0rd"
   }
+}
+
+resource "genesyscloud_integration_credential" "credential_from_sources" {
+  name                 = "example-credential-from-sources"
+  credential_type_name = "basicAuth"
+  fields = {
+    userName = "someUserName"
+  }
+  // Secrets are read at plan and apply time and never stored in the state
+  fields_from_env = {
+    password = "SMTP_PASSWORD"
+  }
 }
\ No newline at end of file
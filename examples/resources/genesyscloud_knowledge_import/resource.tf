@@ -0,0 +1,9 @@
+resource "genesyscloud_knowledge_import" "example_import" {
+  knowledge_base_id       = genesyscloud_knowledge_knowledgebase.example_knowledgebase.id
+  filepath                = "${path.module}/articles.json"
+  file_content_hash       = filesha256("${path.module}/articles.json")
+  file_type               = "Json"
+  visible                 = true
+  label_ids               = [genesyscloud_knowledge_label.example_label.id]
+  fail_on_document_errors = true
+}
\ No newline at end of file
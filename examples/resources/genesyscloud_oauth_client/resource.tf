@@ -11,4 +11,11 @@ resource "genesyscloud_oauth_client" "example-client" {
     role_id     = genesyscloud_auth_role.employee.id
     division_id = genesyscloud_auth_division.testing.id
   }
+  rotation {
+    // Changing any value generates a new client_secret
+    rotate_when_changed = {
+      version = "1"
+    }
+    max_age_days = 90
+  }
 }
@@ -0,0 +1,38 @@
+resource "genesyscloud_outbound_digitalruleset" "digital_rule_set" {
+  name            = "Example Digital Rule Set"
+  contact_list_id = genesyscloud_outbound_contact_list.contact_list.id
+  rules {
+    name     = "Skip contacts with too many attempts"
+    order    = 0
+    category = "PreContact" // Possible values: PreContact, PostContact
+    conditions {
+      contact_column_condition_settings {
+        column_name = "attempts"
+        operator    = "GreaterThan"
+        value       = "3"
+        value_type  = "Numeric" // Possible values: String, Numeric, DateTime, Period
+      }
+    }
+    actions {
+      do_not_send = true
+    }
+  }
+  rules {
+    name     = "Count attempts"
+    order    = 1
+    category = "PostContact"
+    conditions {
+      last_result_overall_condition_settings {
+        email_wrapup_codes = [genesyscloud_routing_wrapupcode.no_answer.id]
+      }
+    }
+    actions {
+      update_contact_column_action_settings {
+        properties = {
+          attempts = "1"
+        }
+        update_option = "Increment" // Possible values: Set, Increment, Decrement, CurrentTime
+      }
+    }
+  }
+}
\ No newline at end of file
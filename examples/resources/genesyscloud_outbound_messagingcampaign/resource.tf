@@ -21,4 +21,26 @@ resource "genesyscloud_outbound_messagingcampaign" "example_outbound_messagingca
     }
     content_template_id = var.content_template_id
   }
+}
+
+resource "genesyscloud_outbound_messagingcampaign" "example_outbound_email_campaign" {
+  name                = "Example Email Campaign"
+  division_id         = genesyscloud_auth_division.division.id
+  campaign_status     = "off"
+  contact_list_id     = genesyscloud_outbound_contact_list.contact_list.id
+  rule_set_ids        = [genesyscloud_outbound_digitalruleset.digital_rule_set.id]
+  messages_per_minute = 10
+  email_config {
+    email_columns       = ["email"]
+    content_template_id = var.content_template_id
+    from_address {
+      domain_id     = genesyscloud_routing_email_domain.domain.id
+      friendly_name = "Example Sender"
+      local_part    = "collections"
+    }
+    reply_to_address {
+      domain_id = genesyscloud_routing_email_domain.domain.id
+      route_id  = genesyscloud_routing_email_route.route.id
+    }
+  }
 }
\ No newline at end of file
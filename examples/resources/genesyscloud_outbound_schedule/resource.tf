@@ -0,0 +1,12 @@
+resource "genesyscloud_outbound_schedule" "campaign_schedule" {
+  campaign_id = genesyscloud_outbound_campaign.campaign.id
+  time_zone   = "America/Indianapolis"
+  intervals {
+    start = "2024-01-08T08:00:00.000"
+    end   = "2024-01-08T17:00:00.000"
+  }
+  intervals {
+    start = "2024-01-09T08:00:00.000"
+    end   = "2024-01-09T17:00:00.000"
+  }
+}
\ No newline at end of file
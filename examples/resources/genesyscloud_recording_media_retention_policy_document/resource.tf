@@ -0,0 +1,4 @@
+resource "genesyscloud_recording_media_retention_policy_document" "example_policy_document" {
+  name            = "Support recordings"
+  policy_document = file("${path.module}/policy.yaml")
+}
\ No newline at end of file
@@ -0,0 +1,6 @@
+resource "genesyscloud_telephony_providers_edges_did_assignment" "support_line" {
+  phone_number = "+13175550100"
+  owner_type   = "IVR_CONFIG"
+  owner_id     = genesyscloud_architect_ivr.support_ivr.id
+  did_pool_id  = genesyscloud_telephony_providers_edges_did_pool.mypool.id
+}
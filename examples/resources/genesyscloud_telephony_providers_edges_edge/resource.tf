@@ -0,0 +1,16 @@
+resource "genesyscloud_telephony_providers_edges_edge" "example_edge" {
+  name          = "example edge"
+  description   = "example description"
+  site_id       = genesyscloud_telephony_providers_edges_site.site.id
+  edge_group_id = genesyscloud_telephony_providers_edges_edge_group.edge_group.id
+  pairing_id    = "00000-00000-00000-00000-00000"
+  proxy         = "proxy.example.com:8080"
+
+  software_update {
+    version             = "2.0.5600.0"
+    execute_start_time  = "2023-06-04T02:00:00Z"
+    execute_stop_time   = "2023-06-04T05:00:00Z"
+    download_start_time = "2023-06-03T22:00:00Z"
+    execute_on_idle     = true
+  }
+}
@@ -0,0 +1,25 @@
+resource "genesyscloud_telephony_providers_edges_edge_logical_interface" "example_interface" {
+  edge_id             = genesyscloud_telephony_providers_edges_edge.edge.id
+  physical_adapter_id = data.genesyscloud_telephony_providers_edges_edge_physical_interface.eth1.id
+  friendly_name       = "Voice"
+  vlan_tag_id         = 100
+
+  addresses {
+    type    = "ip"
+    address = "10.0.0.5/24"
+  }
+
+  routes {
+    prefix  = "0.0.0.0/0"
+    nexthop = "10.0.0.1"
+  }
+
+  ipv4_capabilities {
+    enabled      = true
+    dhcp         = false
+    ping_enabled = true
+  }
+
+  use_for_internal_edge_communication = true
+  external_trunk_base_settings_ids    = [genesyscloud_telephony_providers_edges_trunkbasesettings.external.id]
+}
@@ -0,0 +1,8 @@
+resource "genesyscloud_telephony_providers_edges_phones_bulk" "example_phones" {
+  csv_filepath            = "${path.module}/phones.csv"
+  file_content_hash       = filesha256("${path.module}/phones.csv")
+  site_id                 = genesyscloud_telephony_providers_edges_site.site.id
+  phone_base_settings_id  = genesyscloud_telephony_providers_edges_phonebasesettings.phone-base-settings.id
+  batch_size              = 10
+  max_requests_per_second = 5
+}
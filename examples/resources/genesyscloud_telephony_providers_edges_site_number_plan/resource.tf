@@ -0,0 +1,10 @@
+resource "genesyscloud_telephony_providers_edges_site_number_plan" "number_list_plan" {
+  site_id        = genesyscloud_telephony_providers_edges_site.site.id
+  name           = "numberList plan"
+  classification = "numberList classification"
+  match_type     = "numberList"
+  numbers {
+    start = "114"
+    end   = "115"
+  }
+}
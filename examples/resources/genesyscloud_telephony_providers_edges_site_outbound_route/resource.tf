@@ -0,0 +1,9 @@
+resource "genesyscloud_telephony_providers_edges_site_outbound_route" "regional_route" {
+  site_id                 = genesyscloud_telephony_providers_edges_site.site.id
+  name                    = "Regional outbound route"
+  description             = "Outbound route managed by the regional team"
+  classification_types    = ["National", "International"]
+  external_trunk_base_ids = [genesyscloud_telephony_providers_edges_trunkbasesettings.trunk-base-settings.id]
+  distribution            = "RANDOM"
+  enabled                 = true
+}
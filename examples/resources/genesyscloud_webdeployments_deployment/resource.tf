@@ -15,4 +15,8 @@ resource "genesyscloud_webdeployments_deployment" "exampleDeployment" {
     id      = data.genesyscloud_webdeployments_configuration.exampleConfiguration.id
     version = data.genesyscloud_webdeployments_configuration.exampleConfiguration.version
   }
+}
+
+output "exampleDeploymentSnippet" {
+  value = genesyscloud_webdeployments_deployment.exampleDeployment.snippet
 }
\ No newline at end of file
@@ -0,0 +1,104 @@
+package genesyscloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func dataSourceFlows() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing Genesys Cloud Flows. Select flows by name regex, division and flow type.",
+		ReadContext: readWithPooledClient(dataSourceFlowsRead),
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexFilterSchema("flows"),
+			"division_id": {
+				Description: "Only return flows in this division.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"type": {
+				Description: "Only return flows of this type, e.g. inboundcall, inqueuecall, outboundcall, workflow.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"flows": {
+				Description: "Flows matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Flow ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Flow name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Flow type.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"division_id": {
+							Description: "The division to which this flow belongs.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFlowsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	architectAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	nameFilter, err := getNameRegexFilter(d)
+	if err != nil {
+		return diag.Errorf("Invalid name_regex: %s", err)
+	}
+
+	var flowTypes []string
+	if flowType, ok := d.GetOk("type"); ok {
+		flowTypes = []string{flowType.(string)}
+	}
+	var divisionIds []string
+	if divisionId, ok := d.GetOk("division_id"); ok {
+		divisionIds = []string{divisionId.(string)}
+	}
+
+	flows, err := getAllSdkFlows(architectAPI, flowTypes, divisionIds)
+	if err != nil {
+		return diag.Errorf("Error requesting flows: %s", err)
+	}
+
+	flowList := make([]interface{}, 0)
+	for _, flow := range flows {
+		if !matchesNameRegexFilter(nameFilter, flow.Name) {
+			continue
+		}
+		flowMap := map[string]interface{}{
+			"id":   *flow.Id,
+			"name": *flow.Name,
+		}
+		if flow.VarType != nil {
+			flowMap["type"] = *flow.VarType
+		}
+		if flow.Division != nil && flow.Division.Id != nil {
+			flowMap["division_id"] = *flow.Division.Id
+		}
+		flowList = append(flowList, flowMap)
+	}
+
+	d.SetId(pluralDataSourceId(d, "name_regex", "division_id", "type"))
+	d.Set("flows", flowList)
+	return nil
+}
@@ -0,0 +1,62 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceFlows(t *testing.T) {
+	myDir, _ := os.Getwd()
+	var (
+		flowDataSource    = "flows-data"
+		flowName          = "test flows " + uuid.NewString()
+		inboundcallConfig = fmt.Sprintf("inboundCall:\n  name: %s\n  defaultLanguage: en-us\n  startUpRef: ./menus/menu[mainMenu]\n  initialGreeting:\n    tts: Archy says hi!!!\n  menus:\n    - menu:\n        name: Main Menu\n        audio:\n          tts: You are at the Main Menu, press 9 to disconnect.\n        refId: mainMenu\n        choices:\n          - menuDisconnect:\n              name: Disconnect\n              dtmf: digit_9", flowName)
+
+		flowResource = "test_flow"
+		filePath     = myDir + "/../examples/resources/genesyscloud_flow/inboundcall_flow_example.yaml"
+		dataSrcPath  = "data.genesyscloud_flows." + flowDataSource
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateFlowResource(
+					flowResource,
+					filePath,
+					inboundcallConfig,
+					false,
+				) + generateFlowsDataSource(
+					flowDataSource,
+					"genesyscloud_flow."+flowResource,
+					"^"+flowName+"$",
+					"inboundcall",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSrcPath, "flows.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSrcPath, "flows.0.id", "genesyscloud_flow."+flowResource, "id"),
+					resource.TestCheckResourceAttr(dataSrcPath, "flows.0.name", flowName),
+					resource.TestCheckResourceAttr(dataSrcPath, "flows.0.type", "inboundcall"),
+				),
+			},
+		},
+	})
+}
+
+func generateFlowsDataSource(
+	resourceID,
+	dependsOn,
+	nameRegex,
+	flowType string) string {
+	return fmt.Sprintf(`data "genesyscloud_flows" "%s" {
+		name_regex = "%s"
+		type = "%s"
+		depends_on = [%s]
+	}
+	`, resourceID, nameRegex, flowType, dependsOn)
+}
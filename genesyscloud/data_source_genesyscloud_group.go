@@ -13,15 +13,15 @@ import (
 
 func dataSourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for Genesys Cloud Groups. Select a group by name.",
+		Description: "Data source for Genesys Cloud Groups. Select a group by name. All other group attributes are exported as read-only attributes.",
 		ReadContext: readWithPooledClient(dataSourceGroupRead),
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceSchemaFromResource(resourceGroup(), map[string]*schema.Schema{
 			"name": {
 				Description: "Group name.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
-		},
+		}),
 	}
 }
 
@@ -54,6 +54,18 @@ func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, m interfac
 		// Select first group in the list
 		group := (*groups.Results)[0]
 		d.SetId(*group.Id)
-		return nil
+		return readGroupDataSourceAttributes(d, groupsAPI)
 	})
 }
+
+func readGroupDataSourceAttributes(d *schema.ResourceData, groupsAPI *platformclientv2.GroupsApi) *resource.RetryError {
+	group, _, getErr := groupsAPI.GetGroup(d.Id())
+	if getErr != nil {
+		return resource.NonRetryableError(fmt.Errorf("Error reading group %s: %s", d.Id(), getErr))
+	}
+
+	if diagErr := flattenGroup(d, group, groupsAPI); diagErr != nil {
+		return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
+	}
+	return nil
+}
@@ -34,6 +34,8 @@ func TestAccDataSourceGroup(t *testing.T) {
 					"genesyscloud_group."+groupResource),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("data.genesyscloud_group."+groupDataSource, "id", "genesyscloud_group."+groupResource, "id"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_group."+groupDataSource, "type", "genesyscloud_group."+groupResource, "type"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_group."+groupDataSource, "visibility", "genesyscloud_group."+groupResource, "visibility"),
 				),
 			},
 		},
@@ -0,0 +1,115 @@
+package genesyscloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing Genesys Cloud Groups. Select groups by name regex, type and visibility.",
+		ReadContext: readWithPooledClient(dataSourceGroupsRead),
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexFilterSchema("groups"),
+			"type": {
+				Description:  "Only return groups of this type (official | social).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"official", "social"}, false),
+			},
+			"visibility": {
+				Description:  "Only return groups with this visibility (public | owners | members).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"public", "owners", "members"}, false),
+			},
+			"groups": {
+				Description: "Groups matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Group ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Group name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Group type.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"visibility": {
+							Description: "Who can view this group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"member_count": {
+							Description: "Number of members in the group.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+
+	nameFilter, err := getNameRegexFilter(d)
+	if err != nil {
+		return diag.Errorf("Invalid name_regex: %s", err)
+	}
+	groupType := d.Get("type").(string)
+	visibility := d.Get("visibility").(string)
+
+	groups, err := getAllSdkGroups(groupsAPI)
+	if err != nil {
+		return diag.Errorf("Error requesting groups: %s", err)
+	}
+
+	groupList := make([]interface{}, 0)
+	for _, group := range groups {
+		if !matchesNameRegexFilter(nameFilter, group.Name) {
+			continue
+		}
+		if groupType != "" && (group.VarType == nil || *group.VarType != groupType) {
+			continue
+		}
+		if visibility != "" && (group.Visibility == nil || *group.Visibility != visibility) {
+			continue
+		}
+
+		groupMap := map[string]interface{}{
+			"id":   *group.Id,
+			"name": *group.Name,
+		}
+		if group.VarType != nil {
+			groupMap["type"] = *group.VarType
+		}
+		if group.Visibility != nil {
+			groupMap["visibility"] = *group.Visibility
+		}
+		if group.MemberCount != nil {
+			groupMap["member_count"] = *group.MemberCount
+		}
+		groupList = append(groupList, groupMap)
+	}
+
+	d.SetId(pluralDataSourceId(d, "name_regex", "type", "visibility"))
+	d.Set("groups", groupList)
+	return nil
+}
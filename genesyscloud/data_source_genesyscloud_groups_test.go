@@ -0,0 +1,58 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGroups(t *testing.T) {
+	var (
+		groupNamePrefix  = "Terraform Groups-" + uuid.NewString()
+		groupResource1   = "test-group-1"
+		groupResource2   = "test-group-2"
+		groupDataSource  = "test-groups"
+		groupDataSrcPath = "data.genesyscloud_groups." + groupDataSource
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateGroupResource(groupResource1, groupNamePrefix+"-1", nullValue, strconv.Quote("official"), strconv.Quote("public"), trueValue) +
+					generateGroupResource(groupResource2, groupNamePrefix+"-2", nullValue, strconv.Quote("official"), strconv.Quote("members"), trueValue) +
+					generateGroupsDataSource(
+						groupDataSource,
+						"^"+groupNamePrefix,
+						"public",
+						"genesyscloud_group."+groupResource1+", genesyscloud_group."+groupResource2,
+					),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupDataSrcPath, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(groupDataSrcPath, "groups.0.id", "genesyscloud_group."+groupResource1, "id"),
+					resource.TestCheckResourceAttr(groupDataSrcPath, "groups.0.type", "official"),
+					resource.TestCheckResourceAttr(groupDataSrcPath, "groups.0.visibility", "public"),
+				),
+			},
+		},
+	})
+}
+
+func generateGroupsDataSource(
+	resourceID string,
+	nameRegex string,
+	visibility string,
+	// Must explicitly use depends_on in terraform v0.13 when a data source references a resource
+	// Fixed in v0.14 https://github.com/hashicorp/terraform/pull/26284
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_groups" "%s" {
+		name_regex = "%s"
+		visibility = "%s"
+		depends_on = [%s]
+	}
+	`, resourceID, nameRegex, visibility, dependsOnResource)
+}
@@ -0,0 +1,244 @@
+package genesyscloud
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+// Names of the operations returned by the action test endpoints
+const (
+	actionTestOperationRequestUrl  = "Resolve request URL template"
+	actionTestOperationRequestBody = "Resolve request body template"
+	actionTestOperationExecute     = "Execute request"
+)
+
+func dataSourceIntegrationActionTest() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for testing a Genesys Cloud integration action. Invokes the action's test endpoint with the given input and returns the resolved request, the response and any errors. Note that the action is executed against its integration on every read.",
+		ReadContext: readWithPooledClient(dataSourceIntegrationActionTestRead),
+		Schema: map[string]*schema.Schema{
+			"action_id": {
+				Description: "ID of the integration action to test.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"input": {
+				Description:  "Input of the action as a JSON string. Must match the action's contract_input.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"draft": {
+				Description: "Test the draft of the action instead of the published action.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"fail_on_error": {
+				Description: "Fail the read when the test is not successful, so a plan fails on broken templates or JSON paths.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"success": {
+				Description: "Whether the test was successful.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"resolved_request_url": {
+				Description: "Request URL after resolving the request URL template with the input.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"resolved_request_body": {
+				Description: "Request body after resolving the request body template with the input.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"raw_response": {
+				Description: "Response returned by the endpoint of the action (JSON string).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"response": {
+				Description: "Final result of the test after applying the response translation map and template (JSON string). This is what the action returns during normal execution.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"error_message": {
+				Description: "Error that made the test fail.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"error_code": {
+				Description: "Code of the error that made the test fail.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"operations": {
+				Description: "Operations performed during the test, in order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"step": {
+							Description: "Step number of the operation.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the operation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"success": {
+							Description: "Whether the operation was successful.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"result": {
+							Description: "Result of the operation (JSON string).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"error_message": {
+							Description: "Error that occurred during the operation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIntegrationActionTestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	integrationAPI := platformclientv2.NewIntegrationsApiWithConfig(sdkConfig)
+
+	actionId := d.Get("action_id").(string)
+
+	var input interface{}
+	if err := json.Unmarshal([]byte(d.Get("input").(string)), &input); err != nil {
+		return diag.Errorf("Failed to parse input for integration action %s: %s", actionId, err)
+	}
+
+	var (
+		result *platformclientv2.Testexecutionresult
+		err    error
+	)
+	log.Printf("Testing integration action %s", actionId)
+	if d.Get("draft").(bool) {
+		result, _, err = integrationAPI.PostIntegrationsActionDraftTest(actionId, input)
+	} else {
+		result, _, err = integrationAPI.PostIntegrationsActionTest(actionId, input)
+	}
+	if err != nil {
+		return diag.Errorf("Failed to test integration action %s: %s", actionId, err)
+	}
+
+	d.SetId(actionId)
+
+	success := result.Success != nil && *result.Success
+	d.Set("success", success)
+	d.Set("response", marshalActionTestResult(result.FinalResult))
+
+	errorMessage, errorCode := flattenActionTestError(result.VarError)
+	d.Set("error_message", errorMessage)
+	d.Set("error_code", errorCode)
+
+	var (
+		requestUrl  string
+		requestBody string
+		rawResponse string
+		operations  []interface{}
+	)
+	if result.Operations != nil {
+		for _, operation := range *result.Operations {
+			operationMap := flattenActionTestOperation(operation)
+			switch operationMap["name"] {
+			case actionTestOperationRequestUrl:
+				requestUrl = unquoteActionTestResult(operation.Result)
+			case actionTestOperationRequestBody:
+				requestBody = unquoteActionTestResult(operation.Result)
+			case actionTestOperationExecute:
+				rawResponse = operationMap["result"].(string)
+			}
+			operations = append(operations, operationMap)
+		}
+	}
+	d.Set("resolved_request_url", requestUrl)
+	d.Set("resolved_request_body", requestBody)
+	d.Set("raw_response", rawResponse)
+	d.Set("operations", operations)
+
+	if !success {
+		if d.Get("fail_on_error").(bool) {
+			return diag.Errorf("Test of integration action %s failed: %s", actionId, errorMessage)
+		}
+		log.Printf("Test of integration action %s failed: %s", actionId, errorMessage)
+	}
+
+	log.Printf("Tested integration action %s", actionId)
+	return nil
+}
+
+func flattenActionTestOperation(operation platformclientv2.Testexecutionoperationresult) map[string]interface{} {
+	operationMap := map[string]interface{}{
+		"result": marshalActionTestResult(operation.Result),
+	}
+	if operation.Step != nil {
+		operationMap["step"] = *operation.Step
+	}
+	if operation.Name != nil {
+		operationMap["name"] = *operation.Name
+	}
+	if operation.Success != nil {
+		operationMap["success"] = *operation.Success
+	}
+	operationMap["error_message"], _ = flattenActionTestError(operation.VarError)
+	return operationMap
+}
+
+func flattenActionTestError(errorBody *platformclientv2.Errorbody) (string, string) {
+	var message, code string
+	if errorBody == nil {
+		return message, code
+	}
+	if errorBody.Message != nil {
+		message = *errorBody.Message
+	}
+	if errorBody.Code != nil {
+		code = *errorBody.Code
+	}
+	return message, code
+}
+
+func marshalActionTestResult(result *interface{}) string {
+	if result == nil {
+		return ""
+	}
+	resultBytes, err := json.Marshal(*result)
+	if err != nil {
+		log.Printf("Failed to marshal integration action test result: %s", err)
+		return ""
+	}
+	return string(resultBytes)
+}
+
+// unquoteActionTestResult returns string results as they are and other results as JSON
+func unquoteActionTestResult(result *interface{}) string {
+	if result != nil {
+		if resultStr, ok := (*result).(string); ok {
+			return resultStr
+		}
+	}
+	return marshalActionTestResult(result)
+}
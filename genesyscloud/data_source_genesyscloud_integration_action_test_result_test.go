@@ -0,0 +1,79 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIntegrationActionTest(t *testing.T) {
+	var (
+		integResource1   = "test_integration1"
+		integTypeID      = "purecloud-data-actions"
+		actionResource1  = "test-action1"
+		actionTestSource = "test-action-test"
+		actionName1      = "Terraform Action1-" + uuid.NewString()
+		actionCateg1     = "Genesys Cloud Data Actions"
+		inputAttr1       = "service"
+		outputAttr1      = "status"
+		reqUrlTemplate1  = "/api/v2/users/$${input.service}"
+		reqType1         = "GET"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Test the action with an input that resolves the URL template
+				Config: generateIntegrationResource(
+					integResource1,
+					nullValue,
+					strconv.Quote(integTypeID),
+					// No config block
+				) + generateIntegrationActionResource(
+					actionResource1,
+					actionName1,
+					actionCateg1,
+					"genesyscloud_integration."+integResource1+".id",
+					nullValue,                             // Secure default (false)
+					nullValue,                             // Timeout default
+					generateJsonSchemaDocStr(inputAttr1),  // contract_input
+					generateJsonSchemaDocStr(outputAttr1), // contract_output
+					generateIntegrationActionConfigRequest(
+						reqUrlTemplate1,
+						reqType1,
+						nullValue, // Default req template
+						"",        // No headers
+					),
+					// Default config response
+				) + generateIntegrationActionTestDataSource(
+					actionTestSource,
+					"genesyscloud_integration_action."+actionResource1+".id",
+					generateJsonEncodedProperties(
+						generateJsonProperty(inputAttr1, strconv.Quote("me")),
+					),
+					nullValue, // Published action
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_integration_action_test."+actionTestSource, "id", "genesyscloud_integration_action."+actionResource1, "id"),
+					resource.TestCheckResourceAttr("data.genesyscloud_integration_action_test."+actionTestSource, "resolved_request_url", "/api/v2/users/me"),
+					resource.TestCheckResourceAttrSet("data.genesyscloud_integration_action_test."+actionTestSource, "success"),
+					resource.TestCheckResourceAttrSet("data.genesyscloud_integration_action_test."+actionTestSource, "operations.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func generateIntegrationActionTestDataSource(resourceID string, actionId string, input string, draft string) string {
+	return fmt.Sprintf(`data "genesyscloud_integration_action_test" "%s" {
+		action_id = %s
+		input = %s
+		draft = %s
+	}
+	`, resourceID, actionId, input, draft)
+}
@@ -0,0 +1,70 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceQualityFormsEvaluationVersions(t *testing.T) {
+	var (
+		formRes     = "quality-form"
+		formDataRes = "quality-form-versions"
+	)
+
+	evaluationForm1 := EvaluationFormStruct{
+		Name:      "terraform-form-evaluations-" + uuid.NewString(),
+		Published: true,
+		QuestionGroups: []EvaluationFormQuestionGroupStruct{
+			{
+				Name:   "Test Question Group 1",
+				Weight: 1,
+				Questions: []EvaluationFormQuestionStruct{
+					{
+						Text: "Did the agent perform the opening spiel?",
+						AnswerOptions: []AnswerOptionStruct{
+							{
+								Text:  "Yes",
+								Value: 1,
+							},
+							{
+								Text:  "No",
+								Value: 0,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GenerateEvaluationFormResource(
+					formRes, &evaluationForm1,
+				) + generateQualityFormsEvaluationVersionsDataSource(
+					formDataRes,
+					"genesyscloud_quality_forms_evaluation."+formRes+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_quality_forms_evaluation_versions."+formDataRes, "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.genesyscloud_quality_forms_evaluation_versions."+formDataRes, "versions.0.name", evaluationForm1.Name),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_quality_forms_evaluation_versions."+formDataRes, "latest_version_id", "genesyscloud_quality_forms_evaluation."+formRes, "published_version_id"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyEvaluationFormDestroyed,
+	})
+}
+
+func generateQualityFormsEvaluationVersionsDataSource(resourceID string, formId string) string {
+	return fmt.Sprintf(`data "genesyscloud_quality_forms_evaluation_versions" "%s" {
+		form_id = %s
+	}
+	`, resourceID, formId)
+}
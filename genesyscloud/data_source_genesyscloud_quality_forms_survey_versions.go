@@ -0,0 +1,64 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func dataSourceQualityFormsSurveyVersions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the publish history of a Genesys Cloud Survey Form. Lists the published versions, newest first.",
+		ReadContext: readWithPooledClient(dataSourceQualityFormsSurveyVersionsRead),
+		Schema: map[string]*schema.Schema{
+			"form_id": {
+				Description: "The ID of any version of the survey form.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"latest_version_id": {
+				Description: "The ID of the latest published version of the form.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"versions": {
+				Description: "The published versions of the form, newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        qualityFormVersionResource,
+			},
+		},
+	}
+}
+
+func dataSourceQualityFormsSurveyVersionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	qualityAPI := platformclientv2.NewQualityApiWithConfig(sdkConfig)
+
+	formId := d.Get("form_id").(string)
+
+	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		versions, resp, err := getSurveyFormVersions(qualityAPI, formId)
+		if err != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to find survey form %s: %s", formId, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error retrieving versions of survey form %s: %s", formId, err))
+		}
+
+		published := publishedQualityFormVersions(versions)
+		d.SetId(formId)
+		if len(published) > 0 {
+			d.Set("latest_version_id", published[0].Id)
+		} else {
+			d.Set("latest_version_id", nil)
+		}
+		d.Set("versions", flattenQualityFormVersions(published))
+		return nil
+	})
+}
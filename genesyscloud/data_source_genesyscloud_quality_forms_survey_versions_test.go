@@ -0,0 +1,71 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceQualityFormsSurveyVersions(t *testing.T) {
+	var (
+		formRes     = "quality-form"
+		formDataRes = "quality-form-versions"
+	)
+
+	surveyForm1 := surveyFormStruct{
+		name:      "terraform-form-surveys-" + uuid.NewString(),
+		language:  "en-US",
+		published: true,
+		questionGroups: []surveyFormQuestionGroupStruct{
+			{
+				name: "Test Question Group 1",
+				questions: []surveyFormQuestionStruct{
+					{
+						text:    "Was your problem solved?",
+						varType: "multipleChoiceQuestion",
+						answerOptions: []AnswerOptionStruct{
+							{
+								Text:  "Yes",
+								Value: 1,
+							},
+							{
+								Text:  "No",
+								Value: 0,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateSurveyFormResource(
+					formRes, &surveyForm1,
+				) + generateQualityFormsSurveyVersionsDataSource(
+					formDataRes,
+					"genesyscloud_quality_forms_survey."+formRes+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_quality_forms_survey_versions."+formDataRes, "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.genesyscloud_quality_forms_survey_versions."+formDataRes, "versions.0.name", surveyForm1.name),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_quality_forms_survey_versions."+formDataRes, "latest_version_id", "genesyscloud_quality_forms_survey."+formRes, "published_version_id"),
+				),
+			},
+		},
+		CheckDestroy: testVerifySurveyFormDestroyed,
+	})
+}
+
+func generateQualityFormsSurveyVersionsDataSource(resourceID string, formId string) string {
+	return fmt.Sprintf(`data "genesyscloud_quality_forms_survey_versions" "%s" {
+		form_id = %s
+	}
+	`, resourceID, formId)
+}
@@ -13,15 +13,15 @@ import (
 
 func dataSourceRoutingQueue() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for Genesys Cloud Routing Queues. Select a queue by name.",
+		Description: "Data source for Genesys Cloud Routing Queues. Select a queue by name. All other queue attributes are exported as read-only attributes.",
 		ReadContext: readWithPooledClient(dataSourceRoutingQueueRead),
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceSchemaFromResource(resourceRoutingQueue(), map[string]*schema.Schema{
 			"name": {
 				Description: "Queue name.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
-		},
+		}),
 	}
 }
 
@@ -47,9 +47,21 @@ func dataSourceRoutingQueueRead(ctx context.Context, d *schema.ResourceData, m i
 			for _, queue := range *queues.Entities {
 				if queue.Name != nil && *queue.Name == name {
 					d.SetId(*queue.Id)
-					return nil
+					return readRoutingQueueDataSourceAttributes(d, routingAPI)
 				}
 			}
 		}
 	})
 }
+
+func readRoutingQueueDataSourceAttributes(d *schema.ResourceData, routingAPI *platformclientv2.RoutingApi) *resource.RetryError {
+	queue, _, getErr := routingAPI.GetRoutingQueue(d.Id())
+	if getErr != nil {
+		return resource.NonRetryableError(fmt.Errorf("Error reading queue %s: %s", d.Id(), getErr))
+	}
+
+	if diagErr := flattenQueue(d, queue, routingAPI); diagErr != nil {
+		return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
+	}
+	return nil
+}
@@ -44,6 +44,14 @@ func TestAccDataSourceRoutingQueueBasic(t *testing.T) {
 					resource.TestCheckResourceAttrPair("data.genesyscloud_routing_queue."+queueDataSource,
 						"id", "genesyscloud_routing_queue."+queueResource, "id",
 					),
+					resource.TestCheckResourceAttr("data.genesyscloud_routing_queue."+queueDataSource, "description", queueDesc),
+					resource.TestCheckResourceAttr("data.genesyscloud_routing_queue."+queueDataSource, "acw_timeout_ms", "200000"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_routing_queue."+queueDataSource,
+						"division_id", "genesyscloud_routing_queue."+queueResource, "division_id",
+					),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_routing_queue."+queueDataSource,
+						"media_settings_call.0.alerting_timeout_sec", "genesyscloud_routing_queue."+queueResource, "media_settings_call.0.alerting_timeout_sec",
+					),
 				),
 			},
 		},
@@ -0,0 +1,95 @@
+package genesyscloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func dataSourceRoutingQueues() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing Genesys Cloud Routing Queues. Select queues by name regex and division.",
+		ReadContext: readWithPooledClient(dataSourceRoutingQueuesRead),
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexFilterSchema("queues"),
+			"division_id": {
+				Description: "Only return queues in this division.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"queues": {
+				Description: "Queues matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Queue ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Queue name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"division_id": {
+							Description: "The division to which this queue belongs.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"member_count": {
+							Description: "The total number of members in the queue.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRoutingQueuesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	nameFilter, err := getNameRegexFilter(d)
+	if err != nil {
+		return diag.Errorf("Invalid name_regex: %s", err)
+	}
+
+	var divisionIds []string
+	if divisionId, ok := d.GetOk("division_id"); ok {
+		divisionIds = []string{divisionId.(string)}
+	}
+
+	queues, err := getAllSdkRoutingQueues(routingAPI, divisionIds)
+	if err != nil {
+		return diag.Errorf("Error requesting queues: %s", err)
+	}
+
+	queueList := make([]interface{}, 0)
+	for _, queue := range queues {
+		if !matchesNameRegexFilter(nameFilter, queue.Name) {
+			continue
+		}
+		queueMap := map[string]interface{}{
+			"id":   *queue.Id,
+			"name": *queue.Name,
+		}
+		if queue.Division != nil && queue.Division.Id != nil {
+			queueMap["division_id"] = *queue.Division.Id
+		}
+		if queue.MemberCount != nil {
+			queueMap["member_count"] = *queue.MemberCount
+		}
+		queueList = append(queueList, queueMap)
+	}
+
+	d.SetId(pluralDataSourceId(d, "name_regex", "division_id"))
+	d.Set("queues", queueList)
+	return nil
+}
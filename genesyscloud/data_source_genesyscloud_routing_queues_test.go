@@ -0,0 +1,56 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRoutingQueues(t *testing.T) {
+	var (
+		queueNamePrefix  = "Terraform Queues-" + uuid.NewString()
+		queueResource1   = "test-queue-1"
+		queueResource2   = "test-queue-2"
+		queueDataSource  = "test-queues"
+		queueName1       = queueNamePrefix + "-1"
+		queueName2       = queueNamePrefix + "-2"
+		queueDataSrcPath = "data.genesyscloud_routing_queues." + queueDataSource
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateRoutingQueueResourceBasic(queueResource1, queueName1) +
+					generateRoutingQueueResourceBasic(queueResource2, queueName2) +
+					generateRoutingQueuesDataSource(
+						queueDataSource,
+						"^"+queueNamePrefix+"-1$",
+						"genesyscloud_routing_queue."+queueResource1+", genesyscloud_routing_queue."+queueResource2,
+					),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(queueDataSrcPath, "queues.#", "1"),
+					resource.TestCheckResourceAttrPair(queueDataSrcPath, "queues.0.id", "genesyscloud_routing_queue."+queueResource1, "id"),
+					resource.TestCheckResourceAttr(queueDataSrcPath, "queues.0.name", queueName1),
+					resource.TestCheckResourceAttrPair(queueDataSrcPath, "queues.0.division_id", "genesyscloud_routing_queue."+queueResource1, "division_id"),
+				),
+			},
+		},
+	})
+}
+
+func generateRoutingQueuesDataSource(
+	resourceID string,
+	nameRegex string,
+	// Must explicitly use depends_on in terraform v0.13 when a data source references a resource
+	// Fixed in v0.14 https://github.com/hashicorp/terraform/pull/26284
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_routing_queues" "%s" {
+		name_regex = "%s"
+		depends_on = [%s]
+	}
+	`, resourceID, nameRegex, dependsOnResource)
+}
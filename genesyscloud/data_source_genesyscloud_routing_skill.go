@@ -13,15 +13,15 @@ import (
 
 func dataSourceRoutingSkill() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for Genesys Cloud Routing Skills. Select a skill by name.",
+		Description: "Data source for Genesys Cloud Routing Skills. Select a skill by name. All other skill attributes are exported as read-only attributes.",
 		ReadContext: readWithPooledClient(dataSourceRoutingSkillRead),
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceSchemaFromResource(resourceRoutingSkill(), map[string]*schema.Schema{
 			"name": {
 				Description: "Skill name.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
-		},
+		}),
 	}
 }
 
@@ -48,6 +48,7 @@ func dataSourceRoutingSkillRead(ctx context.Context, d *schema.ResourceData, m i
 				if skill.Name != nil && *skill.Name == name &&
 					skill.State != nil && *skill.State != "deleted" {
 					d.SetId(*skill.Id)
+					flattenRoutingSkill(d, &skill)
 					return nil
 				}
 			}
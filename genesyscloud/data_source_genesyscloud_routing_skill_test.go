@@ -26,6 +26,7 @@ func TestAccDataSourceRoutingSkill(t *testing.T) {
 				) + generateRoutingSkillDataSource(skillDataSource, "genesyscloud_routing_skill."+skillResource+".name", "genesyscloud_routing_skill."+skillResource),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("data.genesyscloud_routing_skill."+skillDataSource, "id", "genesyscloud_routing_skill."+skillResource, "id"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_routing_skill."+skillDataSource, "name", "genesyscloud_routing_skill."+skillResource, "name"),
 				),
 			},
 		},
@@ -0,0 +1,68 @@
+package genesyscloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func dataSourceRoutingSkills() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing Genesys Cloud Routing Skills. Select skills by name regex.",
+		ReadContext: readWithPooledClient(dataSourceRoutingSkillsRead),
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexFilterSchema("skills"),
+			"skills": {
+				Description: "Skills matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Skill ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Skill name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRoutingSkillsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	nameFilter, err := getNameRegexFilter(d)
+	if err != nil {
+		return diag.Errorf("Invalid name_regex: %s", err)
+	}
+
+	skills, err := getAllSdkRoutingSkills(routingAPI)
+	if err != nil {
+		return diag.Errorf("Error requesting skills: %s", err)
+	}
+
+	skillList := make([]interface{}, 0)
+	for _, skill := range skills {
+		if !matchesNameRegexFilter(nameFilter, skill.Name) {
+			continue
+		}
+		skillList = append(skillList, map[string]interface{}{
+			"id":   *skill.Id,
+			"name": *skill.Name,
+		})
+	}
+
+	d.SetId(pluralDataSourceId(d, "name_regex"))
+	d.Set("skills", skillList)
+	return nil
+}
@@ -0,0 +1,53 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRoutingSkills(t *testing.T) {
+	var (
+		skillNamePrefix  = "Terraform Skills-" + uuid.NewString()
+		skillResource1   = "routing-skill-1"
+		skillResource2   = "routing-skill-2"
+		skillDataSource  = "routing-skills-data"
+		skillDataSrcPath = "data.genesyscloud_routing_skills." + skillDataSource
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateRoutingSkillResource(skillResource1, skillNamePrefix+"-1") +
+					generateRoutingSkillResource(skillResource2, skillNamePrefix+"-2") +
+					generateRoutingSkillsDataSource(
+						skillDataSource,
+						"^"+skillNamePrefix,
+						"genesyscloud_routing_skill."+skillResource1+", genesyscloud_routing_skill."+skillResource2,
+					),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(skillDataSrcPath, "skills.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(skillDataSrcPath, "skills.*.id", "genesyscloud_routing_skill."+skillResource1, "id"),
+					resource.TestCheckTypeSetElemAttrPair(skillDataSrcPath, "skills.*.id", "genesyscloud_routing_skill."+skillResource2, "id"),
+				),
+			},
+		},
+	})
+}
+
+func generateRoutingSkillsDataSource(
+	resourceID string,
+	nameRegex string,
+	// Must explicitly use depends_on in terraform v0.13 when a data source references a resource
+	// Fixed in v0.14 https://github.com/hashicorp/terraform/pull/26284
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_routing_skills" "%s" {
+		name_regex = "%s"
+		depends_on = [%s]
+	}
+	`, resourceID, nameRegex, dependsOnResource)
+}
@@ -0,0 +1,120 @@
+package genesyscloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func dataSourceDids() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing the numbers of Genesys Cloud DID pools with their current owner. Select numbers by DID pool, assignment and number.",
+		ReadContext: readWithPooledClient(dataSourceDidsRead),
+		Schema: map[string]*schema.Schema{
+			"did_pool_ids": {
+				Description: "Only return numbers of these DID pools. If not set, numbers of all DID pools are returned.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Description:  "Type of numbers to return (ASSIGNED_AND_UNASSIGNED | UNASSIGNED).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ASSIGNED_AND_UNASSIGNED",
+				ValidateFunc: validation.StringInSlice([]string{"ASSIGNED_AND_UNASSIGNED", "UNASSIGNED"}, false),
+			},
+			"number_match": {
+				Description: "Only return numbers matching this number.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"dids": {
+				Description: "Numbers matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phone_number": {
+							Description: "E.164 phone number.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"did_pool_id": {
+							Description: "ID of the DID pool the number belongs to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"assigned": {
+							Description: "True if the number is assigned to an owner.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"owner_type": {
+							Description: "Type of the owner of the number (USER | PHONE | IVR_CONFIG | GROUP). Empty if the number is unassigned.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"owner_id": {
+							Description: "ID of the owner of the number. Empty if the number is unassigned.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDidsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	telephonyAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	didPoolIds := InterfaceListToStrings(d.Get("did_pool_ids").([]interface{}))
+	numberType := d.Get("type").(string)
+	numberMatch := d.Get("number_match").(string)
+
+	didList := make([]interface{}, 0)
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		didNumbers, _, getErr := telephonyAPI.GetTelephonyProvidersEdgesDidpoolsDids(numberType, didPoolIds, numberMatch, pageSize, pageNum, "")
+		if getErr != nil {
+			return diag.Errorf("Error requesting DID pool numbers: %s", getErr)
+		}
+
+		if didNumbers.Entities == nil || len(*didNumbers.Entities) == 0 {
+			break
+		}
+
+		for _, didNumber := range *didNumbers.Entities {
+			didList = append(didList, flattenDidNumber(didNumber))
+		}
+	}
+
+	d.SetId(pluralDataSourceId(d, "did_pool_ids", "type", "number_match"))
+	d.Set("dids", didList)
+	return nil
+}
+
+func flattenDidNumber(didNumber platformclientv2.Didnumber) map[string]interface{} {
+	didMap := map[string]interface{}{
+		"assigned": didNumber.Assigned != nil && *didNumber.Assigned,
+	}
+	if didNumber.Number != nil {
+		didMap["phone_number"] = *didNumber.Number
+	}
+	if didNumber.DidPool != nil && didNumber.DidPool.Id != nil {
+		didMap["did_pool_id"] = *didNumber.DidPool.Id
+	}
+	if didNumber.OwnerType != nil {
+		didMap["owner_type"] = *didNumber.OwnerType
+	}
+	if didNumber.Owner != nil && didNumber.Owner.Id != nil {
+		didMap["owner_id"] = *didNumber.Owner.Id
+	}
+	return didMap
+}
@@ -0,0 +1,83 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDids(t *testing.T) {
+	var (
+		didPoolStartPhoneNumber = "+14175550041"
+		didPoolEndPhoneNumber   = "+14175550043"
+		didPoolRes              = "didPool"
+		ivrConfigRes            = "ivrConfig"
+		ivrConfigName           = "test-config" + uuid.NewString()
+		didPhoneNumber          = "+14175550042"
+		didsDataRes             = "didsData"
+		didsDataPath            = "data.genesyscloud_telephony_providers_edges_dids." + didsDataRes
+	)
+
+	if err := authorizeSdk(); err != nil {
+		t.Fatal(err)
+	}
+	deleteIvrStartingWith("test-config")
+	if err := deleteDidPoolWithNumber(didPoolStartPhoneNumber); err != nil {
+		t.Fatalf("error deleting did pool start number: %v", err)
+	}
+	if err := deleteDidPoolWithNumber(didPoolEndPhoneNumber); err != nil {
+		t.Fatalf("error deleting did pool end number: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateDidPoolResource(&didPoolStruct{
+					didPoolRes,
+					didPoolStartPhoneNumber,
+					didPoolEndPhoneNumber,
+					nullValue, // No description
+					nullValue, // No comments
+					nullValue, // No provider
+				}) + generateIvrConfigResource(&ivrConfigStruct{
+					resourceID:  ivrConfigRes,
+					name:        ivrConfigName,
+					description: "",
+					dnis:        []string{didPhoneNumber},
+					depends_on:  "genesyscloud_telephony_providers_edges_did_pool." + didPoolRes,
+				}) + generateDidsDataSource(didsDataRes,
+					"genesyscloud_telephony_providers_edges_did_pool."+didPoolRes+".id",
+					"genesyscloud_architect_ivr."+ivrConfigRes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(didsDataPath, "dids.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(didsDataPath, "dids.*", map[string]string{
+						"phone_number": didPhoneNumber,
+						"assigned":     trueValue,
+						"owner_type":   didOwnerIvr,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(didsDataPath, "dids.*", map[string]string{
+						"phone_number": didPoolStartPhoneNumber,
+						"assigned":     falseValue,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func generateDidsDataSource(
+	resourceID string,
+	didPoolId string,
+	// Must explicitly use depends_on in terraform v0.13 when a data source references a resource
+	// Fixed in v0.14 https://github.com/hashicorp/terraform/pull/26284
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_telephony_providers_edges_dids" "%s" {
+		did_pool_ids = [%s]
+		depends_on = [%s]
+	}
+	`, resourceID, didPoolId, dependsOnResource)
+}
@@ -0,0 +1,82 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func dataSourceEdgePhysicalInterface() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for a physical interface of a Genesys Cloud Edge. Select a physical interface by name or port label. Physical interfaces are reported by the edge device and cannot be created.",
+		ReadContext: readWithPooledClient(dataSourceEdgePhysicalInterfaceRead),
+		Schema: map[string]*schema.Schema{
+			"edge_id": {
+				Description: "ID of the edge.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description:  "Name of the physical interface, e.g. eth0.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "port_label"},
+			},
+			"port_label": {
+				Description:  "Label of the port on the edge device.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "port_label"},
+			},
+			"friendly_name": {
+				Description: "Friendly name of the physical interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"hardware_address": {
+				Description: "Hardware (MAC) address of the physical interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceEdgePhysicalInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	edgeId := d.Get("edge_id").(string)
+	name := d.Get("name").(string)
+	portLabel := d.Get("port_label").(string)
+
+	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		physicalInterfaces, _, getErr := edgesAPI.GetTelephonyProvidersEdgePhysicalinterfaces(edgeId)
+		if getErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error requesting physical interfaces of edge %s: %s", edgeId, getErr))
+		}
+
+		if physicalInterfaces.Entities != nil {
+			for _, physicalInterface := range *physicalInterfaces.Entities {
+				if (name != "" && physicalInterface.Name != nil && *physicalInterface.Name == name) ||
+					(portLabel != "" && physicalInterface.PortLabel != nil && *physicalInterface.PortLabel == portLabel) {
+					d.SetId(*physicalInterface.Id)
+					d.Set("name", physicalInterface.Name)
+					d.Set("port_label", physicalInterface.PortLabel)
+					d.Set("friendly_name", physicalInterface.FriendlyName)
+					d.Set("hardware_address", physicalInterface.HardwareAddress)
+					return nil
+				}
+			}
+		}
+
+		return resource.RetryableError(fmt.Errorf("No physical interface found on edge %s with name %q or port label %q", edgeId, name, portLabel))
+	})
+}
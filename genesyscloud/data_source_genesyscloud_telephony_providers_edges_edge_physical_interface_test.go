@@ -0,0 +1,46 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEdgePhysicalInterface(t *testing.T) {
+	t.Skip("Skipping because a paired edge device is needed to read its physical interfaces")
+	var (
+		edgeId          = "edge-id"
+		interfaceData   = "physicalInterface"
+		interfaceName   = "eth0"
+		interfacePortId = "WAN"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateEdgePhysicalInterfaceDataSource(interfaceData, edgeId, "name", interfaceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_providers_edges_edge_physical_interface."+interfaceData, "name", interfaceName),
+					resource.TestCheckResourceAttrSet("data.genesyscloud_telephony_providers_edges_edge_physical_interface."+interfaceData, "hardware_address"),
+				),
+			},
+			{
+				Config: generateEdgePhysicalInterfaceDataSource(interfaceData, edgeId, "port_label", interfacePortId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_providers_edges_edge_physical_interface."+interfaceData, "port_label", interfacePortId),
+				),
+			},
+		},
+	})
+}
+
+func generateEdgePhysicalInterfaceDataSource(resourceID, edgeId, lookupAttr, lookupValue string) string {
+	return fmt.Sprintf(`data "genesyscloud_telephony_providers_edges_edge_physical_interface" "%s" {
+		edge_id = "%s"
+		%s = "%s"
+	}
+	`, resourceID, edgeId, lookupAttr, lookupValue)
+}
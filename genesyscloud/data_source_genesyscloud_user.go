@@ -2,7 +2,11 @@ package genesyscloud
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,20 +17,54 @@ import (
 
 func dataSourceUser() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for Genesys Cloud Users. Select a user by email or name.",
+		Description: "Data source for Genesys Cloud Users. Select a user by email, name, employee ID, manager or external ID. If more than one search attribute is set, a user must match all of them. All other user attributes are exported as read-only attributes.",
 		ReadContext: readWithPooledClient(dataSourceUserRead),
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceSchemaFromResource(resourceUser(), map[string]*schema.Schema{
 			"email": {
 				Description: "User email.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"name": {
 				Description: "User name.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
-		},
+			"employee_id": {
+				Description: "Employee ID set in the user's employer info.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"manager": {
+				Description: "User ID of the user's manager. If the manager has several reports, the first one sorted by email is selected.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"external_id": {
+				Description:   "External identifier of the user, as assigned by an external authority such as an HR or directory system.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"email", "name", "employee_id", "manager"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authority_name": {
+							Description: "Name of the authority that assigned the external key.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"external_key": {
+							Description: "External key of the user within the authority.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+		}, "password"),
 	}
 }
 
@@ -34,23 +72,37 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 	sdkConfig := m.(*ProviderMeta).ClientConfig
 	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
 
+	if externalIds := d.Get("external_id").([]interface{}); len(externalIds) > 0 && externalIds[0] != nil {
+		externalId := externalIds[0].(map[string]interface{})
+		return dataSourceUserReadByExternalId(ctx, d, sdkConfig, usersAPI, externalId["authority_name"].(string), externalId["external_key"].(string))
+	}
+
 	exactSearchType := "EXACT"
 	sortOrderAsc := "ASC"
 	emailField := "email"
-	nameField := "name"
 
-	searchCriteria := platformclientv2.Usersearchcriteria{
-		VarType: &exactSearchType,
+	// Each set attribute adds a criteria to the search. All criteria must match.
+	searchFields := []struct {
+		attr  string
+		field string
+	}{
+		{"email", emailField},
+		{"name", "name"},
+		{"employee_id", "employerInfo.employeeId"},
+		{"manager", "manager.id"},
 	}
-	if email, ok := d.GetOk("email"); ok {
-		emailStr := email.(string)
-		searchCriteria.Fields = &[]string{emailField}
-		searchCriteria.Value = &emailStr
-	} else if name, ok := d.GetOk("name"); ok {
-		nameStr := name.(string)
-		searchCriteria.Fields = &[]string{nameField}
-		searchCriteria.Value = &nameStr
-	} else {
+	var searchCriteria []platformclientv2.Usersearchcriteria
+	for _, searchField := range searchFields {
+		if value, ok := d.GetOk(searchField.attr); ok {
+			valueStr := value.(string)
+			searchCriteria = append(searchCriteria, platformclientv2.Usersearchcriteria{
+				VarType: &exactSearchType,
+				Fields:  &[]string{searchField.field},
+				Value:   &valueStr,
+			})
+		}
+	}
+	if len(searchCriteria) == 0 {
 		return diag.Errorf("No user search field specified")
 	}
 
@@ -59,19 +111,89 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 		users, _, getErr := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
 			SortBy:    &emailField,
 			SortOrder: &sortOrderAsc,
-			Query:     &[]platformclientv2.Usersearchcriteria{searchCriteria},
+			Query:     &searchCriteria,
 		})
 		if getErr != nil {
 			return resource.NonRetryableError(fmt.Errorf("Error requesting users: %s", getErr))
 		}
 
 		if users.Results == nil || len(*users.Results) == 0 {
-			return resource.RetryableError(fmt.Errorf("No users found with search criteria %v", searchCriteria))
+			return resource.RetryableError(fmt.Errorf("No users found with search criteria %s", formatUserSearchCriteria(searchCriteria)))
 		}
 
 		// Select first user in the list
 		user := (*users.Results)[0]
 		d.SetId(*user.Id)
-		return nil
+		return readUserDataSourceAttributes(d, usersAPI)
+	})
+}
+
+func dataSourceUserReadByExternalId(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration, usersAPI *platformclientv2.UsersApi, authorityName string, externalKey string) diag.Diagnostics {
+	// Retry in case the external ID was only just assigned
+	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		user, resp, getErr := getUserByExternalId(sdkConfig, authorityName, externalKey)
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("No user found with external key %s in authority %s", externalKey, authorityName))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error requesting user with external key %s in authority %s: %s", externalKey, authorityName, getErr))
+		}
+
+		d.SetId(*user.Id)
+		return readUserDataSourceAttributes(d, usersAPI)
 	})
 }
+
+func formatUserSearchCriteria(searchCriteria []platformclientv2.Usersearchcriteria) string {
+	criteria := make([]string, len(searchCriteria))
+	for i, c := range searchCriteria {
+		criteria[i] = fmt.Sprintf("%s=%s", (*c.Fields)[0], *c.Value)
+	}
+	return fmt.Sprintf("%v", criteria)
+}
+
+// The SDK does not include GET /api/v2/users/external/{authorityName}/{externalKey}.
+// This function makes the call directly with the SDK's API client.
+func getUserByExternalId(sdkConfig *platformclientv2.Configuration, authorityName string, externalKey string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	headerParams := make(map[string]string)
+	if sdkConfig.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + sdkConfig.AccessToken
+	}
+	// add default headers if any
+	for key := range sdkConfig.DefaultHeader {
+		headerParams[key] = sdkConfig.DefaultHeader[key]
+	}
+
+	// set Accept header
+	httpHeaderAccept := sdkConfig.APIClient.SelectHeaderAccept([]string{
+		"application/json",
+	})
+	if httpHeaderAccept != "" {
+		headerParams["Accept"] = httpHeaderAccept
+	}
+	var successPayload *platformclientv2.User
+	path := sdkConfig.BasePath + "/api/v2/users/external/" + url.PathEscape(authorityName) + "/" + url.PathEscape(externalKey)
+	response, err := sdkConfig.APIClient.CallAPI(path, http.MethodGet, nil, headerParams, nil, nil, "", nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.Error != nil {
+		err = errors.New(response.ErrorMessage)
+	} else {
+		err = json.Unmarshal(response.RawBody, &successPayload)
+	}
+	return successPayload, response, err
+}
+
+func readUserDataSourceAttributes(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) *resource.RetryError {
+	user, _, getErr := usersAPI.GetUser(d.Id(), userExpands, "", "")
+	if getErr != nil {
+		return resource.NonRetryableError(fmt.Errorf("Error reading user %s: %s", d.Id(), getErr))
+	}
+
+	if diagErr := flattenUser(d, user, usersAPI); diagErr != nil {
+		return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
+	}
+	return nil
+}
@@ -2,10 +2,13 @@ package genesyscloud
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
 )
 
 func TestAccDataSourceUser(t *testing.T) {
@@ -14,6 +17,23 @@ func TestAccDataSourceUser(t *testing.T) {
 		userDataSource = "test-user-data"
 		userEmail      = "terraform-" + uuid.NewString() + "@example.com"
 		userName       = "John Data-" + uuid.NewString()
+
+		managerResource = "test-user-manager"
+		managerEmail    = "terraform-manager-" + uuid.NewString() + "@example.com"
+		managerName     = "Manager Data-" + uuid.NewString()
+		employeeId      = "EMP-" + uuid.NewString()
+		authorityName   = "terraform-test"
+		externalKey     = "EXT-" + uuid.NewString()
+		// Report of the manager with an employee ID in its employer info
+		reportUserConfig = fmt.Sprintf(`resource "genesyscloud_user" "%s" {
+		email = "%s"
+		name = "%s"
+		manager = genesyscloud_user.%s.id
+		employer_info {
+			employee_id = "%s"
+		}
+	}
+	`, userResource, userEmail, userName, managerResource, employeeId)
 	)
 
 	resource.Test(t, resource.TestCase{
@@ -34,6 +54,9 @@ func TestAccDataSourceUser(t *testing.T) {
 				),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("data.genesyscloud_user."+userDataSource, "id", "genesyscloud_user."+userResource, "id"),
+					resource.TestCheckResourceAttr("data.genesyscloud_user."+userDataSource, "name", userName),
+					resource.TestCheckResourceAttr("data.genesyscloud_user."+userDataSource, "state", "active"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_user."+userDataSource, "division_id", "genesyscloud_user."+userResource, "division_id"),
 				),
 			},
 			{
@@ -50,12 +73,84 @@ func TestAccDataSourceUser(t *testing.T) {
 				),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("data.genesyscloud_user."+userDataSource, "id", "genesyscloud_user."+userResource, "id"),
+					resource.TestCheckResourceAttr("data.genesyscloud_user."+userDataSource, "email", userEmail),
+				),
+			},
+			{
+				// Search by employee ID
+				Config: GenerateBasicUserResource(
+					managerResource,
+					managerEmail,
+					managerName,
+				) + reportUserConfig + generateUserDataSourceByAttr(
+					userDataSource,
+					"employee_id",
+					strconv.Quote(employeeId),
+					"genesyscloud_user."+userResource,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_user."+userDataSource, "id", "genesyscloud_user."+userResource, "id"),
+					resource.TestCheckResourceAttr("data.genesyscloud_user."+userDataSource, "employer_info.0.employee_id", employeeId),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_user."+userDataSource, "manager", "genesyscloud_user."+managerResource, "id"),
+				),
+			},
+			{
+				// Search by manager
+				Config: GenerateBasicUserResource(
+					managerResource,
+					managerEmail,
+					managerName,
+				) + reportUserConfig + generateUserDataSourceByAttr(
+					userDataSource,
+					"manager",
+					"genesyscloud_user."+managerResource+".id",
+					"genesyscloud_user."+userResource,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_user."+userDataSource, "id", "genesyscloud_user."+userResource, "id"),
+					// The external ID is assigned outside of the user resource
+					testAddUserExternalId("genesyscloud_user."+userResource, authorityName, externalKey),
+				),
+			},
+			{
+				// Search by external ID
+				Config: GenerateBasicUserResource(
+					managerResource,
+					managerEmail,
+					managerName,
+				) + reportUserConfig + generateUserDataSourceByExternalId(
+					userDataSource,
+					authorityName,
+					externalKey,
+					"genesyscloud_user."+userResource,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_user."+userDataSource, "id", "genesyscloud_user."+userResource, "id"),
+					resource.TestCheckResourceAttr("data.genesyscloud_user."+userDataSource, "email", userEmail),
 				),
 			},
 		},
 	})
 }
 
+func testAddUserExternalId(resourceName string, authorityName string, externalKey string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		userResource, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find user %s in state", resourceName)
+		}
+		usersAPI := platformclientv2.NewUsersApi()
+		_, _, err := usersAPI.PostUserExternalid(userResource.Primary.ID, platformclientv2.Userexternalidentifier{
+			AuthorityName: &authorityName,
+			ExternalKey:   &externalKey,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to add external ID to user %s: %s", userResource.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
 func generateUserDataSource(
 	resourceID string,
 	email string,
@@ -70,3 +165,30 @@ func generateUserDataSource(
 	}
 	`, resourceID, email, name, dependsOnResource)
 }
+
+func generateUserDataSourceByAttr(
+	resourceID string,
+	attr string,
+	value string,
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_user" "%s" {
+		%s = %s
+		depends_on=[%s]
+	}
+	`, resourceID, attr, value, dependsOnResource)
+}
+
+func generateUserDataSourceByExternalId(
+	resourceID string,
+	authorityName string,
+	externalKey string,
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_user" "%s" {
+		external_id {
+			authority_name = "%s"
+			external_key = "%s"
+		}
+		depends_on=[%s]
+	}
+	`, resourceID, authorityName, externalKey, dependsOnResource)
+}
@@ -0,0 +1,113 @@
+package genesyscloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing Genesys Cloud Users. Select users by name regex, division and state.",
+		ReadContext: readWithPooledClient(dataSourceUsersRead),
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexFilterSchema("users"),
+			"division_id": {
+				Description: "Only return users in this division.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"state": {
+				Description:  "Only return users in this state (active | inactive | any).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive", "any"}, false),
+			},
+			"users": {
+				Description: "Users matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "User ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "User's full name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "User's primary email.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"division_id": {
+							Description: "The division to which this user belongs.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"state": {
+							Description: "User's state (active | inactive).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	nameFilter, err := getNameRegexFilter(d)
+	if err != nil {
+		return diag.Errorf("Invalid name_regex: %s", err)
+	}
+	divisionId := d.Get("division_id").(string)
+
+	users, err := getAllSdkUsers(usersAPI, d.Get("state").(string))
+	if err != nil {
+		return diag.Errorf("Error requesting users: %s", err)
+	}
+
+	userList := make([]interface{}, 0)
+	for _, user := range users {
+		if !matchesNameRegexFilter(nameFilter, user.Name) {
+			continue
+		}
+		if divisionId != "" && (user.Division == nil || user.Division.Id == nil || *user.Division.Id != divisionId) {
+			continue
+		}
+
+		userMap := map[string]interface{}{
+			"id": *user.Id,
+		}
+		if user.Name != nil {
+			userMap["name"] = *user.Name
+		}
+		if user.Email != nil {
+			userMap["email"] = *user.Email
+		}
+		if user.Division != nil && user.Division.Id != nil {
+			userMap["division_id"] = *user.Division.Id
+		}
+		if user.State != nil {
+			userMap["state"] = *user.State
+		}
+		userList = append(userList, userMap)
+	}
+
+	d.SetId(pluralDataSourceId(d, "name_regex", "division_id", "state"))
+	d.Set("users", userList)
+	return nil
+}
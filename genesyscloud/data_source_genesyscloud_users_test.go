@@ -0,0 +1,60 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceUsers(t *testing.T) {
+	var (
+		userNamePrefix  = "Terraform Users-" + uuid.NewString()
+		userResource1   = "test-user-1"
+		userResource2   = "test-user-2"
+		userDataSource  = "test-users"
+		userEmail1      = "terraform-users-1-" + uuid.NewString() + "@example.com"
+		userEmail2      = "terraform-users-2-" + uuid.NewString() + "@example.com"
+		userDataSrcPath = "data.genesyscloud_users." + userDataSource
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateUserResource(userResource1, userEmail1, userNamePrefix+"-1", strconv.Quote("active"), nullValue, nullValue, nullValue, nullValue, "", "") +
+					generateUserResource(userResource2, userEmail2, userNamePrefix+"-2", strconv.Quote("inactive"), nullValue, nullValue, nullValue, nullValue, "", "") +
+					generateUsersDataSource(
+						userDataSource,
+						"^"+userNamePrefix,
+						"inactive",
+						"genesyscloud_user."+userResource1+", genesyscloud_user."+userResource2,
+					),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(userDataSrcPath, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(userDataSrcPath, "users.0.id", "genesyscloud_user."+userResource2, "id"),
+					resource.TestCheckResourceAttr(userDataSrcPath, "users.0.email", userEmail2),
+					resource.TestCheckResourceAttr(userDataSrcPath, "users.0.state", "inactive"),
+				),
+			},
+		},
+	})
+}
+
+func generateUsersDataSource(
+	resourceID string,
+	nameRegex string,
+	state string,
+	// Must explicitly use depends_on in terraform v0.13 when a data source references a resource
+	// Fixed in v0.14 https://github.com/hashicorp/terraform/pull/26284
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_users" "%s" {
+		name_regex = "%s"
+		state = "%s"
+		depends_on = [%s]
+	}
+	`, resourceID, nameRegex, state, dependsOnResource)
+}
@@ -0,0 +1,122 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func dataSourceWebDeploymentsConfigurationVersions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the version history of a Genesys Cloud Web Deployments Configuration. Lists the published versions, newest first.",
+		ReadContext: readWithPooledClient(dataSourceConfigurationVersionsRead),
+		Schema: map[string]*schema.Schema{
+			"configuration_id": {
+				Description: "The ID of the configuration.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"latest_version": {
+				Description: "The latest published version of the configuration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"versions": {
+				Description: "The published versions of the configuration, newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Description: "The version number.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the configuration in this version.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the configuration in this version.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of this version.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"date_published": {
+							Description: "The date this version was published in ISO-8601 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"published_user_id": {
+							Description: "The ID of the user that published this version.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConfigurationVersionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	api := platformclientv2.NewWebDeploymentsApiWithConfig(sdkConfig)
+
+	configurationId := d.Get("configuration_id").(string)
+
+	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		versions, resp, err := getPublishedConfigurationVersions(api, configurationId)
+		if err != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to find web deployment configuration %s: %s", configurationId, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error retrieving versions of web deployment configuration %s: %s", configurationId, err))
+		}
+
+		d.SetId(configurationId)
+		if len(versions) > 0 {
+			d.Set("latest_version", *versions[0].Version)
+		} else {
+			d.Set("latest_version", nil)
+		}
+		d.Set("versions", flattenConfigurationVersions(versions))
+		return nil
+	})
+}
+
+func flattenConfigurationVersions(versions []platformclientv2.Webdeploymentconfigurationversion) []interface{} {
+	versionList := make([]interface{}, 0, len(versions))
+	for _, v := range versions {
+		versionMap := map[string]interface{}{
+			"version": *v.Version,
+		}
+		if v.Name != nil {
+			versionMap["name"] = *v.Name
+		}
+		if v.Description != nil {
+			versionMap["description"] = *v.Description
+		}
+		if v.Status != nil {
+			versionMap["status"] = *v.Status
+		}
+		if v.DatePublished != nil {
+			versionMap["date_published"] = v.DatePublished.UTC().Format(time.RFC3339)
+		}
+		if v.PublishedUser != nil && v.PublishedUser.Id != nil {
+			versionMap["published_user_id"] = *v.PublishedUser.Id
+		}
+		versionList = append(versionList, versionMap)
+	}
+	return versionList
+}
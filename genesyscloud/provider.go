@@ -78,9 +78,11 @@ func registerResources() {
 	RegisterResource("genesyscloud_idp_adfs", resourceIdpAdfs())
 	RegisterResource("genesyscloud_idp_generic", resourceIdpGeneric())
 	RegisterResource("genesyscloud_idp_gsuite", resourceIdpGsuite())
+	RegisterResource("genesyscloud_idp_identitynow", resourceIdpIdentitynow())
 	RegisterResource("genesyscloud_idp_okta", resourceIdpOkta())
 	RegisterResource("genesyscloud_idp_onelogin", resourceIdpOnelogin())
 	RegisterResource("genesyscloud_idp_ping", resourceIdpPing())
+	RegisterResource("genesyscloud_idp_pureengage", resourceIdpPureengage())
 	RegisterResource("genesyscloud_idp_salesforce", resourceIdpSalesforce())
 	RegisterResource("genesyscloud_integration", resourceIntegration())
 	RegisterResource("genesyscloud_integration_action", resourceIntegrationAction())
@@ -93,11 +95,13 @@ func registerResources() {
 	RegisterResource("genesyscloud_knowledge_document", resourceKnowledgeDocument())
 	RegisterResource("genesyscloud_knowledge_v1_document", resourceKnowledgeDocumentV1())
 	RegisterResource("genesyscloud_knowledge_document_variation", resourceKnowledgeDocumentVariation())
+	RegisterResource("genesyscloud_knowledge_import", resourceKnowledgeImport())
 	RegisterResource("genesyscloud_knowledge_category", resourceKnowledgeCategory())
 	RegisterResource("genesyscloud_knowledge_v1_category", resourceKnowledgeCategoryV1())
 	RegisterResource("genesyscloud_knowledge_label", resourceKnowledgeLabel())
 	RegisterResource("genesyscloud_location", resourceLocation())
 	RegisterResource("genesyscloud_recording_media_retention_policy", resourceMediaRetentionPolicy())
+	RegisterResource("genesyscloud_recording_media_retention_policy_document", resourceMediaRetentionPolicyDocument())
 	RegisterResource("genesyscloud_oauth_client", resourceOAuthClient())
 	RegisterResource("genesyscloud_outbound_campaignrule", resourceOutboundCampaignRule())
 	RegisterResource("genesyscloud_outbound_attempt_limit", resourceOutboundAttemptLimit())
@@ -106,8 +110,10 @@ func registerResources() {
 	RegisterResource("genesyscloud_outbound_contactlistfilter", resourceOutboundContactListFilter())
 	RegisterResource("genesyscloud_outbound_callabletimeset", resourceOutboundCallabletimeset())
 	RegisterResource("genesyscloud_outbound_contact_list", resourceOutboundContactList())
+	RegisterResource("genesyscloud_outbound_digitalruleset", resourceOutboundDigitalruleset())
 	RegisterResource("genesyscloud_outbound_ruleset", resourceOutboundRuleset())
 	RegisterResource("genesyscloud_outbound_messagingcampaign", resourceOutboundMessagingCampaign())
+	RegisterResource("genesyscloud_outbound_schedule", resourceOutboundSchedule())
 	RegisterResource("genesyscloud_outbound_sequence", resourceOutboundSequence())
 	RegisterResource("genesyscloud_outbound_settings", resourceOutboundSettings())
 	RegisterResource("genesyscloud_outbound_wrapupcodemappings", resourceOutboundWrapUpCodeMappings())
@@ -130,11 +136,17 @@ func registerResources() {
 	RegisterResource("genesyscloud_routing_utilization", resourceRoutingUtilization())
 	RegisterResource("genesyscloud_routing_wrapupcode", resourceRoutingWrapupCode())
 	RegisterResource("genesyscloud_script", resourceScript())
+	RegisterResource("genesyscloud_telephony_providers_edges_did_assignment", resourceDidAssignment())
 	RegisterResource("genesyscloud_telephony_providers_edges_did_pool", resourceTelephonyDidPool())
+	RegisterResource("genesyscloud_telephony_providers_edges_edge", resourceEdge())
 	RegisterResource("genesyscloud_telephony_providers_edges_edge_group", resourceEdgeGroup())
+	RegisterResource("genesyscloud_telephony_providers_edges_edge_logical_interface", resourceEdgeLogicalInterface())
 	RegisterResource("genesyscloud_telephony_providers_edges_extension_pool", resourceTelephonyExtensionPool())
 	RegisterResource("genesyscloud_telephony_providers_edges_phone", resourcePhone())
+	RegisterResource("genesyscloud_telephony_providers_edges_phones_bulk", resourcePhonesBulk())
 	RegisterResource("genesyscloud_telephony_providers_edges_site", resourceSite())
+	RegisterResource("genesyscloud_telephony_providers_edges_site_number_plan", resourceSiteNumberPlan())
+	RegisterResource("genesyscloud_telephony_providers_edges_site_outbound_route", resourceSiteOutboundRoute())
 	RegisterResource("genesyscloud_telephony_providers_edges_phonebasesettings", resourcePhoneBaseSettings())
 	RegisterResource("genesyscloud_telephony_providers_edges_trunkbasesettings", resourceTrunkBaseSettings())
 	RegisterResource("genesyscloud_telephony_providers_edges_trunk", resourceTrunk())
@@ -158,11 +170,14 @@ func registerDataSources() {
 	RegisterDataSource("genesyscloud_employeeperformance_externalmetrics_definitions", dataSourceEmployeeperformanceExternalmetricsDefinition())
 	RegisterDataSource("genesyscloud_externalcontacts_contact", dataSourceExternalContactsContact())
 	RegisterDataSource("genesyscloud_flow", dataSourceFlow())
+	RegisterDataSource("genesyscloud_flows", dataSourceFlows())
 	RegisterDataSource("genesyscloud_flow_milestone", dataSourceFlowMilestone())
 	RegisterDataSource("genesyscloud_flow_outcome", dataSourceFlowOutcome())
 	RegisterDataSource("genesyscloud_group", dataSourceGroup())
+	RegisterDataSource("genesyscloud_groups", dataSourceGroups())
 	RegisterDataSource("genesyscloud_integration", dataSourceIntegration())
 	RegisterDataSource("genesyscloud_integration_action", dataSourceIntegrationAction())
+	RegisterDataSource("genesyscloud_integration_action_test", dataSourceIntegrationActionTest())
 	RegisterDataSource("genesyscloud_integration_credential", dataSourceIntegrationCredential())
 	RegisterDataSource("genesyscloud_journey_action_map", dataSourceJourneyActionMap())
 	RegisterDataSource("genesyscloud_journey_action_template", dataSourceJourneyActionTemplate())
@@ -187,15 +202,19 @@ func registerDataSources() {
 	RegisterDataSource("genesyscloud_outbound_sequence", dataSourceOutboundSequence())
 	RegisterDataSource("genesyscloud_outbound_dnclist", dataSourceOutboundDncList())
 	RegisterDataSource("genesyscloud_quality_forms_evaluation", dataSourceQualityFormsEvaluations())
+	RegisterDataSource("genesyscloud_quality_forms_evaluation_versions", dataSourceQualityFormsEvaluationVersions())
 	RegisterDataSource("genesyscloud_quality_forms_survey", dataSourceQualityFormsSurvey())
+	RegisterDataSource("genesyscloud_quality_forms_survey_versions", dataSourceQualityFormsSurveyVersions())
 	RegisterDataSource("genesyscloud_recording_media_retention_policy", dataSourceRecordingMediaRetentionPolicy())
 	RegisterDataSource("genesyscloud_responsemanagement_library", dataSourceResponsemanagementLibrary())
 	RegisterDataSource("genesyscloud_responsemanagement_response", dataSourceResponsemanagementResponse())
 	RegisterDataSource("genesyscloud_responsemanagement_responseasset", dataSourceResponseManagamentResponseAsset())
 	RegisterDataSource("genesyscloud_routing_language", dataSourceRoutingLanguage())
 	RegisterDataSource("genesyscloud_routing_queue", dataSourceRoutingQueue())
+	RegisterDataSource("genesyscloud_routing_queues", dataSourceRoutingQueues())
 	RegisterDataSource("genesyscloud_routing_settings", dataSourceRoutingSettings())
 	RegisterDataSource("genesyscloud_routing_skill", dataSourceRoutingSkill())
+	RegisterDataSource("genesyscloud_routing_skills", dataSourceRoutingSkills())
 	RegisterDataSource("genesyscloud_routing_skill_group", dataSourceRoutingSkillGroup())
 	RegisterDataSource("genesyscloud_routing_sms_address", dataSourceRoutingSmsAddress())
 	RegisterDataSource("genesyscloud_routing_email_domain", dataSourceRoutingEmailDomain())
@@ -203,9 +222,12 @@ func registerDataSources() {
 	RegisterDataSource("genesyscloud_script", dataSourceScript())
 	RegisterDataSource("genesyscloud_station", dataSourceStation())
 	RegisterDataSource("genesyscloud_user", dataSourceUser())
+	RegisterDataSource("genesyscloud_users", dataSourceUsers())
 	RegisterDataSource("genesyscloud_telephony_providers_edges_did", dataSourceDid())
 	RegisterDataSource("genesyscloud_telephony_providers_edges_did_pool", dataSourceDidPool())
+	RegisterDataSource("genesyscloud_telephony_providers_edges_dids", dataSourceDids())
 	RegisterDataSource("genesyscloud_telephony_providers_edges_edge_group", dataSourceEdgeGroup())
+	RegisterDataSource("genesyscloud_telephony_providers_edges_edge_physical_interface", dataSourceEdgePhysicalInterface())
 	RegisterDataSource("genesyscloud_telephony_providers_edges_extension_pool", dataSourceExtensionPool())
 	RegisterDataSource("genesyscloud_telephony_providers_edges_site", dataSourceSite())
 	RegisterDataSource("genesyscloud_telephony_providers_edges_linebasesettings", dataSourceLineBaseSettings())
@@ -214,6 +236,7 @@ func registerDataSources() {
 	RegisterDataSource("genesyscloud_telephony_providers_edges_trunk", dataSourceTrunk())
 	RegisterDataSource("genesyscloud_telephony_providers_edges_trunkbasesettings", dataSourceTrunkBaseSettings())
 	RegisterDataSource("genesyscloud_webdeployments_configuration", dataSourceWebDeploymentsConfiguration())
+	RegisterDataSource("genesyscloud_webdeployments_configuration_versions", dataSourceWebDeploymentsConfigurationVersions())
 	RegisterDataSource("genesyscloud_webdeployments_deployment", dataSourceWebDeploymentsDeployment())
 	RegisterDataSource("genesyscloud_widget_deployment", dataSourceWidgetDeployments())
 }
@@ -244,6 +267,7 @@ func New(version string) func() *schema.Provider {
 					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ACCESS_TOKEN", nil),
 					Description: "A string that the OAuth client uses to make requests. Can be set with the `GENESYSCLOUD_ACCESS_TOKEN` environment variable.",
+					Sensitive:   true,
 				},
 				"oauthclient_id": {
 					Type:        schema.TypeString,
@@ -319,6 +343,7 @@ func New(version string) func() *schema.Provider {
 											Optional:    true,
 											DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_PROXY_AUTH_PASSWORD", nil),
 											Description: "Password for the Auth can be set with the `GENESYSCLOUD_PROXY_AUTH_PASSWORD` environment variable.",
+											Sensitive:   true,
 										},
 									},
 								},
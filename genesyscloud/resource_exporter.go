@@ -215,84 +215,94 @@ func (r *ResourceExporter) RemoveFieldIfMissing(attribute string, config map[str
 func GetResourceExporters(filter []string) map[string]*ResourceExporter {
 	exporters := map[string]*ResourceExporter{
 		// Add new resources that can be exported here
-		"genesyscloud_architect_datatable":                             architectDatatableExporter(),
-		"genesyscloud_architect_datatable_row":                         architectDatatableRowExporter(),
-		"genesyscloud_architect_emergencygroup":                        architectEmergencyGroupExporter(),
-		"genesyscloud_architect_ivr":                                   architectIvrExporter(),
-		"genesyscloud_architect_schedules":                             architectSchedulesExporter(),
-		"genesyscloud_architect_schedulegroups":                        architectScheduleGroupsExporter(),
-		"genesyscloud_architect_user_prompt":                           architectUserPromptExporter(),
-		"genesyscloud_auth_division":                                   authDivisionExporter(),
-		"genesyscloud_auth_role":                                       authRoleExporter(),
-		"genesyscloud_employeeperformance_externalmetrics_definitions": employeeperformanceExternalmetricsDefinitionExporter(),
-		"genesyscloud_externalcontacts_contact":                        externalContactExporter(),
-		"genesyscloud_flow":                                            flowExporter(),
-		"genesyscloud_flow_milestone":                                  flowMilestoneExporter(),
-		"genesyscloud_flow_outcome":                                    flowOutcomeExporter(),
-		"genesyscloud_group":                                           groupExporter(),
-		"genesyscloud_group_roles":                                     groupRolesExporter(),
-		"genesyscloud_idp_adfs":                                        idpAdfsExporter(),
-		"genesyscloud_idp_generic":                                     idpGenericExporter(),
-		"genesyscloud_idp_gsuite":                                      idpGsuiteExporter(),
-		"genesyscloud_idp_okta":                                        idpOktaExporter(),
-		"genesyscloud_idp_onelogin":                                    idpOneloginExporter(),
-		"genesyscloud_idp_ping":                                        idpPingExporter(),
-		"genesyscloud_idp_salesforce":                                  idpSalesforceExporter(),
-		"genesyscloud_integration":                                     integrationExporter(),
-		"genesyscloud_integration_action":                              integrationActionExporter(),
-		"genesyscloud_integration_credential":                          credentialExporter(),
-		"genesyscloud_journey_action_map":                              journeyActionMapExporter(),
-		"genesyscloud_journey_action_template":                         journeyActionTemplateExporter(),
-		"genesyscloud_journey_outcome":                                 journeyOutcomeExporter(),
-		"genesyscloud_journey_segment":                                 journeySegmentExporter(),
-		"genesyscloud_knowledge_knowledgebase":                         knowledgeKnowledgebaseExporter(),
-		"genesyscloud_knowledge_document":                              knowledgeDocumentExporter(),
-		"genesyscloud_knowledge_category":                              knowledgeCategoryExporter(),
-		"genesyscloud_location":                                        locationExporter(),
-		"genesyscloud_oauth_client":                                    oauthClientExporter(),
-		"genesyscloud_outbound_attempt_limit":                          outboundAttemptLimitExporter(),
-		"genesyscloud_outbound_callanalysisresponseset":                outboundCallAnalysisResponseSetExporter(),
-		"genesyscloud_outbound_callabletimeset":                        outboundCallableTimesetExporter(),
-		"genesyscloud_outbound_campaign":                               outboundCampaignExporter(),
-		"genesyscloud_outbound_contact_list":                           outboundContactListExporter(),
-		"genesyscloud_outbound_contactlistfilter":                      outboundContactListFilterExporter(),
-		"genesyscloud_outbound_ruleset":                                outboundRulesetExporter(),
-		"genesyscloud_outbound_messagingcampaign":                      outboundMessagingcampaignExporter(),
-		"genesyscloud_outbound_sequence":                               outboundSequenceExporter(),
-		"genesyscloud_outbound_dnclist":                                outboundDncListExporter(),
-		"genesyscloud_outbound_campaignrule":                           outboundCampaignRuleExporter(),
-		"genesyscloud_outbound_settings":                               outboundSettingsExporter(),
-		"genesyscloud_outbound_wrapupcodemappings":                     outboundWrapupCodeMappingsExporter(),
-		"genesyscloud_processautomation_trigger":                       processAutomationTriggerExporter(),
-		"genesyscloud_quality_forms_evaluation":                        evaluationFormExporter(),
-		"genesyscloud_quality_forms_survey":                            surveyFormExporter(),
-		"genesyscloud_recording_media_retention_policy":                mediaRetentionPolicyExporter(),
-		"genesyscloud_responsemanagement_library":                      responsemanagementLibraryExporter(),
-		"genesyscloud_responsemanagement_response":                     responsemanagementResponseExporter(),
-		"genesyscloud_routing_email_domain":                            routingEmailDomainExporter(),
-		"genesyscloud_routing_email_route":                             routingEmailRouteExporter(),
-		"genesyscloud_routing_language":                                routingLanguageExporter(),
-		"genesyscloud_routing_queue":                                   routingQueueExporter(),
-		"genesyscloud_routing_settings":                                routingSettingsExporter(),
-		"genesyscloud_routing_skill":                                   routingSkillExporter(),
-		"genesyscloud_routing_skill_group":                             resourceSkillGroupExporter(),
-		"genesyscloud_routing_sms_address":                             routingSmsAddressExporter(),
-		"genesyscloud_routing_utilization":                             routingUtilizationExporter(),
-		"genesyscloud_routing_wrapupcode":                              routingWrapupCodeExporter(),
-		"genesyscloud_script":                                          scriptExporter(),
-		"genesyscloud_telephony_providers_edges_did_pool":              telephonyDidPoolExporter(),
-		"genesyscloud_telephony_providers_edges_edge_group":            edgeGroupExporter(),
-		"genesyscloud_telephony_providers_edges_extension_pool":        telephonyExtensionPoolExporter(),
-		"genesyscloud_telephony_providers_edges_phone":                 phoneExporter(),
-		"genesyscloud_telephony_providers_edges_site":                  siteExporter(),
-		"genesyscloud_telephony_providers_edges_phonebasesettings":     phoneBaseSettingsExporter(),
-		"genesyscloud_telephony_providers_edges_trunkbasesettings":     trunkBaseSettingsExporter(),
-		"genesyscloud_telephony_providers_edges_trunk":                 trunkExporter(),
-		"genesyscloud_user":                                            userExporter(),
-		"genesyscloud_user_roles":                                      userRolesExporter(),
-		"genesyscloud_webdeployments_configuration":                    webDeploymentConfigurationExporter(),
-		"genesyscloud_webdeployments_deployment":                       webDeploymentExporter(),
-		"genesyscloud_widget_deployment":                               widgetDeploymentExporter(),
+		"genesyscloud_architect_datatable":                              architectDatatableExporter(),
+		"genesyscloud_architect_datatable_row":                          architectDatatableRowExporter(),
+		"genesyscloud_architect_emergencygroup":                         architectEmergencyGroupExporter(),
+		"genesyscloud_architect_ivr":                                    architectIvrExporter(),
+		"genesyscloud_architect_schedules":                              architectSchedulesExporter(),
+		"genesyscloud_architect_schedulegroups":                         architectScheduleGroupsExporter(),
+		"genesyscloud_architect_user_prompt":                            architectUserPromptExporter(),
+		"genesyscloud_auth_division":                                    authDivisionExporter(),
+		"genesyscloud_auth_role":                                        authRoleExporter(),
+		"genesyscloud_employeeperformance_externalmetrics_definitions":  employeeperformanceExternalmetricsDefinitionExporter(),
+		"genesyscloud_externalcontacts_contact":                         externalContactExporter(),
+		"genesyscloud_flow":                                             flowExporter(),
+		"genesyscloud_flow_milestone":                                   flowMilestoneExporter(),
+		"genesyscloud_flow_outcome":                                     flowOutcomeExporter(),
+		"genesyscloud_group":                                            groupExporter(),
+		"genesyscloud_group_roles":                                      groupRolesExporter(),
+		"genesyscloud_idp_adfs":                                         idpAdfsExporter(),
+		"genesyscloud_idp_generic":                                      idpGenericExporter(),
+		"genesyscloud_idp_gsuite":                                       idpGsuiteExporter(),
+		"genesyscloud_idp_identitynow":                                  idpIdentitynowExporter(),
+		"genesyscloud_idp_okta":                                         idpOktaExporter(),
+		"genesyscloud_idp_onelogin":                                     idpOneloginExporter(),
+		"genesyscloud_idp_ping":                                         idpPingExporter(),
+		"genesyscloud_idp_pureengage":                                   idpPureengageExporter(),
+		"genesyscloud_idp_salesforce":                                   idpSalesforceExporter(),
+		"genesyscloud_integration":                                      integrationExporter(),
+		"genesyscloud_integration_action":                               integrationActionExporter(),
+		"genesyscloud_integration_credential":                           credentialExporter(),
+		"genesyscloud_journey_action_map":                               journeyActionMapExporter(),
+		"genesyscloud_journey_action_template":                          journeyActionTemplateExporter(),
+		"genesyscloud_journey_outcome":                                  journeyOutcomeExporter(),
+		"genesyscloud_journey_segment":                                  journeySegmentExporter(),
+		"genesyscloud_knowledge_knowledgebase":                          knowledgeKnowledgebaseExporter(),
+		"genesyscloud_knowledge_document":                               knowledgeDocumentExporter(),
+		"genesyscloud_knowledge_document_variation":                     knowledgeDocumentVariationExporter(),
+		"genesyscloud_knowledge_category":                               knowledgeCategoryExporter(),
+		"genesyscloud_location":                                         locationExporter(),
+		"genesyscloud_oauth_client":                                     oauthClientExporter(),
+		"genesyscloud_outbound_attempt_limit":                           outboundAttemptLimitExporter(),
+		"genesyscloud_outbound_callanalysisresponseset":                 outboundCallAnalysisResponseSetExporter(),
+		"genesyscloud_outbound_callabletimeset":                         outboundCallableTimesetExporter(),
+		"genesyscloud_outbound_campaign":                                outboundCampaignExporter(),
+		"genesyscloud_outbound_contact_list":                            outboundContactListExporter(),
+		"genesyscloud_outbound_contactlistfilter":                       outboundContactListFilterExporter(),
+		"genesyscloud_outbound_digitalruleset":                          outboundDigitalrulesetExporter(),
+		"genesyscloud_outbound_ruleset":                                 outboundRulesetExporter(),
+		"genesyscloud_outbound_messagingcampaign":                       outboundMessagingcampaignExporter(),
+		"genesyscloud_outbound_schedule":                                outboundScheduleExporter(),
+		"genesyscloud_outbound_sequence":                                outboundSequenceExporter(),
+		"genesyscloud_outbound_dnclist":                                 outboundDncListExporter(),
+		"genesyscloud_outbound_campaignrule":                            outboundCampaignRuleExporter(),
+		"genesyscloud_outbound_settings":                                outboundSettingsExporter(),
+		"genesyscloud_outbound_wrapupcodemappings":                      outboundWrapupCodeMappingsExporter(),
+		"genesyscloud_processautomation_trigger":                        processAutomationTriggerExporter(),
+		"genesyscloud_quality_forms_evaluation":                         evaluationFormExporter(),
+		"genesyscloud_quality_forms_survey":                             surveyFormExporter(),
+		"genesyscloud_recording_media_retention_policy":                 mediaRetentionPolicyExporter(),
+		"genesyscloud_responsemanagement_library":                       responsemanagementLibraryExporter(),
+		"genesyscloud_responsemanagement_response":                      responsemanagementResponseExporter(),
+		"genesyscloud_routing_email_domain":                             routingEmailDomainExporter(),
+		"genesyscloud_routing_email_route":                              routingEmailRouteExporter(),
+		"genesyscloud_routing_language":                                 routingLanguageExporter(),
+		"genesyscloud_routing_queue":                                    routingQueueExporter(),
+		"genesyscloud_routing_settings":                                 routingSettingsExporter(),
+		"genesyscloud_routing_skill":                                    routingSkillExporter(),
+		"genesyscloud_routing_skill_group":                              resourceSkillGroupExporter(),
+		"genesyscloud_routing_sms_address":                              routingSmsAddressExporter(),
+		"genesyscloud_routing_utilization":                              routingUtilizationExporter(),
+		"genesyscloud_routing_wrapupcode":                               routingWrapupCodeExporter(),
+		"genesyscloud_script":                                           scriptExporter(),
+		"genesyscloud_telephony_providers_edges_did_assignment":         didAssignmentExporter(),
+		"genesyscloud_telephony_providers_edges_did_pool":               telephonyDidPoolExporter(),
+		"genesyscloud_telephony_providers_edges_edge":                   edgeExporter(),
+		"genesyscloud_telephony_providers_edges_edge_group":             edgeGroupExporter(),
+		"genesyscloud_telephony_providers_edges_edge_logical_interface": edgeLogicalInterfaceExporter(),
+		"genesyscloud_telephony_providers_edges_extension_pool":         telephonyExtensionPoolExporter(),
+		"genesyscloud_telephony_providers_edges_phone":                  phoneExporter(),
+		"genesyscloud_telephony_providers_edges_site":                   siteExporter(),
+		"genesyscloud_telephony_providers_edges_site_number_plan":       siteNumberPlanExporter(),
+		"genesyscloud_telephony_providers_edges_site_outbound_route":    siteOutboundRouteExporter(),
+		"genesyscloud_telephony_providers_edges_phonebasesettings":      phoneBaseSettingsExporter(),
+		"genesyscloud_telephony_providers_edges_trunkbasesettings":      trunkBaseSettingsExporter(),
+		"genesyscloud_telephony_providers_edges_trunk":                  trunkExporter(),
+		"genesyscloud_user":                                             userExporter(),
+		"genesyscloud_user_roles":                                       userRolesExporter(),
+		"genesyscloud_webdeployments_configuration":                     webDeploymentConfigurationExporter(),
+		"genesyscloud_webdeployments_deployment":                        webDeploymentExporter(),
+		"genesyscloud_widget_deployment":                                widgetDeploymentExporter(),
 	}
 
 	// Include all if no filters
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 )
 
 /*
@@ -42,8 +43,41 @@ func MemberGroupsResolver(configMap map[string]interface{}, exporters map[string
 	return nil
 }
 
-func FileContentHashResolver(configMap map[string]interface{}, filepath string) error {
-	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256(var.%s)}`, filepath)
+// SiteNumberPlansResolver hands the number plans of an exported site over to the exported genesyscloud_telephony_providers_edges_site_number_plan resources
+func SiteNumberPlansResolver(configMap map[string]interface{}, _ map[string]*ResourceExporter) error {
+	configMap["manage_number_plans"] = false
+	return nil
+}
+
+// SiteOutboundRoutesResolver hands the outbound routes of an exported site over to the exported genesyscloud_telephony_providers_edges_site_outbound_route resources
+func SiteOutboundRoutesResolver(configMap map[string]interface{}, _ map[string]*ResourceExporter) error {
+	configMap["manage_outbound_routes"] = false
+	return nil
+}
+
+// DidOwnerResolver resolves the owner of a DID assignment to the exported resource of its owner type
+func DidOwnerResolver(configMap map[string]interface{}, exporters map[string]*ResourceExporter) error {
+	ownerTypes := map[string]string{
+		didOwnerUser:  "genesyscloud_user",
+		didOwnerPhone: "genesyscloud_telephony_providers_edges_phone",
+		didOwnerIvr:   "genesyscloud_architect_ivr",
+		didOwnerGroup: "genesyscloud_group",
+	}
+
+	ownerType, _ := configMap["owner_type"].(string)
+	ownerID, _ := configMap["owner_id"].(string)
+
+	resourceType, ok := ownerTypes[ownerType]
+	if !ok {
+		return fmt.Errorf("the DID owner type %s cannot be resolved to a reference attribute", ownerType)
+	}
+	exporter, ok := exporters[resourceType]
+	if !ok {
+		return fmt.Errorf("unable to locate %s in the exporters array. Unable to resolve the ID for the DID owner", resourceType)
+	}
+	if owner, ok := exporter.SanitizedResourceMap[ownerID]; ok {
+		configMap["owner_id"] = fmt.Sprintf("${%s.%s.id}", resourceType, owner.Name)
+	}
 	return nil
 }
 
@@ -91,3 +125,82 @@ func ScriptResolver(scriptId, exportDirectory, subDirectory string, configMap ma
 
 	return err
 }
+
+func FlowResolver(flowId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}) error {
+	exportFileName := fmt.Sprintf("flow-%s.yaml", flowId)
+
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	url, err := getFlowExportUrl(flowId, meta)
+	if err != nil {
+		return err
+	}
+
+	if err := downloadExportFile(fullPath, exportFileName, url); err != nil {
+		return err
+	}
+
+	// Update filepath field in configMap to point to exported flow file
+	configMap["filepath"] = path.Join(subDirectory, exportFileName)
+
+	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, path.Join(subDirectory, exportFileName))
+
+	return err
+}
+
+// KnowledgeBaseExportResolver downloads the documents of a knowledge base with the knowledge export job API.
+// The exported file can be imported into another org with the genesyscloud_knowledge_import resource.
+func KnowledgeBaseExportResolver(knowledgeBaseId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}) error {
+	exportFileName := fmt.Sprintf("knowledgebase-%s.json", knowledgeBaseId)
+
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	url, err := getKnowledgeBaseExportUrl(knowledgeBaseId, meta)
+	if err != nil {
+		return err
+	}
+
+	return downloadExportFile(fullPath, exportFileName, url)
+}
+
+func KnowledgeDocumentVariationResolver(variationResourceId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}) error {
+	variations, _ := configMap["knowledge_document_variation"].([]interface{})
+	if len(variations) == 0 {
+		return nil
+	}
+	variationMap, ok := variations[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	published, _ := configMap["published"].(bool)
+
+	body, err := getKnowledgeDocumentVariationBody(variationResourceId, published, meta)
+	if err != nil {
+		return err
+	}
+
+	exportFileName := fmt.Sprintf("variation-%s.md", strings.Split(variationResourceId, " ")[0])
+
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path.Join(fullPath, exportFileName), []byte(documentBodyToMarkdown(body)), 0644); err != nil {
+		return err
+	}
+
+	// Replace the body blocks with the exported Markdown file
+	delete(variationMap, "body")
+	variationMap["body_filepath"] = path.Join(subDirectory, exportFileName)
+
+	variationMap["body_file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, path.Join(subDirectory, exportFileName))
+
+	return nil
+}
@@ -64,3 +64,22 @@ func TestAccExporterCustomMemberGroup(t *testing.T) {
 	}
 
 }
+
+func TestExporterCustomSiteManagedChildren(t *testing.T) {
+	configMap := map[string]interface{}{
+		"name":                   "Site",
+		"manage_number_plans":    true,
+		"manage_outbound_routes": true,
+	}
+
+	if err := SiteNumberPlansResolver(configMap, nil); err != nil {
+		t.Errorf("Received an unexpected error while calling SiteNumberPlansResolver: %v", err)
+	}
+	if err := SiteOutboundRoutesResolver(configMap, nil); err != nil {
+		t.Errorf("Received an unexpected error while calling SiteOutboundRoutesResolver: %v", err)
+	}
+
+	if configMap["manage_number_plans"] != false || configMap["manage_outbound_routes"] != false {
+		t.Errorf("Expected the exported site to not manage number plans and outbound routes, got %v and %v", configMap["manage_number_plans"], configMap["manage_outbound_routes"])
+	}
+}
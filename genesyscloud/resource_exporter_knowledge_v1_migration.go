@@ -0,0 +1,433 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+/*
+The v1 knowledge APIs (knowledge bases with language based documents and categories) are deprecated. The exporter can generate
+the equivalent v2 configuration (genesyscloud_knowledge_category, genesyscloud_knowledge_label, genesyscloud_knowledge_document and
+genesyscloud_knowledge_document_variation) for the v1 objects of an org so their content can be moved without rewriting it by hand.
+
+v2 documents only have a single category, so any additional v1 categories of a document are migrated as labels with the same name.
+FAQ answers are written to Markdown files and article content is downloaded as HTML. Both are referenced with body_filepath.
+*/
+
+const knowledgeV1MigrationLabelColor = "#0F6EB4"
+
+// KnowledgeV1MigrationResource is a v2 knowledge resource generated from a v1 knowledge object
+type KnowledgeV1MigrationResource struct {
+	Type   string
+	Name   string
+	Config JsonMap
+
+	// The v1 resource this resource replaces. Empty for resources without a v1 equivalent, e.g. labels and variations.
+	V1Type string
+	V1Name string
+	V1Id   string
+}
+
+// KnowledgeV1Migration holds the v2 resources generated for the v1 knowledge objects of an org
+type KnowledgeV1Migration struct {
+	Resources []KnowledgeV1MigrationResource
+
+	// Content of the v1 objects that could not be migrated
+	Notes []string
+
+	nameInUse func(resourceType, name string) bool
+	names     map[string]map[string]bool
+
+	// Resource names of the migrated categories and labels keyed by v1 category ID or label name and knowledge base ID
+	categories map[string]string
+	labels     map[string]string
+}
+
+func newKnowledgeV1Migration(nameInUse func(resourceType, name string) bool) *KnowledgeV1Migration {
+	return &KnowledgeV1Migration{
+		Resources:  make([]KnowledgeV1MigrationResource, 0),
+		Notes:      make([]string, 0),
+		nameInUse:  nameInUse,
+		names:      make(map[string]map[string]bool),
+		categories: make(map[string]string),
+		labels:     make(map[string]string),
+	}
+}
+
+// GetKnowledgeV1Migration reads the v1 categories and documents of all knowledge bases and builds the equivalent v2 resources.
+// Document bodies are written to subDirectory of the export directory. nameInUse reports resource names already used by the export.
+func GetKnowledgeV1Migration(meta interface{}, exportDirectory, subDirectory string, nameInUse func(resourceType, name string) bool) (*KnowledgeV1Migration, diag.Diagnostics) {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+
+	unpublishedKnowledgeBases, err := getAllKnowledgebaseEntities(*knowledgeAPI, false)
+	if err != nil {
+		return nil, err
+	}
+	publishedKnowledgeBases, err := getAllKnowledgebaseEntities(*knowledgeAPI, true)
+	if err != nil {
+		return nil, err
+	}
+
+	migration := newKnowledgeV1Migration(nameInUse)
+	knowledgeBaseIds := make(map[string]bool)
+	for _, knowledgeBase := range append(*unpublishedKnowledgeBases, *publishedKnowledgeBases...) {
+		if knowledgeBase.Id == nil || knowledgeBase.CoreLanguage == nil || knowledgeBaseIds[*knowledgeBase.Id] {
+			continue
+		}
+		knowledgeBaseIds[*knowledgeBase.Id] = true
+
+		categories, err := getAllKnowledgeV1MigrationCategories(knowledgeAPI, *knowledgeBase.Id, *knowledgeBase.CoreLanguage)
+		if err != nil {
+			return nil, err
+		}
+		documents, err := getAllKnowledgeV1MigrationDocuments(knowledgeAPI, *knowledgeBase.Id, *knowledgeBase.CoreLanguage)
+		if err != nil {
+			return nil, err
+		}
+
+		migration.addCategories(categories)
+		for _, document := range documents {
+			migration.addDocument(document, exportDirectory, subDirectory)
+		}
+	}
+
+	return migration, nil
+}
+
+func getAllKnowledgeV1MigrationCategories(knowledgeAPI *platformclientv2.KnowledgeApi, knowledgeBaseId, languageCode string) ([]platformclientv2.Knowledgeextendedcategory, diag.Diagnostics) {
+	var (
+		after      string
+		categories []platformclientv2.Knowledgeextendedcategory
+	)
+
+	const pageSize = 100
+	for {
+		knowledgeCategories, resp, getErr := knowledgeAPI.GetKnowledgeKnowledgebaseLanguageCategories(knowledgeBaseId, languageCode, "", after, "", fmt.Sprintf("%v", pageSize), "")
+		if getErr != nil {
+			if isStatus400(resp) || isStatus404(resp) {
+				// Not a v1 knowledge base
+				return nil, nil
+			}
+			return nil, diag.Errorf("Failed to get page of v1 knowledge categories for knowledge base %s: %v", knowledgeBaseId, getErr)
+		}
+
+		if knowledgeCategories.Entities == nil || len(*knowledgeCategories.Entities) == 0 {
+			break
+		}
+
+		for _, knowledgeCategory := range *knowledgeCategories.Entities {
+			// The listing does not include the parent category
+			category, _, getErr := knowledgeAPI.GetKnowledgeKnowledgebaseLanguageCategory(*knowledgeCategory.Id, knowledgeBaseId, languageCode)
+			if getErr != nil {
+				return nil, diag.Errorf("Failed to get v1 knowledge category %s: %v", *knowledgeCategory.Id, getErr)
+			}
+			categories = append(categories, *category)
+		}
+
+		after = getKnowledgeV1MigrationAfterCursor(knowledgeCategories.NextUri)
+		if after == "" {
+			break
+		}
+	}
+
+	return categories, nil
+}
+
+func getAllKnowledgeV1MigrationDocuments(knowledgeAPI *platformclientv2.KnowledgeApi, knowledgeBaseId, languageCode string) ([]platformclientv2.Knowledgedocument, diag.Diagnostics) {
+	var (
+		after     string
+		documents []platformclientv2.Knowledgedocument
+	)
+
+	const pageSize = 100
+	for {
+		knowledgeDocuments, resp, getErr := knowledgeAPI.GetKnowledgeKnowledgebaseLanguageDocuments(knowledgeBaseId, languageCode, "", after, "", fmt.Sprintf("%v", pageSize), "", "", "", "", nil)
+		if getErr != nil {
+			if isStatus400(resp) || isStatus404(resp) {
+				// Not a v1 knowledge base
+				return nil, nil
+			}
+			return nil, diag.Errorf("Failed to get page of v1 knowledge documents for knowledge base %s: %v", knowledgeBaseId, getErr)
+		}
+
+		if knowledgeDocuments.Entities == nil || len(*knowledgeDocuments.Entities) == 0 {
+			break
+		}
+
+		for _, knowledgeDocument := range *knowledgeDocuments.Entities {
+			// The listing does not include the article content
+			document, _, getErr := knowledgeAPI.GetKnowledgeKnowledgebaseLanguageDocument(*knowledgeDocument.Id, knowledgeBaseId, languageCode)
+			if getErr != nil {
+				return nil, diag.Errorf("Failed to get v1 knowledge document %s: %v", *knowledgeDocument.Id, getErr)
+			}
+			documents = append(documents, *document)
+		}
+
+		after = getKnowledgeV1MigrationAfterCursor(knowledgeDocuments.NextUri)
+		if after == "" {
+			break
+		}
+	}
+
+	return documents, nil
+}
+
+func getKnowledgeV1MigrationAfterCursor(nextUri *string) string {
+	if nextUri == nil || *nextUri == "" {
+		return ""
+	}
+	u, err := url.Parse(*nextUri)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("after")
+}
+
+// resourceName returns a unique sanitized resource name for the v2 resource type. The ID is hashed into the name when it is already taken.
+func (m *KnowledgeV1Migration) resourceName(resourceType, name, id string) string {
+	if m.names[resourceType] == nil {
+		m.names[resourceType] = make(map[string]bool)
+	}
+
+	resourceName := SanitizeResourceName(name)
+	if m.names[resourceType][resourceName] || (m.nameInUse != nil && m.nameInUse(resourceType, resourceName)) {
+		algorithm := fnv.New32()
+		algorithm.Write([]byte(id))
+		resourceName = resourceName + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+	}
+	m.names[resourceType][resourceName] = true
+	return resourceName
+}
+
+// labelResourceName returns the name of the label migrated from a v1 category, adding the label if it does not exist yet
+func (m *KnowledgeV1Migration) labelResourceName(knowledgeBaseId, labelName string) string {
+	key := knowledgeBaseId + " " + labelName
+	if name, ok := m.labels[key]; ok {
+		return name
+	}
+
+	name := m.resourceName("genesyscloud_knowledge_label", labelName, key)
+	m.labels[key] = name
+	m.Resources = append(m.Resources, KnowledgeV1MigrationResource{
+		Type: "genesyscloud_knowledge_label",
+		Name: name,
+		Config: JsonMap{
+			"knowledge_base_id": knowledgeBaseId,
+			"knowledge_label": []interface{}{
+				map[string]interface{}{
+					"name":  escapeKnowledgeV1MigrationText(labelName),
+					"color": knowledgeV1MigrationLabelColor,
+				},
+			},
+		},
+	})
+	return name
+}
+
+// addCategories adds the v2 categories for the v1 categories of a knowledge base. Names are assigned to all categories
+// first so parents can be referenced regardless of the order of the categories.
+func (m *KnowledgeV1Migration) addCategories(categories []platformclientv2.Knowledgeextendedcategory) {
+	for _, category := range categories {
+		key := *category.Id + " " + *category.KnowledgeBase.Id
+		if _, ok := m.categories[key]; !ok {
+			m.categories[key] = m.resourceName("genesyscloud_knowledge_category", valueOrEmpty(category.Name), key)
+		}
+	}
+
+	for _, category := range categories {
+		knowledgeBaseId := *category.KnowledgeBase.Id
+		categoryName := valueOrEmpty(category.Name)
+
+		categoryMap := map[string]interface{}{
+			"name": escapeKnowledgeV1MigrationText(categoryName),
+		}
+		if category.Description != nil && *category.Description != "" {
+			categoryMap["description"] = escapeKnowledgeV1MigrationText(*category.Description)
+		}
+		if category.Parent != nil && category.Parent.Id != nil {
+			if parentName, ok := m.categories[*category.Parent.Id+" "+knowledgeBaseId]; ok {
+				categoryMap["parent_id"] = fmt.Sprintf("${genesyscloud_knowledge_category.%s.id}", parentName)
+			} else {
+				m.Notes = append(m.Notes, fmt.Sprintf("The parent category %s of v1 category %s (%s) was not found. Set parent_id manually.", *category.Parent.Id, categoryName, *category.Id))
+			}
+		}
+
+		m.Resources = append(m.Resources, KnowledgeV1MigrationResource{
+			Type: "genesyscloud_knowledge_category",
+			Name: m.categories[*category.Id+" "+knowledgeBaseId],
+			Config: JsonMap{
+				"knowledge_base_id":  knowledgeBaseId,
+				"knowledge_category": []interface{}{categoryMap},
+			},
+			V1Type: "genesyscloud_knowledge_v1_category",
+			V1Name: SanitizeResourceName(categoryName),
+			V1Id:   fmt.Sprintf("%s %s %s", *category.Id, knowledgeBaseId, valueOrEmpty(category.LanguageCode)),
+		})
+	}
+}
+
+func (m *KnowledgeV1Migration) addDocument(document platformclientv2.Knowledgedocument, exportDirectory, subDirectory string) {
+	knowledgeBaseId := *document.KnowledgeBase.Id
+	v1Id := fmt.Sprintf("%s %s %s", *document.Id, knowledgeBaseId, *document.LanguageCode)
+
+	documentConfig, documentNotes := m.buildDocument(document)
+	documentName := m.resourceName("genesyscloud_knowledge_document", valueOrEmpty(document.Name), v1Id)
+	m.Resources = append(m.Resources, KnowledgeV1MigrationResource{
+		Type:   "genesyscloud_knowledge_document",
+		Name:   documentName,
+		Config: documentConfig,
+		V1Type: "genesyscloud_knowledge_v1_document",
+		V1Name: SanitizeResourceName(valueOrEmpty(document.Name)),
+		V1Id:   v1Id,
+	})
+	m.Notes = append(m.Notes, documentNotes...)
+
+	bodyFilePath, err := writeKnowledgeV1MigrationBody(document, exportDirectory, subDirectory)
+	if err != nil {
+		m.Notes = append(m.Notes, fmt.Sprintf("The content of v1 document %s (%s) could not be migrated: %v. Add a variation manually.", valueOrEmpty(document.Name), *document.Id, err))
+		return
+	}
+
+	m.Resources = append(m.Resources, KnowledgeV1MigrationResource{
+		Type:   "genesyscloud_knowledge_document_variation",
+		Name:   m.resourceName("genesyscloud_knowledge_document_variation", valueOrEmpty(document.Name), v1Id),
+		Config: buildKnowledgeV1MigrationVariation(knowledgeBaseId, documentName, bodyFilePath),
+	})
+}
+
+// buildDocument builds the config of the v2 document for a v1 document. The first v1 category becomes the document
+// category and any additional v1 categories become labels.
+func (m *KnowledgeV1Migration) buildDocument(document platformclientv2.Knowledgedocument) (JsonMap, []string) {
+	knowledgeBaseId := *document.KnowledgeBase.Id
+	notes := make([]string, 0)
+
+	title := valueOrEmpty(document.Name)
+	var alternatives *[]string
+	if document.Faq != nil {
+		if document.Faq.Question != nil && *document.Faq.Question != "" {
+			title = *document.Faq.Question
+		}
+		alternatives = document.Faq.Alternatives
+	}
+	if document.Article != nil {
+		if document.Article.Title != nil && *document.Article.Title != "" {
+			title = *document.Article.Title
+		}
+		alternatives = document.Article.Alternatives
+	}
+
+	documentMap := map[string]interface{}{
+		"title":   escapeKnowledgeV1MigrationText(title),
+		"visible": true,
+	}
+	if alternatives != nil && len(*alternatives) > 0 {
+		alternativeList := make([]interface{}, 0, len(*alternatives))
+		for _, alternative := range *alternatives {
+			alternativeList = append(alternativeList, map[string]interface{}{
+				"phrase":       escapeKnowledgeV1MigrationText(alternative),
+				"autocomplete": false,
+			})
+		}
+		documentMap["alternatives"] = alternativeList
+	}
+
+	if document.Categories != nil {
+		labelNames := make([]interface{}, 0)
+		for i, category := range *document.Categories {
+			categoryName := valueOrEmpty(category.Name)
+			if i == 0 {
+				// Reference the category so it is created before the document
+				if resourceName, ok := m.categories[valueOrEmpty(category.Id)+" "+knowledgeBaseId]; ok {
+					documentMap["category_name"] = fmt.Sprintf("${genesyscloud_knowledge_category.%s.knowledge_category[0].name}", resourceName)
+				} else {
+					documentMap["category_name"] = escapeKnowledgeV1MigrationText(categoryName)
+				}
+				continue
+			}
+			labelName := m.labelResourceName(knowledgeBaseId, categoryName)
+			labelNames = append(labelNames, fmt.Sprintf("${genesyscloud_knowledge_label.%s.knowledge_label[0].name}", labelName))
+		}
+		if len(labelNames) > 0 {
+			documentMap["label_names"] = labelNames
+			notes = append(notes, fmt.Sprintf("v1 document %s (%s) has more than one category. The additional categories were migrated as labels.", valueOrEmpty(document.Name), *document.Id))
+		}
+	}
+
+	if document.ExternalUrl != nil && *document.ExternalUrl != "" {
+		notes = append(notes, fmt.Sprintf("v2 documents do not have an external URL. The external URL %s of v1 document %s (%s) was not migrated.", *document.ExternalUrl, valueOrEmpty(document.Name), *document.Id))
+	}
+
+	return JsonMap{
+		"knowledge_base_id":  knowledgeBaseId,
+		"knowledge_document": []interface{}{documentMap},
+		// The variation publishes the document once it has content
+		"published": false,
+	}, notes
+}
+
+func buildKnowledgeV1MigrationVariation(knowledgeBaseId, documentResourceName, bodyFilePath string) JsonMap {
+	return JsonMap{
+		"knowledge_base_id":     knowledgeBaseId,
+		"knowledge_document_id": fmt.Sprintf("${genesyscloud_knowledge_document.%s.id}", documentResourceName),
+		"published":             true,
+		"knowledge_document_variation": []interface{}{
+			map[string]interface{}{
+				"body_filepath":          bodyFilePath,
+				"body_file_content_hash": fmt.Sprintf(`${filesha256("%s")}`, bodyFilePath),
+			},
+		},
+	}
+}
+
+// writeKnowledgeV1MigrationBody writes the FAQ answer of a v1 document as Markdown or downloads its article content as HTML.
+// Returns the path of the file relative to the export directory.
+func writeKnowledgeV1MigrationBody(document platformclientv2.Knowledgedocument, exportDirectory, subDirectory string) (string, error) {
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	if document.Article != nil && document.Article.Content != nil && document.Article.Content.Body != nil && document.Article.Content.Body.LocationUrl != nil {
+		exportFileName := fmt.Sprintf("v1-document-%s.html", *document.Id)
+		if err := downloadExportFile(fullPath, exportFileName, *document.Article.Content.Body.LocationUrl); err != nil {
+			return "", err
+		}
+		return path.Join(subDirectory, exportFileName), nil
+	}
+
+	if document.Faq != nil && document.Faq.Answer != nil && *document.Faq.Answer != "" {
+		exportFileName := fmt.Sprintf("v1-document-%s.md", *document.Id)
+		if err := os.WriteFile(path.Join(fullPath, exportFileName), []byte(knowledgeV1AnswerToMarkdown(*document.Faq.Answer)), 0644); err != nil {
+			return "", err
+		}
+		return path.Join(subDirectory, exportFileName), nil
+	}
+
+	return "", fmt.Errorf("the document has no FAQ answer or article content")
+}
+
+// knowledgeV1AnswerToMarkdown converts a plain text FAQ answer to Markdown. Blank lines separate paragraphs and line breaks are kept.
+func knowledgeV1AnswerToMarkdown(answer string) string {
+	paragraphs := make([]string, 0)
+	for _, paragraph := range strings.Split(strings.ReplaceAll(answer, "\r\n", "\n"), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			paragraphs = append(paragraphs, escapeMarkdownBlockMarker(escapeMarkdown(paragraph)))
+		}
+	}
+	return strings.Join(paragraphs, "\n\n") + "\n"
+}
+
+// escapeKnowledgeV1MigrationText escapes Terraform template sequences in text copied from v1 objects
+func escapeKnowledgeV1MigrationText(text string) string {
+	escaped := strings.ReplaceAll(text, "${", "$${")
+	return strings.ReplaceAll(escaped, "%{", "%%{")
+}
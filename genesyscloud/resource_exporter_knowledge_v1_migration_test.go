@@ -0,0 +1,134 @@
+package genesyscloud
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKnowledgeV1Migration(t *testing.T) {
+	exportDir := t.TempDir()
+	knowledgeBase := &platformclientv2.Knowledgebase{Id: platformclientv2.String("kb-id")}
+	knowledgeCategory := func(id, name string, parent *platformclientv2.Knowledgecategory) platformclientv2.Knowledgeextendedcategory {
+		return platformclientv2.Knowledgeextendedcategory{
+			Id:            &id,
+			Name:          &name,
+			KnowledgeBase: knowledgeBase,
+			LanguageCode:  platformclientv2.String("en-US"),
+			Parent:        parent,
+		}
+	}
+
+	// An exported v2 category already uses the name Accounts
+	migration := newKnowledgeV1Migration(func(resourceType, name string) bool {
+		return resourceType == "genesyscloud_knowledge_category" && name == "Accounts"
+	})
+
+	// Child categories are listed before their parent
+	migration.addCategories([]platformclientv2.Knowledgeextendedcategory{
+		knowledgeCategory("child-id", "Passwords", &platformclientv2.Knowledgecategory{Id: platformclientv2.String("parent-id")}),
+		knowledgeCategory("parent-id", "Accounts", nil),
+	})
+
+	migration.addDocument(platformclientv2.Knowledgedocument{
+		Id:            platformclientv2.String("document-id"),
+		Name:          platformclientv2.String("Reset password"),
+		LanguageCode:  platformclientv2.String("en-US"),
+		VarType:       platformclientv2.String("Faq"),
+		KnowledgeBase: knowledgeBase,
+		ExternalUrl:   platformclientv2.String("https://example.com/reset"),
+		Faq: &platformclientv2.Documentfaq{
+			Question:     platformclientv2.String("How do I reset my ${password}?"),
+			Answer:       platformclientv2.String("Open *Settings*.\nClick reset.\n\n- Not a list\n\n1. Not a list\n\n# Not a heading\n\nDone."),
+			Alternatives: &[]string{"Forgot password"},
+		},
+		Categories: &[]platformclientv2.Knowledgecategory{
+			{Id: platformclientv2.String("child-id"), Name: platformclientv2.String("Passwords")},
+			{Id: platformclientv2.String("parent-id"), Name: platformclientv2.String("Accounts")},
+		},
+	}, exportDir, "knowledge_documents")
+
+	// A document without content is migrated without a variation
+	migration.addDocument(platformclientv2.Knowledgedocument{
+		Id:            platformclientv2.String("empty-id"),
+		Name:          platformclientv2.String("Empty"),
+		LanguageCode:  platformclientv2.String("en-US"),
+		KnowledgeBase: knowledgeBase,
+	}, exportDir, "knowledge_documents")
+
+	resources := make(map[string]KnowledgeV1MigrationResource)
+	for _, r := range migration.Resources {
+		resources[r.Type+"."+r.Name] = r
+	}
+	assert.Len(t, resources, 6)
+
+	parentCategory := resources["genesyscloud_knowledge_category.Accounts_3688594418"]
+	assert.Equal(t, "genesyscloud_knowledge_v1_category", parentCategory.V1Type)
+	assert.Equal(t, "Accounts", parentCategory.V1Name)
+	assert.Equal(t, "parent-id kb-id en-US", parentCategory.V1Id)
+
+	childCategory := resources["genesyscloud_knowledge_category.Passwords"]
+	assert.Equal(t, JsonMap{
+		"knowledge_base_id": "kb-id",
+		"knowledge_category": []interface{}{map[string]interface{}{
+			"name":      "Passwords",
+			"parent_id": "${genesyscloud_knowledge_category.Accounts_3688594418.id}",
+		}},
+	}, childCategory.Config)
+
+	label := resources["genesyscloud_knowledge_label.Accounts"]
+	assert.Empty(t, label.V1Type)
+	assert.Equal(t, "Accounts", label.Config["knowledge_label"].([]interface{})[0].(map[string]interface{})["name"])
+
+	document := resources["genesyscloud_knowledge_document.Reset_password_3082933117"]
+	assert.Equal(t, "genesyscloud_knowledge_v1_document", document.V1Type)
+	assert.Equal(t, "document-id kb-id en-US", document.V1Id)
+	assert.Equal(t, JsonMap{
+		"knowledge_base_id": "kb-id",
+		"published":         false,
+		"knowledge_document": []interface{}{map[string]interface{}{
+			"title":   "How do I reset my $${password}?",
+			"visible": true,
+			"alternatives": []interface{}{
+				map[string]interface{}{"phrase": "Forgot password", "autocomplete": false},
+			},
+			"category_name": "${genesyscloud_knowledge_category.Passwords.knowledge_category[0].name}",
+			"label_names":   []interface{}{"${genesyscloud_knowledge_label.Accounts.knowledge_label[0].name}"},
+		}},
+	}, document.Config)
+
+	variation := resources["genesyscloud_knowledge_document_variation.Reset_password_3082933117"]
+	assert.Equal(t, JsonMap{
+		"knowledge_base_id":     "kb-id",
+		"knowledge_document_id": "${genesyscloud_knowledge_document.Reset_password_3082933117.id}",
+		"published":             true,
+		"knowledge_document_variation": []interface{}{map[string]interface{}{
+			"body_filepath":          "knowledge_documents/v1-document-document-id.md",
+			"body_file_content_hash": `${filesha256("knowledge_documents/v1-document-document-id.md")}`,
+		}},
+	}, variation.Config)
+
+	body, err := os.ReadFile(path.Join(exportDir, "knowledge_documents", "v1-document-document-id.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Open \\*Settings\\*.<br>Click reset.\n\n\\- Not a list\n\n1\\. Not a list\n\n\\# Not a heading\n\nDone.\n", string(body))
+
+	// Answer paragraphs starting with list or heading markers stay paragraphs
+	migratedBody := markdownToDocumentBody(string(body))
+	assert.Len(t, *migratedBody.Blocks, 5)
+	for _, block := range *migratedBody.Blocks {
+		assert.Equal(t, "Paragraph", *block.VarType)
+	}
+
+	_, ok := resources["genesyscloud_knowledge_document.Empty"]
+	assert.True(t, ok)
+	_, ok = resources["genesyscloud_knowledge_document_variation.Empty"]
+	assert.False(t, ok)
+
+	assert.Len(t, migration.Notes, 3)
+	assert.Contains(t, migration.Notes[0], "additional categories were migrated as labels")
+	assert.Contains(t, migration.Notes[1], "https://example.com/reset")
+	assert.Contains(t, migration.Notes[2], "The content of v1 document Empty (empty-id) could not be migrated")
+}
@@ -2,6 +2,8 @@ package genesyscloud
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -18,39 +20,73 @@ func getAllFlows(ctx context.Context, clientConfig *platformclientv2.Configurati
 	resources := make(ResourceIDMetaMap)
 	architectAPI := platformclientv2.NewArchitectApiWithConfig(clientConfig)
 
+	flows, err := getAllSdkFlows(architectAPI, nil, nil)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get page of flows: %v", err)
+	}
+
+	for _, flow := range flows {
+		resources[*flow.Id] = &ResourceMeta{Name: *flow.Name}
+	}
+
+	return resources, nil
+}
+
+// getAllSdkFlows pages through every flow in the org, optionally limited to a set of flow types and divisions
+func getAllSdkFlows(architectAPI *platformclientv2.ArchitectApi, flowTypes []string, divisionIds []string) ([]platformclientv2.Flow, error) {
+	var allFlows []platformclientv2.Flow
 	for pageNum := 1; ; pageNum++ {
 		const pageSize = 50
-		flows, _, err := architectAPI.GetFlows(nil, pageNum, pageSize, "", "", nil, "", "", "", "", "", "", "", "", false, true, "", "", nil)
+		flows, _, err := architectAPI.GetFlows(flowTypes, pageNum, pageSize, "", "", nil, "", "", "", "", "", "", "", "", false, true, "", "", divisionIds)
 		if err != nil {
-			return nil, diag.Errorf("Failed to get page of flows: %v", err)
+			return nil, err
 		}
 
 		if flows.Entities == nil || len(*flows.Entities) == 0 {
 			break
 		}
 
-		for _, flow := range *flows.Entities {
-			resources[*flow.Id] = &ResourceMeta{Name: *flow.Name}
-		}
+		allFlows = append(allFlows, *flows.Entities...)
 	}
 
-	return resources, nil
+	return allFlows, nil
 }
 
 func flowExporter() *ResourceExporter {
 	return &ResourceExporter{
 		GetResourcesFunc: getAllWithPooledClient(getAllFlows),
 		RefAttrs:         map[string]*RefAttrSettings{},
-		UnResolvableAttributes: map[string]*schema.Schema{
-			"filepath": resourceFlow().Schema["filepath"],
-		},
-		CustomFlowResolver: map[string]*CustomFlowResolver{
-			"file_content_hash": {ResolverFunc: FileContentHashResolver},
+		CustomFileWriter: CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: FlowResolver,
+			SubDirectory:              "flows",
 		},
 	}
 }
 
 func resourceFlow() *schema.Resource {
+	flowSchema := map[string]*schema.Schema{
+		"filepath": {
+			Description:  "YAML file path for flow configuration.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validatePath,
+		},
+		"file_content_hash": {
+			Description: "Hash value of the YAML file content. Used to detect changes. Changes to the file content after substitutions are also detected through `rendered_content_hash`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"force_unlock": {
+			Description: `Will perform a force unlock on an architect flow before beginning the publication process.  NOTE: The force unlock publishes the 'draft'
+				              architect flow and then publishes the flow named in this resource. This mirrors the behavior found in the archy CLI tool.`,
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+	for attr, attrSchema := range substitutionsSchema(false) {
+		flowSchema[attr] = attrSchema
+	}
+
 	return &schema.Resource{
 		Description: `Genesys Cloud Flow`,
 
@@ -62,30 +98,8 @@ func resourceFlow() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
-		Schema: map[string]*schema.Schema{
-			"filepath": {
-				Description:  "YAML file path for flow configuration.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validatePath,
-			},
-			"file_content_hash": {
-				Description: "Hash value of the YAML file content. Used to detect changes.",
-				Type:        schema.TypeString,
-				Required:    true,
-			},
-			"substitutions": {
-				Description: "A substitution is a key value pair where the key is the value you want to replace, and the value is the value to substitute in its place.",
-				Type:        schema.TypeMap,
-				Optional:    true,
-			},
-			"force_unlock": {
-				Description: `Will perform a force unlock on an architect flow before beginning the publication process.  NOTE: The force unlock publishes the 'draft'
-				              architect flow and then publishes the flow named in this resource. This mirrors the behavior found in the archy CLI tool.`,
-				Type:     schema.TypeBool,
-				Optional: true,
-			},
-		},
+		Schema:        flowSchema,
+		CustomizeDiff: customizeRenderedContentHashDiff,
 	}
 }
 
@@ -160,14 +174,24 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	headers := *flowJob.Headers
 
 	filePath := d.Get("filepath").(string)
-	substitutions := d.Get("substitutions").(map[string]interface{})
+	substitutions, typedSubstitutions, err := getSubstitutions(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	strictSubstitutions := d.Get("substitutions_strict").(bool)
 
-	reader, _, err := downloadOrOpenFile(filePath)
+	content, err := readFileContent(filePath)
 	if err != nil {
 		return diag.Errorf(err.Error())
 	}
+	renderedHash, err := hashRenderedContent(content, substitutions, typedSubstitutions, strictSubstitutions)
+	if err != nil {
+		return diag.Errorf("Failed to render flow file %s: %s", filePath, err)
+	}
 
-	s3Uploader := NewS3Uploader(reader, nil, substitutions, headers, "PUT", presignedUrl)
+	s3Uploader := NewS3Uploader(strings.NewReader(content), nil, substitutions, headers, "PUT", presignedUrl)
+	s3Uploader.typedSubstitutions = typedSubstitutions
+	s3Uploader.strictSubstitutions = strictSubstitutions
 	_, err = s3Uploader.Upload()
 	if err != nil {
 		return diag.Errorf(err.Error())
@@ -211,6 +235,7 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	}
 
 	d.SetId(flowID)
+	d.Set("rendered_content_hash", renderedHash)
 
 	log.Printf("Updated flow %s. ", d.Id())
 	return readFlow(ctx, d, meta)
@@ -244,3 +269,107 @@ func deleteFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return nil
 	})
 }
+
+// Request and response bodies of the Architect flow export job API, which is not included in the SDK
+type flowExportJobRequest struct {
+	Flows []flowExportJobFlow `json:"flows"`
+}
+
+type flowExportJobFlow struct {
+	Flow platformclientv2.Addressableentityref `json:"flow"`
+}
+
+type flowExportJob struct {
+	Id          *string                                 `json:"id,omitempty"`
+	Status      *string                                 `json:"status,omitempty"`
+	DownloadUrl *string                                 `json:"downloadUrl,omitempty"`
+	Messages    *[]platformclientv2.Architectjobmessage `json:"messages,omitempty"`
+}
+
+// getFlowExportUrl starts an Architect export job for the flow and waits for it to complete.
+// Returns the URL from which the exported flow configuration YAML can be downloaded.
+func getFlowExportUrl(flowId string, meta interface{}) (string, error) {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+
+	var exportJob *flowExportJob
+	body := flowExportJobRequest{
+		Flows: []flowExportJobFlow{{Flow: platformclientv2.Addressableentityref{Id: &flowId}}},
+	}
+	_, err := callFlowExportJobAPI(sdkConfig, http.MethodPost, "/api/v2/flows/export/jobs", body, &exportJob)
+	if err != nil {
+		return "", fmt.Errorf("error starting export job for flow %s: %v", flowId, err)
+	}
+	if exportJob == nil || exportJob.Id == nil {
+		return "", fmt.Errorf("export job for flow %s was not created", flowId)
+	}
+	jobId := *exportJob.Id
+
+	downloadUrl := ""
+	diagErr := withRetries(context.Background(), 5*time.Minute, func() *resource.RetryError {
+		var jobStatus *flowExportJob
+		_, err := callFlowExportJobAPI(sdkConfig, http.MethodGet, "/api/v2/flows/export/jobs/"+jobId+"?expand=messages", nil, &jobStatus)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error retrieving export job status. JobID: %s, error: %s ", jobId, err))
+		}
+
+		if jobStatus.Status != nil && *jobStatus.Status == "Failure" {
+			messages := make([]string, 0)
+			if jobStatus.Messages != nil {
+				for _, m := range *jobStatus.Messages {
+					if m.Text != nil {
+						messages = append(messages, *m.Text)
+					}
+				}
+			}
+			return resource.NonRetryableError(fmt.Errorf("Flow export failed. JobID: %s, tracing messages: %v ", jobId, strings.Join(messages, "\n\n")))
+		}
+
+		if jobStatus.Status != nil && *jobStatus.Status == "Success" && jobStatus.DownloadUrl != nil {
+			downloadUrl = *jobStatus.DownloadUrl
+			return nil
+		}
+
+		time.Sleep(5 * time.Second) // Wait 5 seconds for next retry
+		return resource.RetryableError(fmt.Errorf("Export job (%s) could not finish in 5 minutes and timed out ", jobId))
+	})
+	if diagErr != nil {
+		return "", fmt.Errorf("%v", diagErr)
+	}
+
+	return downloadUrl, nil
+}
+
+func callFlowExportJobAPI(sdkConfig *platformclientv2.Configuration, method string, resourcePath string, body interface{}, result interface{}) (*platformclientv2.APIResponse, error) {
+	headerParams := make(map[string]string)
+	if sdkConfig.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + sdkConfig.AccessToken
+	}
+	// add default headers if any
+	for key := range sdkConfig.DefaultHeader {
+		headerParams[key] = sdkConfig.DefaultHeader[key]
+	}
+
+	// set Content-Type header
+	httpContentType := sdkConfig.APIClient.SelectHeaderContentType([]string{"application/json"})
+	if httpContentType != "" {
+		headerParams["Content-Type"] = httpContentType
+	}
+
+	// set Accept header
+	httpHeaderAccept := sdkConfig.APIClient.SelectHeaderAccept([]string{
+		"application/json",
+	})
+	if httpHeaderAccept != "" {
+		headerParams["Accept"] = httpHeaderAccept
+	}
+
+	response, err := sdkConfig.APIClient.CallAPI(sdkConfig.BasePath+resourcePath, method, body, headerParams, nil, nil, "", nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Error != nil {
+		return response, errors.New(response.ErrorMessage)
+	}
+	return response, json.Unmarshal(response.RawBody, result)
+}
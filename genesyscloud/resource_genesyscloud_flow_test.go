@@ -2,12 +2,14 @@ package genesyscloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -293,6 +295,66 @@ func TestAccResourceArchFlowSubstitutions(t *testing.T) {
 	})
 }
 
+func TestAccResourceArchFlowTypedSubstitutions(t *testing.T) {
+	var (
+		flowResource1 = "test_flow1"
+		flowName1     = "Terraform Flow Test-" + uuid.NewString()
+		filePath1     = "../examples/resources/genesyscloud_flow/inboundcall_flow_example_substitutions.yaml"
+		substitutions = map[string]interface{}{
+			"flow_name":            flowName1,
+			"default_language":     "en-us",
+			"greeting":             "Archy says hi!!!",
+			"menu_disconnect_name": "Disconnect",
+		}
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create flow with strict typed substitutions
+				Config: generateFlowResource(
+					flowResource1,
+					filePath1,
+					"",
+					false,
+					generateSubstitutionsJson(substitutions),
+					"substitutions_strict = true",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateFlow("genesyscloud_flow."+flowResource1, flowName1, "INBOUNDCALL"),
+					resource.TestCheckResourceAttrSet("genesyscloud_flow."+flowResource1, "rendered_content_hash"),
+				),
+			},
+			{
+				// An unused substitution fails the plan in strict mode
+				Config: generateFlowResource(
+					flowResource1,
+					filePath1,
+					"",
+					false,
+					generateSubstitutionsJson(map[string]interface{}{
+						"flow_name":            flowName1,
+						"default_language":     "en-us",
+						"greeting":             "Archy says hi!!!",
+						"menu_disconnect_name": "Disconnect",
+						"unused":               30,
+					}),
+					"substitutions_strict = true",
+				),
+				ExpectError: regexp.MustCompile("substitutions not used in the file: unused"),
+			},
+		},
+		CheckDestroy: testVerifyFlowDestroyed,
+	})
+}
+
+func generateSubstitutionsJson(substitutions map[string]interface{}) string {
+	substitutionsJson, _ := json.Marshal(substitutions)
+	return fmt.Sprintf("substitutions_json = %s", strconv.Quote(string(substitutionsJson)))
+}
+
 func copyFile(src string, dest string) {
 	bytesRead, err := ioutil.ReadFile(src)
 
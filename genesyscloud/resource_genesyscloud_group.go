@@ -47,23 +47,36 @@ func getAllGroups(_ context.Context, clientConfig *platformclientv2.Configuratio
 	resources := make(ResourceIDMetaMap)
 	groupsAPI := platformclientv2.NewGroupsApiWithConfig(clientConfig)
 
+	groups, err := getAllSdkGroups(groupsAPI)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get page of groups: %v", err)
+	}
+
+	for _, group := range groups {
+		resources[*group.Id] = &ResourceMeta{Name: *group.Name}
+	}
+
+	return resources, nil
+}
+
+// getAllSdkGroups pages through every group in the org
+func getAllSdkGroups(groupsAPI *platformclientv2.GroupsApi) ([]platformclientv2.Group, error) {
+	var allGroups []platformclientv2.Group
 	for pageNum := 1; ; pageNum++ {
 		const pageSize = 100
 		groups, _, getErr := groupsAPI.GetGroups(pageSize, pageNum, nil, nil, "")
 		if getErr != nil {
-			return nil, diag.Errorf("Failed to get page of groups: %v", getErr)
+			return nil, getErr
 		}
 
 		if groups.Entities == nil || len(*groups.Entities) == 0 {
 			break
 		}
 
-		for _, group := range *groups.Entities {
-			resources[*group.Id] = &ResourceMeta{Name: *group.Name}
-		}
+		allGroups = append(allGroups, *groups.Entities...)
 	}
 
-	return resources, nil
+	return allGroups, nil
 }
 
 func groupExporter() *ResourceExporter {
@@ -202,57 +215,66 @@ func readGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		}
 
 		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceGroup())
-		if group.Name != nil {
-			d.Set("name", *group.Name)
-		} else {
-			d.Set("name", nil)
+		if diagErr := flattenGroup(d, group, groupsAPI); diagErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
 		}
 
-		if group.VarType != nil {
-			d.Set("type", *group.VarType)
-		} else {
-			d.Set("type", nil)
-		}
+		log.Printf("Read group %s %s", d.Id(), *group.Name)
+		return cc.CheckState()
+	})
+}
 
-		if group.Visibility != nil {
-			d.Set("visibility", *group.Visibility)
-		} else {
-			d.Set("visibility", nil)
-		}
+// flattenGroup sets all group attributes in the resource data. Used by both the resource and data source.
+func flattenGroup(d *schema.ResourceData, group *platformclientv2.Group, groupsAPI *platformclientv2.GroupsApi) diag.Diagnostics {
+	if group.Name != nil {
+		d.Set("name", *group.Name)
+	} else {
+		d.Set("name", nil)
+	}
 
-		if group.RulesVisible != nil {
-			d.Set("rules_visible", *group.RulesVisible)
-		} else {
-			d.Set("rules_visible", nil)
-		}
+	if group.VarType != nil {
+		d.Set("type", *group.VarType)
+	} else {
+		d.Set("type", nil)
+	}
 
-		if group.Description != nil {
-			d.Set("description", *group.Description)
-		} else {
-			d.Set("description", nil)
-		}
+	if group.Visibility != nil {
+		d.Set("visibility", *group.Visibility)
+	} else {
+		d.Set("visibility", nil)
+	}
 
-		if group.Addresses != nil {
-			d.Set("addresses", flattenGroupAddresses(*group.Addresses))
-		} else {
-			d.Set("addresses", nil)
-		}
+	if group.RulesVisible != nil {
+		d.Set("rules_visible", *group.RulesVisible)
+	} else {
+		d.Set("rules_visible", nil)
+	}
 
-		if group.Owners != nil {
-			d.Set("owner_ids", flattenGroupOwners(*group.Owners))
-		} else {
-			d.Set("owner_ids", nil)
-		}
+	if group.Description != nil {
+		d.Set("description", *group.Description)
+	} else {
+		d.Set("description", nil)
+	}
 
-		members, err := readGroupMembers(d.Id(), groupsAPI)
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("%v", err))
-		}
-		d.Set("member_ids", members)
+	if group.Addresses != nil {
+		d.Set("addresses", flattenGroupAddresses(*group.Addresses))
+	} else {
+		d.Set("addresses", nil)
+	}
 
-		log.Printf("Read group %s %s", d.Id(), *group.Name)
-		return cc.CheckState()
-	})
+	if group.Owners != nil {
+		d.Set("owner_ids", flattenGroupOwners(*group.Owners))
+	} else {
+		d.Set("owner_ids", nil)
+	}
+
+	members, err := readGroupMembers(d.Id(), groupsAPI)
+	if err != nil {
+		return err
+	}
+	d.Set("member_ids", members)
+
+	return nil
 }
 
 func updateGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,336 @@
+package genesyscloud
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+// idpType describes a single sign-on identity provider. Every identity provider is a singleton configured with a
+// PUT of the whole provider, so all genesyscloud_idp_* resources share the implementation of this file and only
+// define their API calls and the attributes they support in addition to the common ones.
+type idpType struct {
+	// ID of the resource and name of the exported resource
+	id string
+	// Name of the provider used in logs and errors
+	name string
+	// Name of the provider used in attribute descriptions
+	providerName  string
+	description   string
+	deleteTimeout time.Duration
+	// Attributes in addition to certificates, issuer_uri, target_uri and disabled
+	attributes map[string]*schema.Schema
+
+	getIdp    func(idpAPI *platformclientv2.IdentityProviderApi) (*idpSettings, *platformclientv2.APIResponse, error)
+	putIdp    func(idpAPI *platformclientv2.IdentityProviderApi, settings idpSettings) (*platformclientv2.APIResponse, error)
+	deleteIdp func(idpAPI *platformclientv2.IdentityProviderApi) (*platformclientv2.APIResponse, error)
+}
+
+// idpSettings holds the settings of any identity provider. Settings the provider does not support are nil.
+type idpSettings struct {
+	name                   *string
+	certificates           *[]string
+	issuerUri              *string
+	targetUri              *string
+	relyingPartyIdentifier *string
+	disabled               *bool
+	logoImageData          *string
+	endpointCompression    *bool
+	nameIdentifierFormat   *string
+	autoProvisionUsers     *bool
+}
+
+func idpRelyingPartyIdentifierSchema(providerName string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("String used to identify Genesys Cloud to %s.", providerName),
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+}
+
+func idpResource(t idpType) *schema.Resource {
+	idpSchema := map[string]*schema.Schema{
+		"certificates": {
+			Description: "PEM or DER encoded public X.509 certificates for SAML signature validation. Certificates that cannot be parsed or are expired are rejected at plan time.",
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateCertificate,
+			},
+		},
+		"certificate_expirations": {
+			Description: "Expiration time of each certificate in the order of `certificates`, in RFC3339 format.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"issuer_uri": {
+			Description: fmt.Sprintf("Issuer URI provided by %s.", t.providerName),
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"target_uri": {
+			Description: fmt.Sprintf("Target URI provided by %s.", t.providerName),
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"disabled": {
+			Description: fmt.Sprintf("True if %s is disabled.", t.providerName),
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+	for attribute, attributeSchema := range t.attributes {
+		idpSchema[attribute] = attributeSchema
+	}
+
+	return &schema.Resource{
+		Description: t.description,
+
+		CreateContext: createWithPooledClient(func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return createIdp(ctx, d, meta, t)
+		}),
+		ReadContext: readWithPooledClient(func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return readIdp(ctx, d, meta, t)
+		}),
+		UpdateContext: updateWithPooledClient(func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return updateIdp(ctx, d, meta, t)
+		}),
+		DeleteContext: deleteWithPooledClient(func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return deleteIdp(ctx, d, meta, t)
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(8 * time.Minute),
+			Read:   schema.DefaultTimeout(8 * time.Minute),
+		},
+		Schema: idpSchema,
+	}
+}
+
+func idpExporter(t idpType) *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(func(_ context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+			return getAllIdp(clientConfig, t)
+		}),
+		RefAttrs:           map[string]*RefAttrSettings{}, // No references
+		ExcludedAttributes: []string{"certificate_expirations"},
+	}
+}
+
+func getAllIdp(clientConfig *platformclientv2.Configuration, t idpType) (ResourceIDMetaMap, diag.Diagnostics) {
+	idpAPI := platformclientv2.NewIdentityProviderApiWithConfig(clientConfig)
+	resources := make(ResourceIDMetaMap)
+
+	_, resp, getErr := t.getIdp(idpAPI)
+	if getErr != nil {
+		if isStatus404(resp) {
+			// Don't export if config doesn't exist
+			return resources, nil
+		}
+		return nil, diag.Errorf("Failed to get IDP %s: %v", t.name, getErr)
+	}
+
+	resources["0"] = &ResourceMeta{Name: t.id}
+	return resources, nil
+}
+
+func createIdp(ctx context.Context, d *schema.ResourceData, meta interface{}, t idpType) diag.Diagnostics {
+	log.Printf("Creating IDP %s", t.name)
+	d.SetId(t.id)
+	return updateIdp(ctx, d, meta, t)
+}
+
+func readIdp(ctx context.Context, d *schema.ResourceData, meta interface{}, t idpType) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	idpAPI := platformclientv2.NewIdentityProviderApiWithConfig(sdkConfig)
+
+	log.Printf("Reading IDP %s", t.name)
+
+	return withRetriesForReadCustomTimeout(ctx, d.Timeout(schema.TimeoutRead), d, func() *resource.RetryError {
+		settings, resp, getErr := t.getIdp(idpAPI)
+		if getErr != nil {
+			if isStatus404(resp) {
+				createIdp(ctx, d, meta, t)
+				return resource.RetryableError(fmt.Errorf("Failed to read IDP %s: %s", t.name, getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read IDP %s: %s", t.name, getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, idpResource(t))
+		if settings.certificates != nil {
+			d.Set("certificates", stringListToInterfaceList(*settings.certificates))
+			d.Set("certificate_expirations", flattenCertificateExpirations(*settings.certificates))
+		} else {
+			d.Set("certificates", nil)
+			d.Set("certificate_expirations", nil)
+		}
+
+		setIdpString(d, "issuer_uri", settings.issuerUri)
+		setIdpString(d, "target_uri", settings.targetUri)
+		setIdpBool(d, "disabled", settings.disabled)
+
+		if _, ok := t.attributes["name"]; ok {
+			setIdpString(d, "name", settings.name)
+		}
+		if _, ok := t.attributes["relying_party_identifier"]; ok {
+			setIdpString(d, "relying_party_identifier", settings.relyingPartyIdentifier)
+		}
+		if _, ok := t.attributes["logo_image_data"]; ok {
+			setIdpString(d, "logo_image_data", settings.logoImageData)
+		}
+		if _, ok := t.attributes["endpoint_compression"]; ok {
+			setIdpBool(d, "endpoint_compression", settings.endpointCompression)
+		}
+		if _, ok := t.attributes["name_identifier_format"]; ok {
+			setIdpString(d, "name_identifier_format", settings.nameIdentifierFormat)
+		}
+		if _, ok := t.attributes["auto_provision_users"]; ok {
+			setIdpBool(d, "auto_provision_users", settings.autoProvisionUsers)
+		}
+
+		log.Printf("Read IDP %s", t.name)
+		return cc.CheckState()
+	})
+}
+
+func updateIdp(ctx context.Context, d *schema.ResourceData, meta interface{}, t idpType) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	idpAPI := platformclientv2.NewIdentityProviderApiWithConfig(sdkConfig)
+
+	log.Printf("Updating IDP %s", t.name)
+	settings := idpSettings{
+		certificates: buildSdkStringListFromInterfaceArray(d, "certificates"),
+		issuerUri:    platformclientv2.String(d.Get("issuer_uri").(string)),
+		targetUri:    platformclientv2.String(d.Get("target_uri").(string)),
+		disabled:     platformclientv2.Bool(d.Get("disabled").(bool)),
+	}
+	if _, ok := t.attributes["name"]; ok {
+		settings.name = platformclientv2.String(d.Get("name").(string))
+	}
+	if _, ok := t.attributes["relying_party_identifier"]; ok {
+		settings.relyingPartyIdentifier = platformclientv2.String(d.Get("relying_party_identifier").(string))
+	}
+	if _, ok := t.attributes["logo_image_data"]; ok {
+		settings.logoImageData = platformclientv2.String(d.Get("logo_image_data").(string))
+	}
+	if _, ok := t.attributes["endpoint_compression"]; ok {
+		settings.endpointCompression = platformclientv2.Bool(d.Get("endpoint_compression").(bool))
+	}
+	if _, ok := t.attributes["name_identifier_format"]; ok {
+		settings.nameIdentifierFormat = platformclientv2.String(d.Get("name_identifier_format").(string))
+	}
+	if _, ok := t.attributes["auto_provision_users"]; ok {
+		settings.autoProvisionUsers = platformclientv2.Bool(d.Get("auto_provision_users").(bool))
+	}
+
+	_, err := t.putIdp(idpAPI, settings)
+	if err != nil {
+		return diag.Errorf("Failed to update IDP %s: %s", t.name, err)
+	}
+
+	log.Printf("Updated IDP %s", t.name)
+	return readIdp(ctx, d, meta, t)
+}
+
+func deleteIdp(ctx context.Context, _ *schema.ResourceData, meta interface{}, t idpType) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	idpAPI := platformclientv2.NewIdentityProviderApiWithConfig(sdkConfig)
+
+	log.Printf("Deleting IDP %s", t.name)
+	_, err := t.deleteIdp(idpAPI)
+	if err != nil {
+		return diag.Errorf("Failed to delete IDP %s: %s", t.name, err)
+	}
+
+	return withRetries(ctx, t.deleteTimeout, func() *resource.RetryError {
+		_, resp, err := t.getIdp(idpAPI)
+		if err != nil {
+			if isStatus404(resp) {
+				// IDP deleted
+				log.Printf("Deleted IDP %s", t.name)
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error deleting IDP %s: %s", t.name, err))
+		}
+		return resource.RetryableError(fmt.Errorf("IDP %s still exists", t.name))
+	})
+}
+
+func setIdpString(d *schema.ResourceData, key string, value *string) {
+	if value != nil {
+		d.Set(key, *value)
+	} else {
+		d.Set(key, nil)
+	}
+}
+
+func setIdpBool(d *schema.ResourceData, key string, value *bool) {
+	if value != nil {
+		d.Set(key, *value)
+	} else {
+		d.Set(key, nil)
+	}
+}
+
+// buildSdkIdpCertificates returns the certificate and certificates fields of an identity provider.
+// The single certificate field is only set when there is exactly one certificate.
+func buildSdkIdpCertificates(certificates *[]string) (*string, *[]string) {
+	if certificates != nil && len(*certificates) == 1 {
+		return &(*certificates)[0], certificates
+	}
+	return nil, certificates
+}
+
+// flattenSdkIdpCertificates returns the certificates of an identity provider, preferring the single certificate field
+func flattenSdkIdpCertificates(certificate *string, certificates *[]string) *[]string {
+	if certificate != nil {
+		return &[]string{*certificate}
+	}
+	return certificates
+}
+
+// parseCertificate parses a PEM encoded certificate or a base64 encoded DER certificate
+func parseCertificate(certificate string) (*x509.Certificate, error) {
+	certificate = strings.TrimSpace(certificate)
+	if block, _ := pem.Decode([]byte(certificate)); block != nil {
+		return x509.ParseCertificate(block.Bytes)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(certificate), ""))
+	if err != nil {
+		return nil, fmt.Errorf("certificate is neither PEM nor base64 encoded DER: %s", err)
+	}
+	return x509.ParseCertificate(der)
+}
+
+func flattenCertificateExpirations(certificates []string) []interface{} {
+	expirations := make([]interface{}, len(certificates))
+	for i, certificate := range certificates {
+		cert, err := parseCertificate(certificate)
+		if err != nil {
+			log.Printf("Failed to parse certificate %d: %s", i, err)
+			expirations[i] = ""
+			continue
+		}
+		expirations[i] = cert.NotAfter.UTC().Format(time.RFC3339)
+	}
+	return expirations
+}
@@ -0,0 +1,55 @@
+package genesyscloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+var idpIdentitynow = idpType{
+	id:            "identitynow",
+	name:          "IdentityNow",
+	providerName:  "IdentityNow",
+	description:   "Genesys Cloud Single Sign-on SailPoint IdentityNow Identity Provider.",
+	deleteTimeout: 60 * time.Second,
+	attributes: map[string]*schema.Schema{
+		"relying_party_identifier": idpRelyingPartyIdentifierSchema("IdentityNow"),
+	},
+	getIdp: func(idpAPI *platformclientv2.IdentityProviderApi) (*idpSettings, *platformclientv2.APIResponse, error) {
+		identitynow, resp, err := idpAPI.GetIdentityprovidersIdentitynow()
+		if err != nil {
+			return nil, resp, err
+		}
+		return &idpSettings{
+			certificates:           flattenSdkIdpCertificates(identitynow.Certificate, identitynow.Certificates),
+			issuerUri:              identitynow.IssuerURI,
+			targetUri:              identitynow.SsoTargetURI,
+			relyingPartyIdentifier: identitynow.RelyingPartyIdentifier,
+			disabled:               identitynow.Disabled,
+		}, resp, nil
+	},
+	putIdp: func(idpAPI *platformclientv2.IdentityProviderApi, settings idpSettings) (*platformclientv2.APIResponse, error) {
+		update := platformclientv2.Identitynow{
+			IssuerURI:              settings.issuerUri,
+			SsoTargetURI:           settings.targetUri,
+			RelyingPartyIdentifier: settings.relyingPartyIdentifier,
+			Disabled:               settings.disabled,
+		}
+		update.Certificate, update.Certificates = buildSdkIdpCertificates(settings.certificates)
+		_, resp, err := idpAPI.PutIdentityprovidersIdentitynow(update)
+		return resp, err
+	},
+	deleteIdp: func(idpAPI *platformclientv2.IdentityProviderApi) (*platformclientv2.APIResponse, error) {
+		_, resp, err := idpAPI.DeleteIdentityprovidersIdentitynow()
+		return resp, err
+	},
+}
+
+func idpIdentitynowExporter() *ResourceExporter {
+	return idpExporter(idpIdentitynow)
+}
+
+func resourceIdpIdentitynow() *schema.Resource {
+	return idpResource(idpIdentitynow)
+}
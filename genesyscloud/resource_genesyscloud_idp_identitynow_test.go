@@ -0,0 +1,175 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourceIdpIdentitynow(t *testing.T) {
+	var (
+		uri1            = "https://test.com/1"
+		uri2            = "https://test.com/2"
+		relyingPartyID1 = "test-id1"
+		relyingPartyID2 = "test-id2"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: generateIdpIdentitynowResource(
+					generateStringArray(strconv.Quote(testCert1)),
+					uri1,
+					uri2,
+					nullValue, // No relying party ID
+					nullValue, // Not disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_identitynow.identitynow", "certificates", testCert1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "certificate_expirations.0", "2122-04-23T13:45:33Z"),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "issuer_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "target_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "relying_party_identifier", ""),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "disabled", falseValue),
+				),
+			},
+			{
+				// Update with new values
+				Config: generateIdpIdentitynowResource(
+					generateStringArray(strconv.Quote(testCert2)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID1),
+					trueValue, // disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_identitynow.identitynow", "certificates", testCert2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "issuer_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "target_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "relying_party_identifier", relyingPartyID1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "disabled", trueValue),
+				),
+			},
+			{
+				// Update with multiple certs
+				Config: generateIdpIdentitynowResource(
+					generateStringArray(strconv.Quote(testCert1), strconv.Quote(testCert2)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID2),
+					falseValue, // disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_identitynow.identitynow", "certificates", testCert1),
+					validateStringInArray("genesyscloud_idp_identitynow.identitynow", "certificates", testCert2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "issuer_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "target_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "relying_party_identifier", relyingPartyID2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "disabled", falseValue),
+				),
+			},
+			{
+				// Update to one cert in array
+				Config: generateIdpIdentitynowResource(
+					generateStringArray(strconv.Quote(testCert1)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID2),
+					falseValue, // disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_identitynow.identitynow", "certificates", testCert1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "certificates.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "issuer_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "target_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "relying_party_identifier", relyingPartyID2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "disabled", falseValue),
+				),
+			},
+			{
+				// Update back to two certs in array
+				Config: generateIdpIdentitynowResource(
+					generateStringArray(strconv.Quote(testCert1), strconv.Quote(testCert2)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID2),
+					falseValue, // disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_identitynow.identitynow", "certificates", testCert1),
+					validateStringInArray("genesyscloud_idp_identitynow.identitynow", "certificates", testCert2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "certificates.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "issuer_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "target_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "relying_party_identifier", relyingPartyID2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_identitynow.identitynow", "disabled", falseValue),
+				),
+			},
+			{
+				// Expired certificates are rejected at plan time
+				Config: generateIdpIdentitynowResource(
+					generateStringArray(strconv.Quote(testExpiredCert)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID2),
+					falseValue, // disabled
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expired"),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_idp_identitynow.identitynow",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyIdpIdentitynowDestroyed,
+	})
+}
+
+func generateIdpIdentitynowResource(
+	certs string,
+	issuerURI string,
+	targetURI string,
+	partyID string,
+	disabled string) string {
+	return fmt.Sprintf(`resource "genesyscloud_idp_identitynow" "identitynow" {
+		certificates = %s
+		issuer_uri = "%s"
+		target_uri = "%s"
+        relying_party_identifier = %s
+        disabled = %s
+	}
+	`, certs, issuerURI, targetURI, partyID, disabled)
+}
+
+func testVerifyIdpIdentitynowDestroyed(state *terraform.State) error {
+	idpAPI := platformclientv2.NewIdentityProviderApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_idp_identitynow" {
+			continue
+		}
+
+		identitynow, resp, err := idpAPI.GetIdentityprovidersIdentitynow()
+		if identitynow != nil {
+			return fmt.Errorf("Identitynow still exists")
+		} else if isStatus404(resp) {
+			// Identitynow not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. Identitynow config destroyed
+	return nil
+}
@@ -1,193 +1,50 @@
 package genesyscloud
 
 import (
-	"context"
-	"fmt"
-	"log"
 	"time"
 
-	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
 )
 
-func getAllIdpOkta(_ context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
-	idpAPI := platformclientv2.NewIdentityProviderApiWithConfig(clientConfig)
-	resources := make(ResourceIDMetaMap)
-
-	_, resp, getErr := idpAPI.GetIdentityprovidersOkta()
-	if getErr != nil {
-		if isStatus404(resp) {
-			// Don't export if config doesn't exist
-			return resources, nil
-		}
-		return nil, diag.Errorf("Failed to get IDP Okta: %v", getErr)
-	}
-
-	resources["0"] = &ResourceMeta{Name: "okta"}
-	return resources, nil
+var idpOkta = idpType{
+	id:            "okta",
+	name:          "Okta",
+	providerName:  "Okta",
+	description:   "Genesys Cloud Single Sign-on Okta Identity Provider. See this page for detailed configuration instructions: https://help.mypurecloud.com/articles/add-okta-as-a-single-sign-on-provider/",
+	deleteTimeout: 60 * time.Second,
+	getIdp: func(idpAPI *platformclientv2.IdentityProviderApi) (*idpSettings, *platformclientv2.APIResponse, error) {
+		okta, resp, err := idpAPI.GetIdentityprovidersOkta()
+		if err != nil {
+			return nil, resp, err
+		}
+		return &idpSettings{
+			certificates: flattenSdkIdpCertificates(okta.Certificate, okta.Certificates),
+			issuerUri:    okta.IssuerURI,
+			targetUri:    okta.SsoTargetURI,
+			disabled:     okta.Disabled,
+		}, resp, nil
+	},
+	putIdp: func(idpAPI *platformclientv2.IdentityProviderApi, settings idpSettings) (*platformclientv2.APIResponse, error) {
+		update := platformclientv2.Okta{
+			IssuerURI:    settings.issuerUri,
+			SsoTargetURI: settings.targetUri,
+			Disabled:     settings.disabled,
+		}
+		update.Certificate, update.Certificates = buildSdkIdpCertificates(settings.certificates)
+		_, resp, err := idpAPI.PutIdentityprovidersOkta(update)
+		return resp, err
+	},
+	deleteIdp: func(idpAPI *platformclientv2.IdentityProviderApi) (*platformclientv2.APIResponse, error) {
+		_, resp, err := idpAPI.DeleteIdentityprovidersOkta()
+		return resp, err
+	},
 }
 
 func idpOktaExporter() *ResourceExporter {
-	return &ResourceExporter{
-		GetResourcesFunc: getAllWithPooledClient(getAllIdpOkta),
-		RefAttrs:         map[string]*RefAttrSettings{}, // No references
-	}
+	return idpExporter(idpOkta)
 }
 
 func resourceIdpOkta() *schema.Resource {
-	return &schema.Resource{
-		Description: "Genesys Cloud Single Sign-on Okta Identity Provider. See this page for detailed configuration instructions: https://help.mypurecloud.com/articles/add-okta-as-a-single-sign-on-provider/",
-
-		CreateContext: createWithPooledClient(createIdpOkta),
-		ReadContext:   readWithPooledClient(readIdpOkta),
-		UpdateContext: updateWithPooledClient(updateIdpOkta),
-		DeleteContext: deleteWithPooledClient(deleteIdpOkta),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		SchemaVersion: 1,
-		Timeouts: &schema.ResourceTimeout{
-			Update: schema.DefaultTimeout(8 * time.Minute),
-			Read:   schema.DefaultTimeout(8 * time.Minute),
-		},
-		Schema: map[string]*schema.Schema{
-			"certificates": {
-				Description: "PEM or DER encoded public X.509 certificates for SAML signature validation.",
-				Type:        schema.TypeList,
-				Required:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-			},
-			"issuer_uri": {
-				Description: "Issuer URI provided by Okta.",
-				Type:        schema.TypeString,
-				Required:    true,
-			},
-			"target_uri": {
-				Description: "Target URI provided by Okta.",
-				Type:        schema.TypeString,
-				Optional:    true,
-			},
-			"disabled": {
-				Description: "True if Okta is disabled.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-			},
-		},
-	}
-}
-
-func createIdpOkta(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	log.Printf("Creating IDP Okta")
-	d.SetId("okta")
-	return updateIdpOkta(ctx, d, meta)
-}
-
-func readIdpOkta(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*ProviderMeta).ClientConfig
-	idpAPI := platformclientv2.NewIdentityProviderApiWithConfig(sdkConfig)
-
-	log.Printf("Reading IDP Okta")
-
-	return withRetriesForReadCustomTimeout(ctx, d.Timeout(schema.TimeoutRead), d, func() *resource.RetryError {
-		okta, resp, getErr := idpAPI.GetIdentityprovidersOkta()
-		if getErr != nil {
-			if isStatus404(resp) {
-				createIdpOkta(ctx, d, meta)
-				return resource.RetryableError(fmt.Errorf("Failed to read IDP Okta: %s", getErr))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Failed to read IDP Okta: %s", getErr))
-		}
-
-		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceIdpOkta())
-		if okta.Certificate != nil {
-			d.Set("certificates", stringListToInterfaceList([]string{*okta.Certificate}))
-		} else if okta.Certificates != nil {
-			d.Set("certificates", stringListToInterfaceList(*okta.Certificates))
-		} else {
-			d.Set("certificates", nil)
-		}
-
-		if okta.IssuerURI != nil {
-			d.Set("issuer_uri", *okta.IssuerURI)
-		} else {
-			d.Set("issuer_uri", nil)
-		}
-
-		if okta.SsoTargetURI != nil {
-			d.Set("target_uri", *okta.SsoTargetURI)
-		} else {
-			d.Set("target_uri", nil)
-		}
-
-		if okta.Disabled != nil {
-			d.Set("disabled", *okta.Disabled)
-		} else {
-			d.Set("disabled", nil)
-		}
-
-		log.Printf("Read IDP Okta")
-		return cc.CheckState()
-	})
-}
-
-func updateIdpOkta(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	issuerUri := d.Get("issuer_uri").(string)
-	targetUri := d.Get("target_uri").(string)
-	disabled := d.Get("disabled").(bool)
-
-	sdkConfig := meta.(*ProviderMeta).ClientConfig
-	idpAPI := platformclientv2.NewIdentityProviderApiWithConfig(sdkConfig)
-
-	log.Printf("Updating IDP Okta")
-	update := platformclientv2.Okta{
-		IssuerURI:    &issuerUri,
-		SsoTargetURI: &targetUri,
-		Disabled:     &disabled,
-	}
-
-	certificates := buildSdkStringListFromInterfaceArray(d, "certificates")
-	if certificates != nil {
-		if len(*certificates) == 1 {
-			update.Certificate = &(*certificates)[0]
-		}
-		update.Certificates = certificates
-	}
-
-	_, _, err := idpAPI.PutIdentityprovidersOkta(update)
-	if err != nil {
-		return diag.Errorf("Failed to update IDP Okta: %s", err)
-	}
-
-	log.Printf("Updated IDP Okta")
-	return readIdpOkta(ctx, d, meta)
-}
-
-func deleteIdpOkta(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*ProviderMeta).ClientConfig
-	idpAPI := platformclientv2.NewIdentityProviderApiWithConfig(sdkConfig)
-
-	log.Printf("Deleting IDP Okta")
-	_, _, err := idpAPI.DeleteIdentityprovidersOkta()
-	if err != nil {
-		return diag.Errorf("Failed to delete IDP Okta: %s", err)
-	}
-
-	return withRetries(ctx, 60*time.Second, func() *resource.RetryError {
-		_, resp, err := idpAPI.GetIdentityprovidersOkta()
-		if err != nil {
-			if isStatus404(resp) {
-				// IDP Okta deleted
-				log.Printf("Deleted IDP Okta")
-				return nil
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting IDP Okta: %s", err))
-		}
-		return resource.RetryableError(fmt.Errorf("IDP Okta still exists"))
-	})
+	return idpResource(idpOkta)
 }
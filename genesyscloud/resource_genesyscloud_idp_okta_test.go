@@ -2,6 +2,7 @@ package genesyscloud
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -30,6 +31,7 @@ func TestAccResourceIdpOkta(t *testing.T) {
 				),
 				Check: resource.ComposeTestCheckFunc(
 					validateStringInArray("genesyscloud_idp_okta.okta", "certificates", testCert1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_okta.okta", "certificate_expirations.0", "2122-04-23T13:45:33Z"),
 					resource.TestCheckResourceAttr("genesyscloud_idp_okta.okta", "issuer_uri", uri1),
 					resource.TestCheckResourceAttr("genesyscloud_idp_okta.okta", "target_uri", uri2),
 					resource.TestCheckResourceAttr("genesyscloud_idp_okta.okta", "disabled", falseValue),
@@ -99,6 +101,17 @@ func TestAccResourceIdpOkta(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_idp_okta.okta", "disabled", falseValue),
 				),
 			},
+			{
+				// Expired certificates are rejected at plan time
+				Config: generateIdpOktaResource(
+					generateStringArray(strconv.Quote(testExpiredCert)),
+					uri2,
+					uri1,
+					falseValue, // disabled
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expired"),
+			},
 			{
 				// Import/Read
 				ResourceName:      "genesyscloud_idp_okta.okta",
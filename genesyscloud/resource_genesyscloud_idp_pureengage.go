@@ -0,0 +1,63 @@
+package genesyscloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+var idpPureengage = idpType{
+	id:            "pureengage",
+	name:          "PureEngage",
+	providerName:  "PureEngage",
+	description:   "Genesys Cloud Single Sign-on PureEngage Identity Provider.",
+	deleteTimeout: 60 * time.Second,
+	attributes: map[string]*schema.Schema{
+		"relying_party_identifier": idpRelyingPartyIdentifierSchema("PureEngage"),
+		"auto_provision_users": {
+			Description: "True if users signing in with PureEngage that do not exist in Genesys Cloud are created.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	},
+	getIdp: func(idpAPI *platformclientv2.IdentityProviderApi) (*idpSettings, *platformclientv2.APIResponse, error) {
+		pureengage, resp, err := idpAPI.GetIdentityprovidersPureengage()
+		if err != nil {
+			return nil, resp, err
+		}
+		return &idpSettings{
+			certificates:           flattenSdkIdpCertificates(pureengage.Certificate, pureengage.Certificates),
+			issuerUri:              pureengage.IssuerURI,
+			targetUri:              pureengage.SsoTargetURI,
+			relyingPartyIdentifier: pureengage.RelyingPartyIdentifier,
+			disabled:               pureengage.Disabled,
+			autoProvisionUsers:     pureengage.AutoProvisionUsers,
+		}, resp, nil
+	},
+	putIdp: func(idpAPI *platformclientv2.IdentityProviderApi, settings idpSettings) (*platformclientv2.APIResponse, error) {
+		update := platformclientv2.Pureengage{
+			IssuerURI:              settings.issuerUri,
+			SsoTargetURI:           settings.targetUri,
+			RelyingPartyIdentifier: settings.relyingPartyIdentifier,
+			Disabled:               settings.disabled,
+			AutoProvisionUsers:     settings.autoProvisionUsers,
+		}
+		update.Certificate, update.Certificates = buildSdkIdpCertificates(settings.certificates)
+		_, resp, err := idpAPI.PutIdentityprovidersPureengage(update)
+		return resp, err
+	},
+	deleteIdp: func(idpAPI *platformclientv2.IdentityProviderApi) (*platformclientv2.APIResponse, error) {
+		_, resp, err := idpAPI.DeleteIdentityprovidersPureengage()
+		return resp, err
+	},
+}
+
+func idpPureengageExporter() *ResourceExporter {
+	return idpExporter(idpPureengage)
+}
+
+func resourceIdpPureengage() *schema.Resource {
+	return idpResource(idpPureengage)
+}
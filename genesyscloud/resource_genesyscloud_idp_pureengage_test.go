@@ -0,0 +1,188 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourceIdpPureengage(t *testing.T) {
+	var (
+		uri1            = "https://test.com/1"
+		uri2            = "https://test.com/2"
+		relyingPartyID1 = "test-id1"
+		relyingPartyID2 = "test-id2"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: generateIdpPureengageResource(
+					generateStringArray(strconv.Quote(testCert1)),
+					uri1,
+					uri2,
+					nullValue, // No relying party ID
+					nullValue, // No auto provisioning
+					nullValue, // Not disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_pureengage.pureengage", "certificates", testCert1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "certificate_expirations.0", "2122-04-23T13:45:33Z"),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "issuer_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "target_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "relying_party_identifier", ""),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "auto_provision_users", falseValue),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "disabled", falseValue),
+				),
+			},
+			{
+				// Update with new values
+				Config: generateIdpPureengageResource(
+					generateStringArray(strconv.Quote(testCert2)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID1),
+					trueValue, // auto provision users
+					trueValue, // disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_pureengage.pureengage", "certificates", testCert2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "issuer_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "target_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "relying_party_identifier", relyingPartyID1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "auto_provision_users", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "disabled", trueValue),
+				),
+			},
+			{
+				// Update with multiple certs
+				Config: generateIdpPureengageResource(
+					generateStringArray(strconv.Quote(testCert1), strconv.Quote(testCert2)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID2),
+					falseValue, // auto provision users
+					falseValue, // disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_pureengage.pureengage", "certificates", testCert1),
+					validateStringInArray("genesyscloud_idp_pureengage.pureengage", "certificates", testCert2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "issuer_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "target_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "relying_party_identifier", relyingPartyID2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "auto_provision_users", falseValue),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "disabled", falseValue),
+				),
+			},
+			{
+				// Update to one cert in array
+				Config: generateIdpPureengageResource(
+					generateStringArray(strconv.Quote(testCert1)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID2),
+					falseValue, // auto provision users
+					falseValue, // disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_pureengage.pureengage", "certificates", testCert1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "certificates.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "issuer_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "target_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "relying_party_identifier", relyingPartyID2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "auto_provision_users", falseValue),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "disabled", falseValue),
+				),
+			},
+			{
+				// Update back to two certs in array
+				Config: generateIdpPureengageResource(
+					generateStringArray(strconv.Quote(testCert1), strconv.Quote(testCert2)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID2),
+					falseValue, // auto provision users
+					falseValue, // disabled
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateStringInArray("genesyscloud_idp_pureengage.pureengage", "certificates", testCert1),
+					validateStringInArray("genesyscloud_idp_pureengage.pureengage", "certificates", testCert2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "certificates.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "issuer_uri", uri2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "target_uri", uri1),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "relying_party_identifier", relyingPartyID2),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "auto_provision_users", falseValue),
+					resource.TestCheckResourceAttr("genesyscloud_idp_pureengage.pureengage", "disabled", falseValue),
+				),
+			},
+			{
+				// Expired certificates are rejected at plan time
+				Config: generateIdpPureengageResource(
+					generateStringArray(strconv.Quote(testExpiredCert)),
+					uri2,
+					uri1,
+					strconv.Quote(relyingPartyID2),
+					falseValue, // auto provision users
+					falseValue, // disabled
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expired"),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_idp_pureengage.pureengage",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyIdpPureengageDestroyed,
+	})
+}
+
+func generateIdpPureengageResource(
+	certs string,
+	issuerURI string,
+	targetURI string,
+	partyID string,
+	autoProvisionUsers string,
+	disabled string) string {
+	return fmt.Sprintf(`resource "genesyscloud_idp_pureengage" "pureengage" {
+		certificates = %s
+		issuer_uri = "%s"
+		target_uri = "%s"
+        relying_party_identifier = %s
+        auto_provision_users = %s
+        disabled = %s
+	}
+	`, certs, issuerURI, targetURI, partyID, autoProvisionUsers, disabled)
+}
+
+func testVerifyIdpPureengageDestroyed(state *terraform.State) error {
+	idpAPI := platformclientv2.NewIdentityProviderApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_idp_pureengage" {
+			continue
+		}
+
+		pureengage, resp, err := idpAPI.GetIdentityprovidersPureengage()
+		if pureengage != nil {
+			return fmt.Errorf("Pureengage still exists")
+		} else if isStatus404(resp) {
+			// Pureengage not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. Pureengage config destroyed
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -37,10 +38,11 @@ var (
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 			"advanced": {
-				Description:      "Integration advanced config (JSON string).",
+				Description:      "Integration advanced config (JSON string). May contain secrets such as webhook tokens.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
+				Sensitive:        true,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 			"credentials": {
@@ -51,6 +53,40 @@ var (
 			},
 		},
 	}
+
+	integrationInlineCredentialResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Description: "Key of the credential in the integration config, as indicated in the credentials property of the Integration Type.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "Credential name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"credential_type_name": {
+				Description: "Credential type name. Use [GET /api/v2/integrations/credentials/types](https://developer.genesys.cloud/api/rest/v2/integrations/#get-api-v2-integrations-credentials-types) to see the list of available integration credential types.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"fields": {
+				Description: "Credential fields. Different credential types require different fields.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"fields_from_env":  credentialFieldsFromEnvSchema(),
+			"fields_from_file": credentialFieldsFromFileSchema(),
+			"credential_id": {
+				Description: "ID of the credential created for the integration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
 )
 
 func getAllIntegrations(_ context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
@@ -83,6 +119,7 @@ func integrationExporter() *ResourceExporter {
 			"config.credentials.*": {RefType: "genesyscloud_integration_credential"},
 		},
 		JsonEncodeAttributes: []string{"config.properties", "config.advanced"},
+		ExcludedAttributes:   []string{"credential", "credential_fields_hashes"},
 		EncodedRefAttrs: map[*JsonEncodeRefAttr]*RefAttrSettings{
 			{Attr: "config.properties", NestedAttr: "groups"}: {RefType: "genesyscloud_group"},
 		},
@@ -101,6 +138,7 @@ func resourceIntegration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeIntegrationCredentialsDiff,
 		Schema: map[string]*schema.Schema{
 			"intended_state": {
 				Description:  "Integration state (ENABLED | DISABLED | DELETED).",
@@ -122,6 +160,19 @@ func resourceIntegration() *schema.Resource {
 				Computed:    true,
 				Elem:        integrationConfigResource,
 			},
+			"credential": {
+				Description: "Credentials created and managed together with the integration. Each credential is bound to the integration config under its key, so it must not also be set in `config.credentials`. The credentials are deleted with the integration.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        integrationInlineCredentialResource,
+			},
+			"credential_fields_hashes": {
+				Description: "Salted HMAC-SHA256 hashes of the inline credential fields read from environment variables or files, keyed by credential key.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -148,8 +199,13 @@ func createIntegration(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	d.SetId(*integration.Id)
 
+	inlineCredentials, diagErr := updateIntegrationInlineCredentials(d, integrationAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
 	//Update integration config separately
-	diagErr, name := updateIntegrationConfig(d, integrationAPI)
+	diagErr, name := updateIntegrationConfig(d, integrationAPI, inlineCredentials)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -204,7 +260,7 @@ func readIntegration(ctx context.Context, d *schema.ResourceData, meta interface
 			return resource.NonRetryableError(fmt.Errorf("Failed to read config of integration %s: %s", d.Id(), getErr))
 		}
 
-		d.Set("config", flattenIntegrationConfig(integrationConfig))
+		d.Set("config", flattenIntegrationConfig(integrationConfig, getIntegrationInlineCredentialKeys(d)))
 
 		log.Printf("Read integration %s %s", d.Id(), *currentIntegration.Name)
 
@@ -219,11 +275,21 @@ func updateIntegration(ctx context.Context, d *schema.ResourceData, meta interfa
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	integrationAPI := platformclientv2.NewIntegrationsApiWithConfig(sdkConfig)
 
-	diagErr, name := updateIntegrationConfig(d, integrationAPI)
+	inlineCredentials, diagErr := updateIntegrationInlineCredentials(d, integrationAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr, name := updateIntegrationConfig(d, integrationAPI, inlineCredentials)
 	if diagErr != nil {
 		return diagErr
 	}
 
+	// Credentials removed from the config can only be deleted once they are no longer bound to it
+	if diagErr := deleteRemovedIntegrationInlineCredentials(d, integrationAPI); diagErr != nil {
+		return diagErr
+	}
+
 	if d.HasChange("intended_state") {
 
 		log.Printf("Updating integration %s", name)
@@ -251,7 +317,7 @@ func deleteIntegration(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("Failed to delete the integration %s: %s", d.Id(), err)
 	}
 
-	return withRetries(ctx, 30*time.Second, func() *resource.RetryError {
+	diagErr := withRetries(ctx, 30*time.Second, func() *resource.RetryError {
 		const pageSize = 100
 		const pageNum = 1
 		_, resp, err := integrationAPI.GetIntegration(d.Id(), pageSize, pageNum, "", nil, "", "")
@@ -265,9 +331,21 @@ func deleteIntegration(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 		return resource.RetryableError(fmt.Errorf("Integration %s still exists", d.Id()))
 	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	// Inline credentials are owned by the integration
+	for _, credential := range d.Get("credential").([]interface{}) {
+		credentialMap := credential.(map[string]interface{})
+		if diagErr := deleteIntegrationInlineCredential(credentialMap["credential_id"].(string), integrationAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
 }
 
-func flattenIntegrationConfig(config *platformclientv2.Integrationconfiguration) []interface{} {
+func flattenIntegrationConfig(config *platformclientv2.Integrationconfiguration, inlineCredentialKeys []string) []interface{} {
 	if config == nil {
 		return nil
 	}
@@ -305,7 +383,7 @@ func flattenIntegrationConfig(config *platformclientv2.Integrationconfiguration)
 		}
 	}
 	if config.Credentials != nil {
-		configCredentials = flattenConfigCredentials(*config.Credentials)
+		configCredentials = flattenConfigCredentials(*config.Credentials, inlineCredentialKeys)
 	}
 
 	return []interface{}{map[string]interface{}{
@@ -317,20 +395,23 @@ func flattenIntegrationConfig(config *platformclientv2.Integrationconfiguration)
 	}}
 }
 
-func flattenConfigCredentials(credentials map[string]platformclientv2.Credentialinfo) map[string]interface{} {
-	if len(credentials) == 0 {
-		return nil
-	}
-
+func flattenConfigCredentials(credentials map[string]platformclientv2.Credentialinfo, inlineCredentialKeys []string) map[string]interface{} {
 	results := make(map[string]interface{})
 	for k, v := range credentials {
+		// Credentials managed by the credential block are not part of the config
+		if StringInSlice(k, inlineCredentialKeys) {
+			continue
+		}
 		results[k] = *v.Id
 	}
+	if len(results) == 0 {
+		return nil
+	}
 	return results
 }
 
-func updateIntegrationConfig(d *schema.ResourceData, integrationAPI *platformclientv2.IntegrationsApi) (diag.Diagnostics, string) {
-	if d.HasChange("config") {
+func updateIntegrationConfig(d *schema.ResourceData, integrationAPI *platformclientv2.IntegrationsApi, inlineCredentials map[string]string) (diag.Diagnostics, string) {
+	if d.HasChanges("config", "credential") {
 		if configInput := d.Get("config").([]interface{}); configInput != nil {
 
 			integrationConfig, _, err := integrationAPI.GetIntegrationConfigCurrent(d.Id())
@@ -368,6 +449,15 @@ func updateIntegrationConfig(d *schema.ResourceData, integrationAPI *platformcli
 				credential = buildConfigCredentials(configMap["credentials"].(map[string]interface{}))
 			}
 
+			// Drop previously bound inline credentials that were removed, then bind the current ones
+			for _, key := range getIntegrationInlineCredentialKeysFromList(getOldIntegrationInlineCredentials(d)) {
+				delete(credential, key)
+			}
+			for key, credentialId := range inlineCredentials {
+				id := credentialId
+				credential[key] = platformclientv2.Credentialinfo{Id: &id}
+			}
+
 			diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 
 				// Get latest config version
@@ -408,3 +498,178 @@ func buildConfigCredentials(credentials map[string]interface{}) map[string]platf
 	}
 	return results
 }
+
+// customizeIntegrationCredentialsDiff resolves the inline credential fields during plan so changes to
+// environment variables or files show up as a change of credential_fields_hashes
+func customizeIntegrationCredentialsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	credentials := diff.Get("credential").([]interface{})
+	configCredentials, _ := diff.Get("config.0.credentials").(map[string]interface{})
+	oldHashes, _ := diff.Get("credential_fields_hashes").(map[string]interface{})
+
+	hashes := make(map[string]interface{})
+	for i, credential := range credentials {
+		for _, attr := range []string{"key", "fields", "fields_from_env", "fields_from_file"} {
+			if !diff.NewValueKnown(fmt.Sprintf("credential.%d.%s", i, attr)) {
+				return diff.SetNewComputed("credential_fields_hashes")
+			}
+		}
+
+		credentialMap := credential.(map[string]interface{})
+		key := credentialMap["key"].(string)
+		if _, exists := hashes[key]; exists {
+			return fmt.Errorf("credential key %s is set more than once", key)
+		}
+		if _, exists := configCredentials[key]; exists {
+			return fmt.Errorf("credential key %s is set in both the credential block and config.credentials", key)
+		}
+
+		fields, _ := credentialMap["fields"].(map[string]interface{})
+		fromEnv, _ := credentialMap["fields_from_env"].(map[string]interface{})
+		fromFile, _ := credentialMap["fields_from_file"].(map[string]interface{})
+		oldHash, _ := oldHashes[key].(string)
+		hash, err := credentialFieldsSourceHash(fields, fromEnv, fromFile, oldHash)
+		if err != nil {
+			return fmt.Errorf("credential %s: %s", key, err)
+		}
+		hashes[key] = hash
+	}
+
+	for key, hash := range hashes {
+		if hash == "" {
+			delete(hashes, key)
+		}
+	}
+	if !reflect.DeepEqual(hashes, oldHashes) && (len(hashes) > 0 || len(oldHashes) > 0) {
+		return diff.SetNew("credential_fields_hashes", hashes)
+	}
+	return nil
+}
+
+// updateIntegrationInlineCredentials creates or updates the credentials of the credential block and
+// returns their IDs keyed by credential key
+func updateIntegrationInlineCredentials(d *schema.ResourceData, integrationAPI *platformclientv2.IntegrationsApi) (map[string]string, diag.Diagnostics) {
+	results := make(map[string]string)
+	if !d.HasChanges("credential", "credential_fields_hashes") {
+		for _, credential := range d.Get("credential").([]interface{}) {
+			credentialMap := credential.(map[string]interface{})
+			results[credentialMap["key"].(string)] = credentialMap["credential_id"].(string)
+		}
+		return results, nil
+	}
+
+	oldCredentials := make(map[string]map[string]interface{})
+	for _, credential := range getOldIntegrationInlineCredentials(d) {
+		credentialMap := credential.(map[string]interface{})
+		oldCredentials[credentialMap["key"].(string)] = credentialMap
+	}
+	oldHashes, newHashes := d.GetChange("credential_fields_hashes")
+
+	credentials := d.Get("credential").([]interface{})
+	for _, credential := range credentials {
+		credentialMap := credential.(map[string]interface{})
+		key := credentialMap["key"].(string)
+		name := credentialMap["name"].(string)
+		credentialType := credentialMap["credential_type_name"].(string)
+
+		fields, _ := credentialMap["fields"].(map[string]interface{})
+		fromEnv, _ := credentialMap["fields_from_env"].(map[string]interface{})
+		fromFile, _ := credentialMap["fields_from_file"].(map[string]interface{})
+		resolvedFields, err := resolveCredentialFields(fields, fromEnv, fromFile)
+		if err != nil {
+			return nil, diag.Errorf("Failed to build fields for credential %s of integration %s: %s", key, d.Id(), err)
+		}
+
+		sdkCredential := platformclientv2.Credential{
+			Name: &name,
+			VarType: &platformclientv2.Credentialtype{
+				Name: &credentialType,
+			},
+			CredentialFields: &resolvedFields,
+		}
+
+		oldCredential, exists := oldCredentials[key]
+		if exists && oldCredential["credential_type_name"] == credentialType && oldCredential["credential_id"].(string) != "" {
+			credentialId := oldCredential["credential_id"].(string)
+			if isIntegrationInlineCredentialChanged(oldCredential, credentialMap) ||
+				oldHashes.(map[string]interface{})[key] != newHashes.(map[string]interface{})[key] {
+				log.Printf("Updating credential %s of integration %s", key, d.Id())
+				if _, _, err := integrationAPI.PutIntegrationsCredential(credentialId, sdkCredential); err != nil {
+					return nil, diag.Errorf("Failed to update credential %s of integration %s: %s", key, d.Id(), err)
+				}
+			}
+			credentialMap["credential_id"] = credentialId
+		} else {
+			log.Printf("Creating credential %s for integration %s", key, d.Id())
+			createdCredential, _, err := integrationAPI.PostIntegrationsCredentials(sdkCredential)
+			if err != nil {
+				return nil, diag.Errorf("Failed to create credential %s for integration %s: %s", key, d.Id(), err)
+			}
+			credentialMap["credential_id"] = *createdCredential.Id
+		}
+		results[key] = credentialMap["credential_id"].(string)
+	}
+
+	d.Set("credential", credentials)
+	return results, nil
+}
+
+// isIntegrationInlineCredentialChanged compares the configured attributes of two credential blocks
+func isIntegrationInlineCredentialChanged(oldCredential, newCredential map[string]interface{}) bool {
+	for _, attr := range []string{"name", "fields", "fields_from_env", "fields_from_file"} {
+		if !reflect.DeepEqual(oldCredential[attr], newCredential[attr]) {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteRemovedIntegrationInlineCredentials deletes the credentials that were previously created by the
+// credential block but are no longer part of it
+func deleteRemovedIntegrationInlineCredentials(d *schema.ResourceData, integrationAPI *platformclientv2.IntegrationsApi) diag.Diagnostics {
+	currentIds := make(map[string]bool)
+	for _, credential := range d.Get("credential").([]interface{}) {
+		currentIds[credential.(map[string]interface{})["credential_id"].(string)] = true
+	}
+
+	for _, credential := range getOldIntegrationInlineCredentials(d) {
+		credentialId := credential.(map[string]interface{})["credential_id"].(string)
+		if !currentIds[credentialId] {
+			if diagErr := deleteIntegrationInlineCredential(credentialId, integrationAPI); diagErr != nil {
+				return diagErr
+			}
+		}
+	}
+	return nil
+}
+
+func deleteIntegrationInlineCredential(credentialId string, integrationAPI *platformclientv2.IntegrationsApi) diag.Diagnostics {
+	if credentialId == "" {
+		return nil
+	}
+	log.Printf("Deleting integration credential %s", credentialId)
+	resp, err := integrationAPI.DeleteIntegrationsCredential(credentialId)
+	if err != nil && !isStatus404(resp) {
+		return diag.Errorf("Failed to delete integration credential %s: %s", credentialId, err)
+	}
+	return nil
+}
+
+func getOldIntegrationInlineCredentials(d *schema.ResourceData) []interface{} {
+	oldCredentials, _ := d.GetChange("credential")
+	if oldCredentials == nil {
+		return nil
+	}
+	return oldCredentials.([]interface{})
+}
+
+func getIntegrationInlineCredentialKeys(d *schema.ResourceData) []string {
+	return getIntegrationInlineCredentialKeysFromList(d.Get("credential").([]interface{}))
+}
+
+func getIntegrationInlineCredentialKeysFromList(credentials []interface{}) []string {
+	keys := make([]string, 0, len(credentials))
+	for _, credential := range credentials {
+		keys = append(keys, credential.(map[string]interface{})["key"].(string))
+	}
+	return keys
+}
@@ -41,9 +41,10 @@ var (
 				Computed:    true,
 			},
 			"headers": {
-				Description: "Map of headers in name, value pairs to include in request.",
+				Description: "Map of headers in name, value pairs to include in request. May contain secrets such as authorization headers.",
 				Type:        schema.TypeMap,
 				Optional:    true,
+				Sensitive:   true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 		},
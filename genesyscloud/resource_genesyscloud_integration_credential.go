@@ -2,8 +2,15 @@ package genesyscloud
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
@@ -47,6 +54,25 @@ func credentialExporter() *ResourceExporter {
 		UnResolvableAttributes: map[string]*schema.Schema{
 			"fields": resourceCredential().Schema["fields"],
 		},
+		ExcludedAttributes: []string{"fields_hash"},
+	}
+}
+
+func credentialFieldsFromEnvSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Credential fields read from environment variables, as a map of field name to environment variable name. The values are only read during plan and apply and are never stored in the state.",
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func credentialFieldsFromFileSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Credential fields read from local files, as a map of field name to file path. Trailing newlines are trimmed from the file contents. The values are only read during plan and apply and are never stored in the state.",
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 	}
 }
 
@@ -62,6 +88,7 @@ func resourceCredential() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeCredentialFieldsDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "Credential name.",
@@ -77,10 +104,17 @@ func resourceCredential() *schema.Resource {
 				Description: "Credential fields. Different credential types require different fields. Missing any correct required fields will result API request failure. Use [GET /api/v2/integrations/credentials/types](https://developer.genesys.cloud/api/rest/v2/integrations/#get-api-v2-integrations-credentials-types) to check out the specific credential type schema to find out what fields are required. ",
 				Type:        schema.TypeMap,
 				Optional:    true,
-				Computed:    true,
 				Sensitive:   true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"fields_from_env":  credentialFieldsFromEnvSchema(),
+			"fields_from_file": credentialFieldsFromFileSchema(),
+			"fields_hash": {
+				Description: "Salted HMAC-SHA256 hash of the resolved credential fields when any field is read from an environment variable or file. Used to detect changes to those values, as the API never returns the field values. The random salt is kept in the hash.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 		},
 	}
 }
@@ -93,12 +127,17 @@ func createCredential(ctx context.Context, d *schema.ResourceData, meta interfac
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	integrationAPI := platformclientv2.NewIntegrationsApiWithConfig(sdkConfig)
 
+	fields, err := buildCredentialFields(d)
+	if err != nil {
+		return diag.Errorf("Failed to build fields for credential %s: %s", name, err)
+	}
+
 	createCredential := platformclientv2.Credential{
 		Name: &name,
 		VarType: &platformclientv2.Credentialtype{
 			Name: &cred_type,
 		},
-		CredentialFields: buildCredentialFields(d),
+		CredentialFields: fields,
 	}
 
 	credential, _, err := integrationAPI.PostIntegrationsCredentials(createCredential)
@@ -145,16 +184,21 @@ func updateCredential(ctx context.Context, d *schema.ResourceData, meta interfac
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	integrationAPI := platformclientv2.NewIntegrationsApiWithConfig(sdkConfig)
 
-	if d.HasChanges("name", "credential_type_name", "fields") {
+	if d.HasChanges("name", "credential_type_name", "fields", "fields_hash") {
 
 		log.Printf("Updating credential %s", name)
 
+		fields, err := buildCredentialFields(d)
+		if err != nil {
+			return diag.Errorf("Failed to build fields for credential %s: %s", name, err)
+		}
+
 		_, _, putErr := integrationAPI.PutIntegrationsCredential(d.Id(), platformclientv2.Credential{
 			Name: &name,
 			VarType: &platformclientv2.Credentialtype{
 				Name: &cred_type,
 			},
-			CredentialFields: buildCredentialFields(d),
+			CredentialFields: fields,
 		})
 		if putErr != nil {
 			return diag.Errorf("Failed to update credential %s: %s", name, putErr)
@@ -188,14 +232,123 @@ func deleteCredential(ctx context.Context, d *schema.ResourceData, meta interfac
 	})
 }
 
-func buildCredentialFields(d *schema.ResourceData) *map[string]string {
+func buildCredentialFields(d *schema.ResourceData) (*map[string]string, error) {
+	fields, _ := d.Get("fields").(map[string]interface{})
+	fromEnv, _ := d.Get("fields_from_env").(map[string]interface{})
+	fromFile, _ := d.Get("fields_from_file").(map[string]interface{})
+
+	results, err := resolveCredentialFields(fields, fromEnv, fromFile)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// customizeCredentialFieldsDiff resolves the credential fields during plan so changes to environment variables
+// or files show up as a change of fields_hash without the values themselves being part of the plan
+func customizeCredentialFieldsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("fields") || !diff.NewValueKnown("fields_from_env") || !diff.NewValueKnown("fields_from_file") {
+		return diff.SetNewComputed("fields_hash")
+	}
+
+	fields, _ := diff.Get("fields").(map[string]interface{})
+	fromEnv, _ := diff.Get("fields_from_env").(map[string]interface{})
+	fromFile, _ := diff.Get("fields_from_file").(map[string]interface{})
+
+	oldHash := diff.Get("fields_hash").(string)
+	hash, err := credentialFieldsSourceHash(fields, fromEnv, fromFile, oldHash)
+	if err != nil {
+		return err
+	}
+	if hash != oldHash {
+		return diff.SetNew("fields_hash", hash)
+	}
+	return nil
+}
+
+// credentialFieldsSourceHash returns the hash of the resolved fields, or an empty string if no field
+// is read from an environment variable or file as literal fields are already diffed by Terraform.
+// The salt of the previous hash is reused so the hash only changes when the fields change.
+func credentialFieldsSourceHash(fields, fromEnv, fromFile map[string]interface{}, previousHash string) (string, error) {
+	if len(fromEnv) == 0 && len(fromFile) == 0 {
+		return "", nil
+	}
+	resolved, err := resolveCredentialFields(fields, fromEnv, fromFile)
+	if err != nil {
+		return "", err
+	}
+	return hashCredentialFields(resolved, previousHash)
+}
+
+// resolveCredentialFields merges the literal credential fields with the fields read from environment variables and files
+func resolveCredentialFields(fields, fromEnv, fromFile map[string]interface{}) (map[string]string, error) {
 	results := make(map[string]string)
-	if fields, ok := d.GetOk("fields"); ok {
-		fieldMap := fields.(map[string]interface{})
-		for k, v := range fieldMap {
-			results[k] = v.(string)
+	for k, v := range fields {
+		results[k] = v.(string)
+	}
+
+	for k, v := range fromEnv {
+		if _, exists := results[k]; exists {
+			return nil, fmt.Errorf("credential field %s is set more than once", k)
+		}
+		envName := v.(string)
+		value, present := os.LookupEnv(envName)
+		if !present {
+			return nil, fmt.Errorf("environment variable %s for credential field %s is not set", envName, k)
+		}
+		results[k] = value
+	}
+
+	for k, v := range fromFile {
+		if _, exists := results[k]; exists {
+			return nil, fmt.Errorf("credential field %s is set more than once", k)
+		}
+		filePath := v.(string)
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %s for credential field %s: %s", filePath, k, err)
+		}
+		results[k] = strings.TrimRight(string(content), "\r\n")
+	}
+
+	return results, nil
+}
+
+// hashCredentialFields returns a salted HMAC-SHA256 hash of the resolved credential fields as "<salt>:<hash>" in hex.
+// The salt of previousHash is reused if it has one, otherwise a random salt is generated, so short secrets cannot be
+// recovered from the state by hashing guesses.
+func hashCredentialFields(fields map[string]string, previousHash string) (string, error) {
+	salt, err := credentialFieldsHashSalt(previousHash)
+	if err != nil {
+		return "", err
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	hash := hmac.New(sha256.New, salt)
+	for _, k := range keys {
+		hash.Write([]byte(k))
+		hash.Write([]byte{0})
+		hash.Write([]byte(fields[k]))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(salt) + ":" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// credentialFieldsHashSalt returns the salt of a hash returned by hashCredentialFields or a new random salt
+func credentialFieldsHashSalt(previousHash string) ([]byte, error) {
+	if encodedSalt, _, found := strings.Cut(previousHash, ":"); found {
+		if salt, err := hex.DecodeString(encodedSalt); err == nil && len(salt) > 0 {
+			return salt, nil
 		}
-		return &results
 	}
-	return &results
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt for credential fields hash: %s", err)
+	}
+	return salt, nil
 }
@@ -1,8 +1,13 @@
 package genesyscloud
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -155,6 +160,75 @@ func TestAccResourceCredential(t *testing.T) {
 	})
 }
 
+func TestAccResourceCredentialFieldsFromSources(t *testing.T) {
+	var (
+		credResource = "test_credential_sources"
+		credName     = "Terraform Credential Test-" + uuid.NewString()
+		typeName     = "basicAuth"
+		passwordEnv  = "TF_TEST_CREDENTIAL_PASSWORD"
+		userNameFile = filepath.Join(t.TempDir(), "username.txt")
+	)
+
+	config := generateCredentialResource(
+		credResource,
+		strconv.Quote(credName),
+		strconv.Quote(typeName),
+		fmt.Sprintf(`fields_from_env = { password = "%s" }
+		fields_from_file = { userName = "%s" }`, passwordEnv, userNameFile),
+	)
+
+	var firstHash string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				PreConfig: func() {
+					t.Setenv(passwordEnv, "P@$$W0rd")
+					if err := os.WriteFile(userNameFile, []byte("someUserName\n"), 0600); err != nil {
+						t.Fatalf("Failed to write file %s: %s", userNameFile, err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_integration_credential."+credResource, "name", credName),
+					resource.TestCheckNoResourceAttr("genesyscloud_integration_credential."+credResource, "fields.password"),
+					resource.TestCheckResourceAttrWith("genesyscloud_integration_credential."+credResource, "fields_hash", func(value string) error {
+						firstHash = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Changing the environment variable updates the credential
+				PreConfig: func() {
+					t.Setenv(passwordEnv, "$tr0ng3rP@$$W0rd")
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("genesyscloud_integration_credential."+credResource, "fields_hash", func(value string) error {
+						if value == firstHash {
+							return fmt.Errorf("fields_hash did not change after updating %s", passwordEnv)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// Missing environment variables are reported at plan time
+				PreConfig: func() {
+					os.Unsetenv(passwordEnv)
+				},
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not set"),
+			},
+		},
+		CheckDestroy: testVerifyCredentialDestroyed,
+	})
+}
+
 func generateCredentialResource(resourceID string, name string, credentialType string, fields string) string {
 	return fmt.Sprintf(`resource "genesyscloud_integration_credential" "%s" {
         name = %s
@@ -189,3 +263,74 @@ func testVerifyCredentialDestroyed(state *terraform.State) error {
 	// Success. All credentials destroyed
 	return nil
 }
+
+func TestCredentialFieldMovedToEnv(t *testing.T) {
+	const (
+		userNameEnv = "TF_TEST_CREDENTIAL_MOVED_USERNAME"
+		passwordEnv = "TF_TEST_CREDENTIAL_MOVED_PASSWORD"
+	)
+	t.Setenv(userNameEnv, "someUserName")
+	t.Setenv(passwordEnv, "P@$$W0rd")
+
+	state := &terraform.InstanceState{
+		ID: "credential-id",
+		Attributes: map[string]string{
+			"id":                   "credential-id",
+			"name":                 "Credential",
+			"credential_type_name": "basicAuth",
+			"fields.%":             "2",
+			"fields.userName":      "someUserName",
+			"fields.password":      "P@$$W0rd",
+		},
+	}
+	// All the fields move from fields to fields_from_env
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                 "Credential",
+		"credential_type_name": "basicAuth",
+		"fields_from_env":      map[string]interface{}{"userName": userNameEnv, "password": passwordEnv},
+	})
+
+	instanceDiff, err := resourceCredential().Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("Failed to plan moving a credential field to an environment variable: %s", err)
+	}
+	if attrDiff := instanceDiff.Attributes["fields.password"]; attrDiff == nil || !attrDiff.NewRemoved {
+		t.Errorf("Expected fields.password to be removed, got %+v", attrDiff)
+	}
+	if attrDiff := instanceDiff.Attributes["fields_hash"]; attrDiff == nil || attrDiff.New == "" {
+		t.Errorf("Expected fields_hash to be set, got %+v", attrDiff)
+	}
+}
+
+func TestCredentialFieldsHashSalt(t *testing.T) {
+	fields := map[string]string{"pin": "1234"}
+
+	hash1, err := hashCredentialFields(fields, "")
+	if err != nil {
+		t.Fatalf("Failed to hash credential fields: %s", err)
+	}
+	hash2, err := hashCredentialFields(fields, "")
+	if err != nil {
+		t.Fatalf("Failed to hash credential fields: %s", err)
+	}
+	// Each resource gets its own salt, so equal secrets do not have equal hashes
+	if hash1 == hash2 {
+		t.Errorf("Expected different hashes for different salts, got %s", hash1)
+	}
+
+	// The salt of the previous hash is reused, so the hash only changes with the fields
+	if rehash, err := hashCredentialFields(fields, hash1); err != nil || rehash != hash1 {
+		t.Errorf("Expected hash %s with the previous salt, got %s (%v)", hash1, rehash, err)
+	}
+	changedHash, err := hashCredentialFields(map[string]string{"pin": "1235"}, hash1)
+	if err != nil {
+		t.Fatalf("Failed to hash credential fields: %s", err)
+	}
+	if changedHash == hash1 {
+		t.Error("Expected the hash to change with the fields")
+	}
+	salt1, _, _ := strings.Cut(hash1, ":")
+	if salt, _, _ := strings.Cut(changedHash, ":"); salt != salt1 {
+		t.Errorf("Expected salt %s to be kept, got %s", salt1, salt)
+	}
+}
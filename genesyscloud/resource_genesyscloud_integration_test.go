@@ -2,6 +2,8 @@ package genesyscloud
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -312,6 +314,135 @@ func TestAccResourceIntegration(t *testing.T) {
 	})
 }
 
+func TestAccResourceIntegrationInlineCredential(t *testing.T) {
+	var (
+		inteResource  = "test_integration_inline_cred"
+		inteName      = "Terraform Integration Test-" + uuid.NewString()
+		typeID        = "custom-smtp-server"
+		credTypeName  = "basicAuth"
+		credName      = "Terraform Credential Test-" + uuid.NewString()
+		passwordEnv   = "TF_TEST_INTEGRATION_SMTP_PASSWORD"
+		passwordFile  = filepath.Join(t.TempDir(), "password.txt")
+		integrationID string
+	)
+
+	config := func(credAttrs string) string {
+		return generateIntegrationResource(
+			inteResource,
+			nullValue,
+			strconv.Quote(typeID),
+			generateIntegrationConfig(
+				strconv.Quote(inteName),
+				nullValue,
+				"",
+				generateJsonEncodedProperties(
+					generateJsonProperty("smtpHost", strconv.Quote("fakeHost")),
+				),
+				nullValue,
+			),
+			fmt.Sprintf(`credential {
+				key = "%s"
+				name = "%s"
+				credential_type_name = "%s"
+				fields = {
+					userName = "someUserName"
+				}
+				%s
+			}`, credTypeName, credName, credTypeName, credAttrs),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with the password read from an environment variable
+				PreConfig: func() {
+					t.Setenv(passwordEnv, "P@$$W0rd")
+				},
+				Config: config(fmt.Sprintf(`fields_from_env = { password = "%s" }`, passwordEnv)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("genesyscloud_integration."+inteResource, "credential.0.credential_id"),
+					resource.TestCheckResourceAttrSet("genesyscloud_integration."+inteResource, "credential_fields_hashes."+credTypeName),
+					resource.TestCheckNoResourceAttr("genesyscloud_integration."+inteResource, "config.0.credentials.%"),
+					testIntegrationCredentialBound("genesyscloud_integration."+inteResource, credTypeName),
+					func(state *terraform.State) error {
+						integrationID = state.RootModule().Resources["genesyscloud_integration."+inteResource].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Switch the password to a file
+				PreConfig: func() {
+					if err := os.WriteFile(passwordFile, []byte("$tr0ng3rP@$$W0rd\n"), 0600); err != nil {
+						t.Fatalf("Failed to write password file: %s", err)
+					}
+				},
+				Config: config(fmt.Sprintf(`fields_from_file = { password = "%s" }`, passwordFile)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("genesyscloud_integration."+inteResource, "credential.0.credential_id"),
+					testIntegrationCredentialBound("genesyscloud_integration."+inteResource, credTypeName),
+					func(state *terraform.State) error {
+						if id := state.RootModule().Resources["genesyscloud_integration."+inteResource].Primary.ID; id != integrationID {
+							return fmt.Errorf("Integration was recreated: %s != %s", id, integrationID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Remove the inline credential
+				Config: generateIntegrationResource(
+					inteResource,
+					nullValue,
+					strconv.Quote(typeID),
+					generateIntegrationConfig(
+						strconv.Quote(inteName),
+						nullValue,
+						"",
+						generateJsonEncodedProperties(
+							generateJsonProperty("smtpHost", strconv.Quote("fakeHost")),
+						),
+						nullValue,
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_integration."+inteResource, "credential.#", "0"),
+					resource.TestCheckNoResourceAttr("genesyscloud_integration."+inteResource, "config.0.credentials.%"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyIntegrationDestroyed,
+	})
+}
+
+// testIntegrationCredentialBound verifies the inline credential is bound to the integration config under its key
+func testIntegrationCredentialBound(resourceName string, key string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resourceState, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state", resourceName)
+		}
+		credentialId := resourceState.Primary.Attributes["credential.0.credential_id"]
+
+		integrationAPI := platformclientv2.NewIntegrationsApi()
+		integrationConfig, _, err := integrationAPI.GetIntegrationConfigCurrent(resourceState.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Failed to get config of integration %s: %s", resourceState.Primary.ID, err)
+		}
+		if integrationConfig.Credentials == nil {
+			return fmt.Errorf("Integration %s has no credentials", resourceState.Primary.ID)
+		}
+		credential, ok := (*integrationConfig.Credentials)[key]
+		if !ok || credential.Id == nil || *credential.Id != credentialId {
+			return fmt.Errorf("Credential %s is not bound to integration %s under key %s", credentialId, resourceState.Primary.ID, key)
+		}
+		return nil
+	}
+}
+
 func generateIntegrationResource(resourceID string, intendedState string, integrationType string, attrs ...string) string {
 	return fmt.Sprintf(`resource "genesyscloud_integration" "%s" {
         intended_state = %s
@@ -31,9 +31,10 @@ var (
 				Optional:    true,
 			},
 			"parent_id": {
-				Description: "Knowledge category parent id",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "Knowledge category parent id. The ID of a `genesyscloud_knowledge_category` resource can also be used.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressKnowledgeCategoryResourceId,
 			},
 		},
 	}
@@ -253,7 +254,8 @@ func buildKnowledgeCategory(categoryIn map[string]interface{}) *platformclientv2
 		categoryOut.Description = &description
 	}
 	if parentId, ok := categoryIn["parent_id"].(string); ok && parentId != "" {
-		categoryOut.ParentCategoryId = &parentId
+		parentCategoryId := strings.Split(parentId, ",")[0]
+		categoryOut.ParentCategoryId = &parentCategoryId
 	}
 
 	return &categoryOut
@@ -274,3 +276,8 @@ func flattenKnowledgeCategory(categoryIn platformclientv2.Categoryresponse) []in
 
 	return []interface{}{categoryOut}
 }
+
+// suppressKnowledgeCategoryResourceId suppresses the diff between a category ID and a resource ID in the form {categoryId},{knowledgeBaseId}
+func suppressKnowledgeCategoryResourceId(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.Split(old, ",")[0] == strings.Split(new, ",")[0]
+}
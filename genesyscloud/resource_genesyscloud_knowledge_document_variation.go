@@ -21,11 +21,24 @@ var (
 	knowledgeDocumentVariation = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"body": {
-				Description: "The content for the variation.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
+				Description:   "The content for the variation.",
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				Elem:          documentBody,
+				ConflictsWith: []string{"knowledge_document_variation.0.body_filepath"},
+			},
+			"body_filepath": {
+				Description:   "Path to a Markdown (.md) or HTML (.html) file with the content for the variation. The file is converted to body blocks; bold, italic and underline text, links, images, lists and videos (`<video src=\"url\"></video>`) are supported, and headings become bold paragraphs.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validatePath,
+				ConflictsWith: []string{"knowledge_document_variation.0.body"},
+			},
+			"body_file_content_hash": {
+				Description: "Hash value of the body file content. Used to detect changes to the file, e.g. `filesha256(\"article.md\")`.",
+				Type:        schema.TypeString,
 				Optional:    true,
-				Elem:        documentBody,
 			},
 			"document_version": {
 				Description: "The version of the document.",
@@ -268,6 +281,10 @@ func knowledgeDocumentVariationExporter() *ResourceExporter {
 			"knowledge_base_id":     {RefType: "genesyscloud_knowledge_knowledgebase"},
 			"knowledge_document_id": {RefType: "genesyscloud_knowledge_document"},
 		},
+		CustomFileWriter: CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: KnowledgeDocumentVariationResolver,
+			SubDirectory:              "knowledge_documents",
+		},
 	}
 }
 
@@ -323,7 +340,10 @@ func createKnowledgeDocumentVariation(ctx context.Context, d *schema.ResourceDat
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
 
-	knowledgeDocumentVariationRequest := buildKnowledgeDocumentVariation(knowledgeDocumentVariation)
+	knowledgeDocumentVariationRequest, err := buildKnowledgeDocumentVariation(knowledgeDocumentVariation)
+	if err != nil {
+		return diag.Errorf("Failed to build variation for knowledge document %s: %s", knowledgeDocumentId, err)
+	}
 
 	log.Printf("Creating knowledge document variation for document %s", knowledgeDocumentId)
 
@@ -435,7 +455,15 @@ func readKnowledgeDocumentVariation(ctx context.Context, d *schema.ResourceData,
 		d.SetId(newId)
 		d.Set("knowledge_base_id", *knowledgeDocumentVariation.Document.KnowledgeBase.Id)
 		d.Set("knowledge_document_id", documentResourceId)
-		d.Set("knowledge_document_variation", flattenKnowledgeDocumentVariation(*knowledgeDocumentVariation))
+		flattenedVariation := flattenKnowledgeDocumentVariation(*knowledgeDocumentVariation)
+		if bodyFilepath, _ := d.Get("knowledge_document_variation.0.body_filepath").(string); bodyFilepath != "" {
+			// The body is managed through the file, so keep the file attributes instead of the body blocks
+			variationMap := flattenedVariation[0].(map[string]interface{})
+			delete(variationMap, "body")
+			variationMap["body_filepath"] = bodyFilepath
+			variationMap["body_file_content_hash"] = d.Get("knowledge_document_variation.0.body_file_content_hash")
+		}
+		d.Set("knowledge_document_variation", flattenedVariation)
 
 		if knowledgeDocumentVariation.DocumentVersion != nil && knowledgeDocumentVariation.DocumentVersion.Id != nil && len(*knowledgeDocumentVariation.DocumentVersion.Id) > 0 {
 			d.Set("published", true)
@@ -472,7 +500,10 @@ func updateKnowledgeDocumentVariation(ctx context.Context, d *schema.ResourceDat
 			return resp, diag.Errorf("Failed to read knowledge document variation %s: %s", documentVariationId, getErr)
 		}
 
-		knowledgeDocumentVariationUpdate := buildKnowledgeDocumentVariationUpdate(knowledgeDocumentVariation)
+		knowledgeDocumentVariationUpdate, buildErr := buildKnowledgeDocumentVariationUpdate(knowledgeDocumentVariation)
+		if buildErr != nil {
+			return nil, diag.Errorf("Failed to build knowledge document variation %s: %s", documentVariationId, buildErr)
+		}
 
 		log.Printf("Updating knowledge document variation %s", documentVariationId)
 		_, resp, putErr := knowledgeAPI.PatchKnowledgeKnowledgebaseDocumentVariation(documentVariationId, knowledgeDocumentId, knowledgeBaseId, *knowledgeDocumentVariationUpdate)
@@ -692,19 +723,59 @@ func buildVariationBody(bodyIn map[string]interface{}) *platformclientv2.Documen
 	return nil
 }
 
-func buildKnowledgeDocumentVariation(variationIn map[string]interface{}) *platformclientv2.Documentvariation {
+// buildVariationBodyFromInput builds the variation body from the body_filepath file if set, or from the body blocks
+func buildVariationBodyFromInput(variationIn map[string]interface{}) (*platformclientv2.Documentbody, error) {
+	if bodyFilepath, ok := variationIn["body_filepath"].(string); ok && bodyFilepath != "" {
+		return documentBodyFromFile(bodyFilepath)
+	}
+	return buildVariationBody(variationIn), nil
+}
+
+func buildKnowledgeDocumentVariation(variationIn map[string]interface{}) (*platformclientv2.Documentvariation, error) {
+	body, err := buildVariationBodyFromInput(variationIn)
+	if err != nil {
+		return nil, err
+	}
 	variationOut := platformclientv2.Documentvariation{
-		Body: buildVariationBody(variationIn),
+		Body: body,
 	}
-	return &variationOut
+	return &variationOut, nil
 }
 
-func buildKnowledgeDocumentVariationUpdate(variationIn map[string]interface{}) *platformclientv2.Documentvariation {
+func buildKnowledgeDocumentVariationUpdate(variationIn map[string]interface{}) (*platformclientv2.Documentvariation, error) {
+	body, err := buildVariationBodyFromInput(variationIn)
+	if err != nil {
+		return nil, err
+	}
 	variationOut := platformclientv2.Documentvariation{
-		Body: buildVariationBody(variationIn),
+		Body: body,
 	}
 
-	return &variationOut
+	return &variationOut, nil
+}
+
+// getKnowledgeDocumentVariationBody gets the body of a variation from its resource ID for the exporter
+func getKnowledgeDocumentVariationBody(variationResourceId string, published bool, meta interface{}) (*platformclientv2.Documentbody, error) {
+	id := strings.Split(variationResourceId, " ")
+	if len(id) < 3 {
+		return nil, fmt.Errorf("invalid knowledge document variation ID %s", variationResourceId)
+	}
+	documentVariationId := id[0]
+	knowledgeBaseId := id[1]
+	knowledgeDocumentId := strings.Split(id[2], ",")[0]
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+
+	documentState := "Draft"
+	if published {
+		documentState = "Published"
+	}
+	variation, _, err := knowledgeAPI.GetKnowledgeKnowledgebaseDocumentVariation(documentVariationId, knowledgeDocumentId, knowledgeBaseId, documentState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read knowledge document variation %s: %s", documentVariationId, err)
+	}
+	return variation.Body, nil
 }
 
 func flattenDocumentText(textIn platformclientv2.Documenttext) []interface{} {
@@ -2,6 +2,8 @@ package genesyscloud
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -129,6 +131,97 @@ func TestAccResourceKnowledgeDocumentVariationBasic(t *testing.T) {
 	})
 }
 
+func TestAccResourceKnowledgeDocumentVariationBodyFile(t *testing.T) {
+	var (
+		variationResource         = "test-variation-file"
+		knowledgeBaseResource     = "test-knowledgebase-file"
+		knowledgeBaseName         = "Terraform Knowledge Base " + uuid.NewString()
+		knowledgeDocumentResource = "test-knowledge-document-file"
+		bodyFile                  = filepath.Join(t.TempDir(), "article.md")
+	)
+
+	config := generateKnowledgeKnowledgebaseResource(
+		knowledgeBaseResource,
+		knowledgeBaseName,
+		"test-knowledgebase-description",
+		"en-US",
+	) +
+		generateKnowledgeDocumentBasic(
+			knowledgeDocumentResource,
+			knowledgeBaseResource,
+			"Terraform Knowledge Document",
+			true,
+			false,
+			"Terraform Knowledge Document",
+			true,
+		) +
+		fmt.Sprintf(`
+		resource "genesyscloud_knowledge_document_variation" "%s" {
+			depends_on            = [genesyscloud_knowledge_document.%s]
+			knowledge_base_id     = genesyscloud_knowledge_knowledgebase.%s.id
+			knowledge_document_id = genesyscloud_knowledge_document.%s.id
+			published             = true
+			knowledge_document_variation {
+				body_filepath          = "%s"
+				body_file_content_hash = filesha256("%s")
+			}
+		}
+		`, variationResource, knowledgeDocumentResource, knowledgeBaseResource, knowledgeDocumentResource, bodyFile, bodyFile)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create from Markdown
+				PreConfig: func() {
+					if err := os.WriteFile(bodyFile, []byte("Reset your **password**\n\n- Open settings\n- Click reset\n"), 0644); err != nil {
+						t.Fatalf("Failed to write file %s: %s", bodyFile, err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_knowledge_document_variation."+variationResource, "knowledge_document_variation.0.body_filepath", bodyFile),
+					resource.TestCheckResourceAttrSet("genesyscloud_knowledge_document_variation."+variationResource, "knowledge_document_variation.0.body_file_content_hash"),
+					resource.TestCheckNoResourceAttr("genesyscloud_knowledge_document_variation."+variationResource, "knowledge_document_variation.0.body.#"),
+					testVerifyKnowledgeDocumentVariationBody("genesyscloud_knowledge_document_variation."+variationResource, "Reset your **password**\n\n- Open settings\n- Click reset\n"),
+				),
+			},
+			{
+				// Update the file content
+				PreConfig: func() {
+					if err := os.WriteFile(bodyFile, []byte("Reset your *password* in [settings](https://example.com/settings)\n"), 0644); err != nil {
+						t.Fatalf("Failed to write file %s: %s", bodyFile, err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testVerifyKnowledgeDocumentVariationBody("genesyscloud_knowledge_document_variation."+variationResource, "Reset your *password* in [settings](https://example.com/settings)\n"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyKnowledgeDocumentVariationDestroyed,
+	})
+}
+
+// testVerifyKnowledgeDocumentVariationBody checks that the published variation body converts back to the expected Markdown
+func testVerifyKnowledgeDocumentVariationBody(resourceName, expectedMarkdown string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		variationResource, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find knowledge document variation %s in state", resourceName)
+		}
+		body, err := getKnowledgeDocumentVariationBody(variationResource.Primary.ID, true, &ProviderMeta{ClientConfig: platformclientv2.GetDefaultConfiguration()})
+		if err != nil {
+			return err
+		}
+		if markdown := documentBodyToMarkdown(body); markdown != expectedMarkdown {
+			return fmt.Errorf("Expected variation body %q, got %q", expectedMarkdown, markdown)
+		}
+		return nil
+	}
+}
+
 func generateKnowledgeDocumentVariation(resourceName string, knowledgeBaseResourceName string, knowledgeDocumentResourceName string, published bool, bodyBlockType string, contentBlockType string, imageUrl string, hyperlink string, videoUrl string, listType string, documentText string, marks []string) string {
 	variation := fmt.Sprintf(`
         resource "genesyscloud_knowledge_document_variation" "%s" {
@@ -0,0 +1,377 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+// Knowledge import job statuses
+const (
+	knowledgeImportStatusValidationCompleted = "ValidationCompleted"
+	knowledgeImportStatusValidationFailed    = "ValidationFailed"
+	knowledgeImportStatusStarted             = "Started"
+	knowledgeImportStatusCompleted           = "Completed"
+	knowledgeImportStatusPartialCompleted    = "PartialCompleted"
+	knowledgeImportStatusFailed              = "Failed"
+)
+
+var knowledgeImportStatisticsResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"documents_created": {
+			Description: "Number of documents created by the import.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"documents_updated": {
+			Description: "Number of documents updated by the import.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"documents_imported": {
+			Description: "Number of documents imported successfully.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"documents_failed": {
+			Description: "Number of documents that failed validation or import.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	},
+}
+
+func resourceKnowledgeImport() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Knowledge Import. Imports a JSON, CSV, XLSX or HTML export file into a knowledge base with the knowledge import jobs API.
+
+Documents are imported when this resource is created or replaced. Destroying this resource does not remove the imported documents from the knowledge base.`,
+
+		CreateContext: createWithPooledClient(createKnowledgeImport),
+		ReadContext:   readWithPooledClient(readKnowledgeImport),
+		DeleteContext: deleteWithPooledClient(deleteKnowledgeImport),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"knowledge_base_id": {
+				Description: "ID of the knowledge base to import the documents into.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"filepath": {
+				Description:  "Path to the file to import.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the file content. Used to detect changes, e.g. `filesha256(\"articles.json\")`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"file_type": {
+				Description:  "The type of the file to import.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Json", "Csv", "Xlsx", "Html"}, false),
+			},
+			"import_as_new": {
+				Description: "Create new documents even if an existing document could be updated.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"visible": {
+				Description: "If set, overrides the visibility of the imported documents.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"category_id": {
+				Description: "If set, overrides the category of the imported documents.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"label_ids": {
+				Description: "IDs of labels to add to the imported documents.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"fail_on_document_errors": {
+				Description: "Fail the apply when some documents could not be imported. When false, document failures are reported as warnings.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"status": {
+				Description: "The status of the import job.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"statistics": {
+				Description: "Statistics of the import job.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        knowledgeImportStatisticsResource,
+			},
+		},
+	}
+}
+
+func createKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	knowledgeBaseId := d.Get("knowledge_base_id").(string)
+	filePath := d.Get("filepath").(string)
+	fileType := d.Get("file_type").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+
+	uploadKey, err := uploadKnowledgeImportFile(knowledgeAPI, filePath)
+	if err != nil {
+		return diag.Errorf("Failed to upload knowledge import file %s: %s", filePath, err)
+	}
+
+	log.Printf("Creating knowledge import job for knowledge base %s", knowledgeBaseId)
+	importJob, _, err := knowledgeAPI.PostKnowledgeKnowledgebaseImportJobs(knowledgeBaseId, platformclientv2.Knowledgeimportjobrequest{
+		UploadKey: &uploadKey,
+		FileType:  &fileType,
+		Settings:  buildKnowledgeImportJobSettings(d),
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create knowledge import job for knowledge base %s: %s", knowledgeBaseId, err)
+	}
+	importJobId := *importJob.Id
+	d.SetId(importJobId)
+	log.Printf("Created knowledge import job %s", importJobId)
+
+	importJob, diagErr := waitForKnowledgeImportJob(ctx, d, knowledgeAPI, knowledgeBaseId, importJobId)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diags := buildKnowledgeImportJobDiagnostics(importJob, d.Get("fail_on_document_errors").(bool))
+	if diags.HasError() {
+		// Nothing was imported if the validation failed, so don't keep the job in state
+		if *importJob.Status == knowledgeImportStatusValidationFailed {
+			d.SetId("")
+		}
+		return diags
+	}
+
+	log.Printf("Finished knowledge import job %s with status %s", importJobId, *importJob.Status)
+	return append(diags, readKnowledgeImport(ctx, d, meta)...)
+}
+
+// uploadKnowledgeImportFile uploads the import file to a presigned URL and returns its upload key
+func uploadKnowledgeImportFile(knowledgeAPI *platformclientv2.KnowledgeApi, filePath string) (string, error) {
+	reader, file, err := downloadOrOpenFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	fileName := filepath.Base(filePath)
+	upload, _, err := knowledgeAPI.PostKnowledgeDocumentuploads(platformclientv2.Uploadurlrequest{
+		FileName: &fileName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get upload URL: %s", err)
+	}
+
+	headers := make(map[string]string)
+	if upload.Headers != nil {
+		headers = *upload.Headers
+	}
+	if _, err := NewS3Uploader(reader, nil, nil, headers, "PUT", *upload.Url).Upload(); err != nil {
+		return "", err
+	}
+	return *upload.UploadKey, nil
+}
+
+func buildKnowledgeImportJobSettings(d *schema.ResourceData) *platformclientv2.Knowledgeimportjobsettings {
+	importAsNew := d.Get("import_as_new").(bool)
+	settings := platformclientv2.Knowledgeimportjobsettings{
+		ImportAsNew: &importAsNew,
+	}
+	if visible, ok := d.GetOkExists("visible"); ok {
+		visibleBool := visible.(bool)
+		settings.Visible = &visibleBool
+	}
+	if categoryId, ok := d.GetOk("category_id"); ok {
+		categoryIdStr := categoryId.(string)
+		settings.CategoryId = &categoryIdStr
+	}
+	if labelIds, ok := d.GetOk("label_ids"); ok {
+		labelIdList := InterfaceListToStrings(labelIds.([]interface{}))
+		settings.LabelIds = &labelIdList
+	}
+	return &settings
+}
+
+// waitForKnowledgeImportJob polls the import job until it finishes, starting the import once the file is validated
+func waitForKnowledgeImportJob(ctx context.Context, d *schema.ResourceData, knowledgeAPI *platformclientv2.KnowledgeApi, knowledgeBaseId, importJobId string) (*platformclientv2.Knowledgeimportjobresponse, diag.Diagnostics) {
+	var importJob *platformclientv2.Knowledgeimportjobresponse
+	retryErr := withRetries(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		job, resp, err := knowledgeAPI.GetKnowledgeKnowledgebaseImportJob(knowledgeBaseId, importJobId)
+		if err != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to find knowledge import job %s: %s", importJobId, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error retrieving knowledge import job %s: %s", importJobId, err))
+		}
+		importJob = job
+
+		switch *job.Status {
+		case knowledgeImportStatusValidationCompleted:
+			log.Printf("Knowledge import job %s validated. Starting import", importJobId)
+			status := knowledgeImportStatusStarted
+			if _, _, err := knowledgeAPI.PatchKnowledgeKnowledgebaseImportJob(knowledgeBaseId, importJobId, platformclientv2.Importstatusrequest{Status: &status}); err != nil {
+				return resource.NonRetryableError(fmt.Errorf("Failed to start knowledge import job %s: %s", importJobId, err))
+			}
+		case knowledgeImportStatusValidationFailed, knowledgeImportStatusCompleted, knowledgeImportStatusPartialCompleted, knowledgeImportStatusFailed:
+			return nil
+		}
+
+		time.Sleep(5 * time.Second) // Wait 5 seconds for next retry
+		return resource.RetryableError(fmt.Errorf("Knowledge import job %s did not finish. Status: %s", importJobId, *job.Status))
+	})
+	return importJob, retryErr
+}
+
+// buildKnowledgeImportJobDiagnostics reports the result of a finished import job and the errors of each failed document
+func buildKnowledgeImportJobDiagnostics(importJob *platformclientv2.Knowledgeimportjobresponse, failOnDocumentErrors bool) diag.Diagnostics {
+	status := *importJob.Status
+	jobFailed := status == knowledgeImportStatusValidationFailed || status == knowledgeImportStatusFailed
+
+	var diags diag.Diagnostics
+	if importJob.Report != nil && importJob.Report.Errors != nil {
+		severity := diag.Warning
+		if jobFailed || failOnDocumentErrors {
+			severity = diag.Error
+		}
+		for _, importErr := range *importJob.Report.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  knowledgeImportErrorSummary(importErr),
+				Detail:   knowledgeImportErrorDetail(importErr),
+			})
+		}
+	}
+
+	if jobFailed && !diags.HasError() {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Knowledge import job %s finished with status %s", *importJob.Id, status),
+		})
+	}
+	return diags
+}
+
+func knowledgeImportErrorSummary(importErr platformclientv2.Knowledgeimportjoberror) string {
+	if importErr.DocumentIndex == nil {
+		return "Knowledge import failed"
+	}
+	if importErr.EntityName != nil && *importErr.EntityName != "" {
+		return fmt.Sprintf("Failed to import document %d (%s)", *importErr.DocumentIndex, *importErr.EntityName)
+	}
+	return fmt.Sprintf("Failed to import document %d", *importErr.DocumentIndex)
+}
+
+func knowledgeImportErrorDetail(importErr platformclientv2.Knowledgeimportjoberror) string {
+	var details []string
+	if importErr.Message != nil {
+		details = append(details, *importErr.Message)
+	}
+	if importErr.Code != nil {
+		details = append(details, fmt.Sprintf("Code: %s", *importErr.Code))
+	}
+	if importErr.Errors != nil {
+		for _, nestedErr := range *importErr.Errors {
+			if nestedErr.Message != nil {
+				details = append(details, *nestedErr.Message)
+			}
+		}
+	}
+	return strings.Join(details, "\n")
+}
+
+func readKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	knowledgeBaseId := d.Get("knowledge_base_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+
+	log.Printf("Reading knowledge import job %s", d.Id())
+	importJob, resp, getErr := knowledgeAPI.GetKnowledgeKnowledgebaseImportJob(knowledgeBaseId, d.Id())
+	if getErr != nil {
+		if isStatus404(resp) {
+			// Import jobs are removed after some time, but the imported documents remain, so keep the last known state
+			log.Printf("Knowledge import job %s no longer exists", d.Id())
+			return nil
+		}
+		return diag.Errorf("Failed to read knowledge import job %s: %s", d.Id(), getErr)
+	}
+
+	if importJob.Status != nil {
+		d.Set("status", *importJob.Status)
+	}
+	if importJob.Report != nil && importJob.Report.Statistics != nil {
+		d.Set("statistics", flattenKnowledgeImportJobStatistics(importJob.Report.Statistics))
+	}
+
+	log.Printf("Read knowledge import job %s", d.Id())
+	return nil
+}
+
+func flattenKnowledgeImportJobStatistics(statistics *platformclientv2.Knowledgeimportjobstatistics) []interface{} {
+	intValue := func(value *int) int {
+		if value == nil {
+			return 0
+		}
+		return *value
+	}
+	return []interface{}{map[string]interface{}{
+		"documents_created":  intValue(statistics.CountDocumentImportActivityCreate),
+		"documents_updated":  intValue(statistics.CountDocumentImportActivityUpdate),
+		"documents_imported": intValue(statistics.CountDocumentImportSuccess),
+		"documents_failed":   intValue(statistics.CountDocumentValidationFailure) + intValue(statistics.CountDocumentImportFailure),
+	}}
+}
+
+func deleteKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	knowledgeBaseId := d.Get("knowledge_base_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+
+	// The imported documents are not removed. Only the import job is deleted.
+	log.Printf("Deleting knowledge import job %s", d.Id())
+	resp, err := knowledgeAPI.DeleteKnowledgeKnowledgebaseImportJob(knowledgeBaseId, d.Id())
+	if err != nil && !isStatus404(resp) {
+		return diag.Errorf("Failed to delete knowledge import job %s: %s", d.Id(), err)
+	}
+	log.Printf("Deleted knowledge import job %s", d.Id())
+	return nil
+}
@@ -0,0 +1,119 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceKnowledgeImport(t *testing.T) {
+	var (
+		knowledgeBaseResource = "test-knowledgebase-import"
+		knowledgeBaseName     = "Terraform Knowledge Base " + uuid.NewString()
+		importResource        = "test-knowledge-import"
+		importFile            = filepath.Join(t.TempDir(), "articles.json")
+	)
+
+	articles := `{
+	"version": 3,
+	"documents": [
+		{
+			"title": "How do I reset my password?",
+			"visible": true,
+			"alternatives": [{"phrase": "Forgot password", "autocomplete": true}],
+			"variations": [{"body": {"blocks": [{"type": "Paragraph", "paragraph": {"blocks": [{"type": "Text", "text": {"text": "Open settings and click reset."}}]}}]}}]
+		}
+	]
+}`
+	if err := os.WriteFile(importFile, []byte(articles), 0644); err != nil {
+		t.Fatalf("Failed to write file %s: %s", importFile, err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateKnowledgeKnowledgebaseResource(
+					knowledgeBaseResource,
+					knowledgeBaseName,
+					"test-knowledgebase-description",
+					"en-US",
+				) + fmt.Sprintf(`
+				resource "genesyscloud_knowledge_import" "%s" {
+					knowledge_base_id = genesyscloud_knowledge_knowledgebase.%s.id
+					filepath          = "%s"
+					file_content_hash = filesha256("%s")
+					file_type         = "Json"
+					visible           = true
+				}
+				`, importResource, knowledgeBaseResource, importFile, importFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_knowledge_import."+importResource, "status", "Completed"),
+					resource.TestCheckResourceAttr("genesyscloud_knowledge_import."+importResource, "statistics.0.documents_imported", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_knowledge_import."+importResource, "statistics.0.documents_failed", "0"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyKnowledgebasesDestroyed,
+	})
+}
+
+func TestBuildKnowledgeImportJobDiagnostics(t *testing.T) {
+	importErrors := []platformclientv2.Knowledgeimportjoberror{
+		{
+			DocumentIndex: platformclientv2.Int(2),
+			EntityName:    platformclientv2.String("Reset password"),
+			Message:       platformclientv2.String("Title is too long"),
+			Code:          platformclientv2.String("bad.request"),
+		},
+		{
+			DocumentIndex: platformclientv2.Int(5),
+			Message:       platformclientv2.String("Category not found"),
+		},
+	}
+	importJob := func(status string) *platformclientv2.Knowledgeimportjobresponse {
+		return &platformclientv2.Knowledgeimportjobresponse{
+			Id:     platformclientv2.String("job-id"),
+			Status: &status,
+			Report: &platformclientv2.Knowledgeimportjobreport{Errors: &importErrors},
+		}
+	}
+
+	// Document failures of a partially completed import are warnings by default
+	diags := buildKnowledgeImportJobDiagnostics(importJob(knowledgeImportStatusPartialCompleted), false)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 2)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "Failed to import document 2 (Reset password)", diags[0].Summary)
+	assert.Equal(t, "Title is too long\nCode: bad.request", diags[0].Detail)
+	assert.Equal(t, "Failed to import document 5", diags[1].Summary)
+
+	diags = buildKnowledgeImportJobDiagnostics(importJob(knowledgeImportStatusPartialCompleted), true)
+	assert.True(t, diags.HasError())
+	assert.Len(t, diags, 2)
+
+	diags = buildKnowledgeImportJobDiagnostics(importJob(knowledgeImportStatusValidationFailed), false)
+	assert.True(t, diags.HasError())
+
+	failedJob := &platformclientv2.Knowledgeimportjobresponse{
+		Id:     platformclientv2.String("job-id"),
+		Status: platformclientv2.String(knowledgeImportStatusFailed),
+	}
+	diags = buildKnowledgeImportJobDiagnostics(failedJob, false)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Knowledge import job job-id finished with status Failed", diags[0].Summary)
+
+	completedJob := &platformclientv2.Knowledgeimportjobresponse{
+		Id:     platformclientv2.String("job-id"),
+		Status: platformclientv2.String(knowledgeImportStatusCompleted),
+	}
+	assert.Empty(t, buildKnowledgeImportJobDiagnostics(completedJob, true))
+}
@@ -84,6 +84,10 @@ func knowledgeKnowledgebaseExporter() *ResourceExporter {
 	return &ResourceExporter{
 		GetResourcesFunc: getAllWithPooledClient(getAllKnowledgeKnowledgebases),
 		RefAttrs:         map[string]*RefAttrSettings{}, // No references
+		CustomFileWriter: CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: KnowledgeBaseExportResolver,
+			SubDirectory:              "knowledge_bases",
+		},
 	}
 }
 
@@ -239,3 +243,48 @@ func deleteKnowledgeKnowledgebase(ctx context.Context, d *schema.ResourceData, m
 		return resource.RetryableError(fmt.Errorf("Knowledge base %s still exists", d.Id()))
 	})
 }
+
+// getKnowledgeBaseExportUrl starts a knowledge export job for the knowledge base and waits for it to complete.
+// Returns the URL from which the exported documents can be downloaded.
+func getKnowledgeBaseExportUrl(knowledgeBaseId string, meta interface{}) (string, error) {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+
+	fileType := "Json"
+	exportJob, _, err := knowledgeAPI.PostKnowledgeKnowledgebaseExportJobs(knowledgeBaseId, platformclientv2.Knowledgeexportjobrequest{
+		FileType: &fileType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error starting export job for knowledge base %s: %v", knowledgeBaseId, err)
+	}
+	jobId := *exportJob.Id
+
+	downloadUrl := ""
+	diagErr := withRetries(context.Background(), 10*time.Minute, func() *resource.RetryError {
+		jobStatus, _, err := knowledgeAPI.GetKnowledgeKnowledgebaseExportJob(knowledgeBaseId, jobId)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error retrieving knowledge export job status. JobID: %s, error: %s ", jobId, err))
+		}
+
+		if jobStatus.Status != nil && (*jobStatus.Status == "Failed" || *jobStatus.Status == "Aborted") {
+			message := ""
+			if jobStatus.ErrorInformation != nil && jobStatus.ErrorInformation.Message != nil {
+				message = *jobStatus.ErrorInformation.Message
+			}
+			return resource.NonRetryableError(fmt.Errorf("Knowledge export failed. JobID: %s, status: %s %s", jobId, *jobStatus.Status, message))
+		}
+
+		if jobStatus.Status != nil && *jobStatus.Status == "Completed" && jobStatus.DownloadURL != nil {
+			downloadUrl = *jobStatus.DownloadURL
+			return nil
+		}
+
+		time.Sleep(5 * time.Second) // Wait 5 seconds for next retry
+		return resource.RetryableError(fmt.Errorf("Knowledge export job (%s) could not finish in 10 minutes and timed out ", jobId))
+	})
+	if diagErr != nil {
+		return "", fmt.Errorf("%v", diagErr)
+	}
+
+	return downloadUrl, nil
+}
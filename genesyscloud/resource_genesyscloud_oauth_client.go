@@ -71,6 +71,8 @@ func oauthClientExporter() *ResourceExporter {
 			"integration_credential_id":   {"integration_credential_id"},
 			"integration_credential_name": {"integration_credential_name"},
 		},
+		// Never write client secrets to the exported config
+		ExcludedAttributes: []string{"client_secret", "secret_rotated_date"},
 	}
 }
 
@@ -86,6 +88,7 @@ func resourceOAuthClient() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeOAuthClientSecretDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "The name of the OAuth client.",
@@ -149,6 +152,40 @@ func resourceOAuthClient() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"client_secret": {
+				Description: "The secret of the OAuth client. Only set for clients using a grant type that requires a secret. The secret is stored in the Terraform state.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"secret_rotated_date": {
+				Description: "Date the client secret was last generated by this resource. Date time is represented as an ISO-8601 string.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rotation": {
+				Description: "Settings to regenerate the client secret. When the secret is rotated, the linked integration credential is updated with the new secret in the same apply.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotate_when_changed": {
+							Description: "Arbitrary map of values that, when changed, will trigger a rotation of the client secret.",
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+						},
+						"max_age_days": {
+							Description:  "Maximum age of the client secret in days. The secret is rotated on the first apply after it expires. " +
+								"A secret that was not generated by this resource, for example of an imported client, is rotated on the first apply.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -183,6 +220,11 @@ func createOAuthClient(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("Failed to create oauth client %s: %s", name, err)
 	}
 
+	if client.Secret != nil {
+		d.Set("client_secret", *client.Secret)
+		d.Set("secret_rotated_date", time.Now().UTC().Format(time.RFC3339))
+	}
+
 	credentialName := resourcedata.GetNillableValue[string](d, "integration_credential_name")
 	if credentialName != nil {
 		integrationAPI := platformclientv2.NewIntegrationsApiWithConfig(sdkConfig)
@@ -274,19 +316,42 @@ func readOAuthClient(ctx context.Context, d *schema.ResourceData, meta interface
 			d.Set("roles", nil)
 		}
 
+		if client.Secret != nil {
+			d.Set("client_secret", *client.Secret)
+		}
+
 		log.Printf("Read oauth client %s %s", d.Id(), *client.Name)
 		return cc.CheckState()
 	})
 }
 
 func updateOAuthClient(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	name := d.Get("name").(string)
+
+	log.Printf("Updating oauth client %s", name)
+	if diagErr := putOAuthClient(d, sdkConfig); diagErr != nil {
+		return diagErr
+	}
+
+	if d.HasChange("rotation.0.rotate_when_changed") || isOAuthClientSecretExpired(d) {
+		if diagErr := rotateOAuthClientSecret(d, sdkConfig); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Updated oauth client %s", name)
+
+	return readOAuthClient(ctx, d, meta)
+}
+
+func putOAuthClient(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	tokenSeconds := d.Get("access_token_validity_seconds").(int)
 	grantType := d.Get("authorized_grant_type").(string)
 	state := d.Get("state").(string)
 
-	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	oauthAPI := platformclientv2.NewOAuthApiWithConfig(sdkConfig)
 
 	roles, diagErr := buildOAuthRoles(d)
@@ -294,7 +359,6 @@ func updateOAuthClient(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diagErr
 	}
 
-	log.Printf("Updating oauth client %s", name)
 	_, _, err := oauthAPI.PutOauthClient(d.Id(), platformclientv2.Oauthclientrequest{
 		Name:                       &name,
 		Description:                &description,
@@ -308,10 +372,7 @@ func updateOAuthClient(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err != nil {
 		return diag.Errorf("Failed to update oauth client %s: %s", name, err)
 	}
-
-	log.Printf("Updated oauth client %s", name)
-
-	return readOAuthClient(ctx, d, meta)
+	return nil
 }
 
 func deleteOAuthClient(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -336,7 +397,7 @@ func deleteOAuthClient(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	// The client state must be set to inactive before deleting
 	d.Set("state", "inactive")
-	diagErr := updateOAuthClient(ctx, d, meta)
+	diagErr := putOAuthClient(d, sdkConfig)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -428,3 +489,78 @@ func flattenOAuthRoles(sdkRoles []platformclientv2.Roledivision) *schema.Set {
 	}
 	return roleSet
 }
+
+// customizeOAuthClientSecretDiff marks the client secret as changing when a rotation will happen during the apply
+func customizeOAuthClientSecretDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChange("rotation.0.rotate_when_changed") || isOAuthClientSecretExpired(diff) {
+		if err := diff.SetNewComputed("client_secret"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("secret_rotated_date")
+	}
+	return nil
+}
+
+// isOAuthClientSecretExpired returns true if the rotation max age is set and the secret is older than it.
+// A secret that was not generated by this resource, for example of an imported client, has no known age and is expired.
+func isOAuthClientSecretExpired(d interface{ Get(string) interface{} }) bool {
+	maxAgeDays, _ := d.Get("rotation.0.max_age_days").(int)
+	rotatedDate, _ := d.Get("secret_rotated_date").(string)
+	if maxAgeDays <= 0 {
+		return false
+	}
+	if rotatedDate == "" {
+		return true
+	}
+
+	rotatedTime, err := time.Parse(time.RFC3339, rotatedDate)
+	if err != nil {
+		log.Printf("Failed to parse secret_rotated_date %s: %s", rotatedDate, err)
+		return false
+	}
+	return time.Now().After(rotatedTime.AddDate(0, 0, maxAgeDays))
+}
+
+// rotateOAuthClientSecret generates a new secret for the client and updates the linked integration credential
+func rotateOAuthClientSecret(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	oauthAPI := platformclientv2.NewOAuthApiWithConfig(sdkConfig)
+
+	log.Printf("Rotating secret of oauth client %s", d.Id())
+	client, _, err := oauthAPI.PostOauthClientSecret(d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to rotate secret of oauth client %s: %s", d.Id(), err)
+	}
+	if client.Secret == nil {
+		return diag.Errorf("No secret returned when rotating the secret of oauth client %s", d.Id())
+	}
+	d.Set("client_secret", *client.Secret)
+	d.Set("secret_rotated_date", time.Now().UTC().Format(time.RFC3339))
+
+	credentialId := resourcedata.GetNillableValue[string](d, "integration_credential_id")
+	if credentialId == nil {
+		return nil
+	}
+
+	integrationAPI := platformclientv2.NewIntegrationsApiWithConfig(sdkConfig)
+	credentialName := d.Get("integration_credential_name").(string)
+	credType := "pureCloudOAuthClient"
+	_, _, err = integrationAPI.PutIntegrationsCredential(*credentialId, platformclientv2.Credential{
+		Name: &credentialName,
+		VarType: &platformclientv2.Credentialtype{
+			Name: &credType,
+		},
+		CredentialFields: &map[string]string{
+			"clientId":     d.Id(),
+			"clientSecret": *client.Secret,
+		},
+	})
+	if err != nil {
+		return diag.Errorf("Failed to update integration credential %s with the rotated secret: %s", *credentialId, err)
+	}
+
+	log.Printf("Rotated secret of oauth client %s", d.Id())
+	return nil
+}
@@ -5,9 +5,11 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -62,6 +64,7 @@ func TestAccResourceOAuthClient(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_oauth_client."+clientResource1, "access_token_validity_seconds", tokenSec1),
 					resource.TestCheckResourceAttr("genesyscloud_oauth_client."+clientResource1, "state", stateActive),
 					resource.TestCheckResourceAttr("genesyscloud_oauth_client."+clientResource1, "integration_credential_name", credentialName1),
+					resource.TestCheckResourceAttrSet("genesyscloud_oauth_client."+clientResource1, "client_secret"),
 					resource.TestCheckNoResourceAttr("genesyscloud_oauth_client."+clientResource1, "scopes.%"),
 					validateStringInArray("genesyscloud_oauth_client."+clientResource1, "registered_redirect_uris", redirectURI1),
 					validateOauthRole("genesyscloud_oauth_client."+clientResource1, "data.genesyscloud_auth_role."+roleResource1, ""),
@@ -123,7 +126,106 @@ func TestAccResourceOAuthClient(t *testing.T) {
 				ResourceName:            "genesyscloud_oauth_client." + clientResource1,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"integration_credential_id", "integration_credential_name"},
+				ImportStateVerifyIgnore: []string{"integration_credential_id", "integration_credential_name", "client_secret", "secret_rotated_date"},
+			},
+		},
+	})
+}
+
+func TestOAuthClientSecretExpired(t *testing.T) {
+	clientData := func(rotation []interface{}, rotatedDate string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resourceOAuthClient().Schema, map[string]interface{}{
+			"name":                          "client",
+			"access_token_validity_seconds": 300,
+			"authorized_grant_type":         "CLIENT-CREDENTIALS",
+			"rotation":                      rotation,
+		})
+		d.Set("secret_rotated_date", rotatedDate)
+		return d
+	}
+	maxAge := []interface{}{map[string]interface{}{"max_age_days": 30}}
+	recent := time.Now().UTC().AddDate(0, 0, -1).Format(time.RFC3339)
+	old := time.Now().UTC().AddDate(0, 0, -31).Format(time.RFC3339)
+
+	if isOAuthClientSecretExpired(clientData(nil, "")) {
+		t.Error("Secret without a max age is expired")
+	}
+	if isOAuthClientSecretExpired(clientData(maxAge, recent)) {
+		t.Error("Secret rotated a day ago is expired")
+	}
+	if !isOAuthClientSecretExpired(clientData(maxAge, old)) {
+		t.Error("Secret rotated 31 days ago is not expired")
+	}
+	// The secret of an imported client has no rotation date
+	if !isOAuthClientSecretExpired(clientData(maxAge, "")) {
+		t.Error("Secret without a rotation date is not expired")
+	}
+}
+
+func TestAccResourceOAuthClientSecretRotation(t *testing.T) {
+	var (
+		clientResource1      = "test-client"
+		clientName1          = "terraform1-" + uuid.NewString()
+		clientDesc1          = "terraform test client1"
+		tokenSec1            = "300"
+		redirectURI1         = "https://example.com/auth1"
+		grantTypeClientCreds = "CLIENT-CREDENTIALS"
+		credentialName1      = "terraform3" + uuid.NewString()
+		initialSecret        string
+
+		roleResource1 = "admin-role"
+		roleName1     = "admin" // Must use a role already assigned to the TF OAuth client
+	)
+
+	config := func(version string) string {
+		return generateAuthRoleDataSource(
+			roleResource1,
+			strconv.Quote(roleName1),
+			"",
+		) + generateOauthClientWithCredential(
+			clientResource1,
+			clientName1,
+			clientDesc1,
+			grantTypeClientCreds,
+			tokenSec1,
+			nullValue, // Default state
+			generateStringArray(strconv.Quote(redirectURI1)),
+			nullValue, // No scopes for client creds
+			credentialName1,
+			generateOauthClientRoles("data.genesyscloud_auth_role."+roleResource1+".id", nullValue),
+			generateOauthClientRotation(fmt.Sprintf("version = %s", strconv.Quote(version))),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create client with a rotation keeper
+				Config: config("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("genesyscloud_oauth_client."+clientResource1, "secret_rotated_date"),
+					resource.TestCheckResourceAttrWith("genesyscloud_oauth_client."+clientResource1, "client_secret", func(value string) error {
+						if value == "" {
+							return fmt.Errorf("expected client_secret to be set")
+						}
+						initialSecret = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Changing the keeper rotates the secret
+				Config: config("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("genesyscloud_oauth_client."+clientResource1, "client_secret", func(value string) error {
+						if value == "" || value == initialSecret {
+							return fmt.Errorf("expected client_secret to be rotated")
+						}
+						return nil
+					}),
+				),
 			},
 		},
 	})
@@ -166,6 +268,15 @@ func generateOauthClientRoles(roleID string, divisionId string) string {
 	`, roleID, divisionId)
 }
 
+func generateOauthClientRotation(keepers string) string {
+	return fmt.Sprintf(`rotation {
+		rotate_when_changed = {
+			%s
+		}
+	}
+	`, keepers)
+}
+
 func validateOauthRole(resourceName string, roleResourceName string, division string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		resourceState, ok := state.RootModule().Resources[resourceName]
@@ -39,6 +39,10 @@ func resourceOutboundCampaign() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeContactListColumnsDiff("contact_list_id", getOutboundCampaignContactListColumnRefs, "phone_columns", "contact_sorts"),
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name of the Campaign.`,
@@ -77,7 +81,7 @@ func resourceOutboundCampaign() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`campaign_status`: {
-				Description:  `The current status of the Campaign. A Campaign may be turned 'on' or 'off' (default). If this value is changed alongside other changes to the resource, a subsequent update will occur immediately afterwards to set the campaign status. This is due to behavioral requirements in the Genesys Cloud API.`,
+				Description:  `The current status of the Campaign. A Campaign may be turned 'on' or 'off' (default). If this value is changed alongside other changes to the resource, a subsequent update will occur immediately afterwards to set the campaign status. This is due to behavioral requirements in the Genesys Cloud API. See restart_on_update to update a running campaign.`,
 				Optional:     true,
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -144,6 +148,12 @@ func resourceOutboundCampaign() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeInt,
 			},
+			`restart_on_update`: {
+				Description: `Whether to stop a running campaign before applying changes to it, wait until it has finished stopping and turn it back on afterwards. The wait is bounded by the update timeout.`,
+				Optional:    true,
+				Default:     false,
+				Type:        schema.TypeBool,
+			},
 			`always_running`: {
 				Description: `Indicates (when true) that the campaign will remain on after contacts are depleted, allowing additional contacts to be appended/added to the contact list and processed by the still-running campaign. The campaign can still be turned off manually.`,
 				Optional:    true,
@@ -395,6 +405,45 @@ func updateOutboundCampaignStatus(d *schema.ResourceData, outboundApi *platformc
 	return nil
 }
 
+// stopOutboundCampaign turns off a running campaign and waits until it has finished stopping
+func stopOutboundCampaign(ctx context.Context, campaignId string, outboundApi *platformclientv2.OutboundApi, timeout time.Duration) diag.Diagnostics {
+	stopping := false
+	diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		campaign, resp, getErr := outboundApi.GetOutboundCampaign(campaignId)
+		if getErr != nil {
+			return resp, diag.Errorf("Failed to read Outbound Campaign %s: %s", campaignId, getErr)
+		}
+		if campaign.CampaignStatus == nil || *campaign.CampaignStatus != "on" {
+			return nil, nil
+		}
+
+		log.Printf("Stopping Outbound Campaign %s before updating it", campaignId)
+		off := "off"
+		campaign.CampaignStatus = &off
+		_, resp, updateErr := outboundApi.PutOutboundCampaign(campaignId, *campaign)
+		if updateErr != nil {
+			return resp, diag.Errorf("Failed to stop Outbound Campaign %s: %s", campaignId, updateErr)
+		}
+		stopping = true
+		return nil, nil
+	})
+	if diagErr != nil || !stopping {
+		return diagErr
+	}
+
+	return withRetries(ctx, timeout, func() *resource.RetryError {
+		campaign, _, getErr := outboundApi.GetOutboundCampaign(campaignId)
+		if getErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("Failed to read Outbound Campaign %s: %s", campaignId, getErr))
+		}
+		if campaign.CampaignStatus != nil && (*campaign.CampaignStatus == "on" || *campaign.CampaignStatus == "stopping") {
+			return resource.RetryableError(fmt.Errorf("Outbound Campaign %s is still %s", campaignId, *campaign.CampaignStatus))
+		}
+		log.Printf("Stopped Outbound Campaign %s", campaignId)
+		return nil
+	})
+}
+
 func updateOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	dialingMode := d.Get("dialing_mode").(string)
@@ -462,6 +511,13 @@ func updateOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta in
 		sdkcampaign.Priority = &priority
 	}
 
+	// Running campaigns reject most changes, so stop the campaign first and let updateOutboundCampaignStatus turn it back on
+	if d.Get("restart_on_update").(bool) && d.HasChangesExcept("campaign_status", "restart_on_update") {
+		if diagErr := stopOutboundCampaign(ctx, d.Id(), outboundApi, d.Timeout(schema.TimeoutUpdate)); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	log.Printf("Updating Outbound Campaign %s", name)
 	diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current Outbound Campaign version
@@ -595,6 +651,8 @@ func readOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta inte
 		if sdkcampaign.DynamicContactQueueingSettings != nil {
 			d.Set("dynamic_contact_queueing_settings", flattenSdkDynamicContactQueueingSettings(*sdkcampaign.DynamicContactQueueingSettings))
 		}
+		// Not returned by the API, keep the configured value or the default when importing
+		d.Set("restart_on_update", d.Get("restart_on_update").(bool))
 
 		log.Printf("Read Outbound Campaign %s %s", d.Id(), *sdkcampaign.Name)
 		return cc.CheckState()
@@ -755,3 +813,13 @@ func flattenSdkoutboundcampaignContactsortSlice(contactSorts []platformclientv2.
 
 	return contactSortList
 }
+
+// getOutboundCampaignContactListColumnRefs returns the contact list columns referenced by the campaign
+func getOutboundCampaignContactListColumnRefs(diff *schema.ResourceDiff) []contactListColumnRef {
+	var refs []contactListColumnRef
+	for i, phoneColumn := range diff.Get("phone_columns").([]interface{}) {
+		phoneColumnMap, _ := phoneColumn.(map[string]interface{})
+		refs = appendContactListColumnRef(refs, fmt.Sprintf("phone_columns.%d.column_name", i), phoneColumnMap["column_name"], contactListColumnPhone)
+	}
+	return appendContactSortColumnRefs(refs, diff.Get("contact_sorts").([]interface{}))
+}
@@ -698,13 +698,38 @@ data "genesyscloud_auth_division_home" "home" {}
 					verifyAttributeInArrayOfPotentialValues("genesyscloud_outbound_campaign."+resourceId, "campaign_status", []string{"on", "complete"}),
 				),
 			},
+			// Update the running campaign, which stops it, applies the change and turns it back on
+			{
+				Config: fmt.Sprintf(`
+data "genesyscloud_auth_division_home" "home" {}
+`) + strings.Replace(generateOutboundCampaignBasic(
+					resourceId,
+					name,
+					contactListResourceId,
+					siteId,
+					emergencyNumber,
+					carResourceId,
+					strconv.Quote("on"),
+					outboundFlowFilePath,
+					flowResourceId,
+					flowName,
+					"${data.genesyscloud_auth_division_home.home.name}",
+					locationResourceId,
+					wrapupcodeResourceId,
+				), "outbound_line_count           = 2", "outbound_line_count           = 3\n\trestart_on_update             = true", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_outbound_campaign."+resourceId, "outbound_line_count", "3"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_campaign."+resourceId, "restart_on_update", "true"),
+					verifyAttributeInArrayOfPotentialValues("genesyscloud_outbound_campaign."+resourceId, "campaign_status", []string{"on", "complete"}),
+				),
+			},
 			// Don't turn campaign back off to ensure campaign can be destroyed properly by turning it off within the destroy handler
 			{
 				// Import/Read
 				ResourceName:            "genesyscloud_outbound_campaign." + resourceId,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"campaign_status"},
+				ImportStateVerifyIgnore: []string{"campaign_status", "restart_on_update"},
 			},
 		},
 		CheckDestroy: testVerifyOutboundCampaignDestroyed,
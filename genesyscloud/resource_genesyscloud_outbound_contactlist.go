@@ -134,6 +134,7 @@ func resourceOutboundContactList() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeContactListOwnColumnsDiff,
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name for the contact list.`,
@@ -148,6 +148,7 @@ func resourceOutboundContactListFilter() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeContactListColumnsDiff("contact_list_id", getOutboundContactListFilterContactListColumnRefs, "clauses"),
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name of the list.`,
@@ -470,3 +471,17 @@ func flattenSdkOutboundContactListFilterClauseSlice(contactListFilterClauses []p
 	}
 	return contactListFilterClauseList
 }
+
+// getOutboundContactListFilterContactListColumnRefs returns the contact list columns referenced by the filter predicates
+func getOutboundContactListFilterContactListColumnRefs(diff *schema.ResourceDiff) []contactListColumnRef {
+	var refs []contactListColumnRef
+	for i, clause := range diff.Get("clauses").([]interface{}) {
+		clauseMap, _ := clause.(map[string]interface{})
+		predicates, _ := clauseMap["predicates"].([]interface{})
+		for j, predicate := range predicates {
+			predicateMap, _ := predicate.(map[string]interface{})
+			refs = appendContactListColumnRef(refs, fmt.Sprintf("clauses.%d.predicates.%d.column", i, j), predicateMap["column"], contactListColumnAny)
+		}
+	}
+	return refs
+}
@@ -2,6 +2,7 @@ package genesyscloud
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -254,6 +255,136 @@ func TestAccResourceOutboundContactListFilter(t *testing.T) {
 	})
 }
 
+func TestAccResourceOutboundContactListFilterUnknownColumn(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId            = "contact_list_filter"
+		name                  = "Test CLF " + uuid.NewString()
+		contactListResourceId = "contact_list"
+		contactListName       = "Test Contact List " + uuid.NewString()
+		column                = "Phone"
+	)
+
+	contactListResource := generateOutboundContactList(
+		contactListResourceId,
+		contactListName,
+		nullValue,
+		nullValue,
+		[]string{},
+		[]string{strconv.Quote(column)},
+		nullValue,
+		nullValue,
+		nullValue,
+		generatePhoneColumnsBlock(
+			column,
+			"cell",
+			nullValue,
+		),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: contactListResource,
+			},
+			{
+				// The contact list exists, so a typo in the column is caught at plan time
+				Config: contactListResource + generateOutboundContactListFilter(
+					resourceId,
+					name,
+					"genesyscloud_outbound_contact_list."+contactListResourceId+".id",
+					"",
+					generateOutboundContactListFilterClause(
+						"",
+						generateOutboundContactListFilterPredicates(
+							"Phnoe",
+							"numeric",
+							"EQUALS",
+							"+12345123456",
+							"",
+							"",
+						),
+					),
+				),
+				ExpectError: regexp.MustCompile("clauses.0.predicates.0.column: column 'Phnoe' does not exist on contact list"),
+			},
+		},
+		CheckDestroy: testVerifyOutboundContactListFilterDestroyed,
+	})
+}
+
+func TestAccResourceOutboundContactListFilterAddedColumn(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId            = "contact_list_filter"
+		name                  = "Test CLF " + uuid.NewString()
+		contactListResourceId = "contact_list"
+		contactListName       = "Test Contact List " + uuid.NewString()
+		column                = "Phone"
+		addedColumn           = "Zipcode"
+	)
+
+	generateContactList := func(columns ...string) string {
+		quotedColumns := make([]string, 0, len(columns))
+		for _, c := range columns {
+			quotedColumns = append(quotedColumns, strconv.Quote(c))
+		}
+		return generateOutboundContactList(
+			contactListResourceId,
+			contactListName,
+			nullValue,
+			nullValue,
+			[]string{},
+			quotedColumns,
+			nullValue,
+			nullValue,
+			nullValue,
+			generatePhoneColumnsBlock(
+				column,
+				"cell",
+				nullValue,
+			),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateContactList(column),
+			},
+			{
+				// The column is added to the existing contact list and referenced by the filter in the same apply
+				Config: generateContactList(column, addedColumn) + generateOutboundContactListFilter(
+					resourceId,
+					name,
+					"genesyscloud_outbound_contact_list."+contactListResourceId+".id",
+					"",
+					generateOutboundContactListFilterClause(
+						"",
+						generateOutboundContactListFilterPredicates(
+							addedColumn,
+							"alphabetic",
+							"EQUALS",
+							"90210",
+							"",
+							"",
+						),
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_outbound_contact_list."+contactListResourceId, "column_names.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_contactlistfilter."+resourceId, "clauses.0.predicates.0.column", addedColumn),
+				),
+			},
+		},
+		CheckDestroy: testVerifyOutboundContactListFilterDestroyed,
+	})
+}
+
 func generateOutboundContactListFilter(
 	resourceId string,
 	name string,
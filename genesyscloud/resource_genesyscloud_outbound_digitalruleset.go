@@ -0,0 +1,1073 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+var (
+	outbounddigitalrulesetMediaTypes = []string{`Email`, `Sms`}
+
+	outbounddigitalrulesetdigitalruleResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			`name`: {
+				Description: `The name of the rule.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			`order`: {
+				Description: `The ranked order of the rule. Rules are processed from lowest number to highest.`,
+				Required:    true,
+				Type:        schema.TypeInt,
+			},
+			`category`: {
+				Description:  `The category of the rule.`,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{`PreContact`, `PostContact`}, false),
+			},
+			`conditions`: {
+				Description: `A list of conditions to evaluate. All of the Conditions must evaluate to true to trigger the actions.`,
+				Required:    true,
+				Type:        schema.TypeList,
+				Elem:        outbounddigitalrulesetdigitalconditionResource,
+			},
+			`actions`: {
+				Description: `The list of actions to be taken if all conditions are true.`,
+				Required:    true,
+				Type:        schema.TypeList,
+				Elem:        outbounddigitalrulesetdigitalactionResource,
+			},
+		},
+	}
+	outbounddigitalrulesetdigitalconditionResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			`inverted`: {
+				Description: `If true, inverts the result of evaluating this Condition.`,
+				Optional:    true,
+				Default:     false,
+				Type:        schema.TypeBool,
+			},
+			`contact_column_condition_settings`: {
+				Description: `The settings for a 'contact list column' condition.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`column_name`: {
+							Description: `The name of the contact list column to evaluate.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`operator`: {
+							Description: `The operator to use when comparing values.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`value`: {
+							Description: `The value to compare against the contact's data.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`value_type`: {
+							Description:  `The data type the value should be treated as.`,
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{`String`, `Numeric`, `DateTime`, `Period`}, false),
+						},
+					},
+				},
+			},
+			`contact_address_condition_settings`: {
+				Description: `The settings for a 'contact address' condition.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`operator`: {
+							Description: `The operator to use when comparing address values.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`value`: {
+							Description: `The value to compare against the contact's address.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			`contact_address_type_condition_settings`: {
+				Description: `The settings for a 'contact address type' condition.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`operator`: {
+							Description: `The operator to use when comparing the address types.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`value`: {
+							Description: `The type value to compare against the contact column type.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			`last_attempt_by_column_condition_settings`: {
+				Description: `The settings for a 'last attempt by column' condition.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`email_column_name`: {
+							Description: `The name of the contact column to evaluate for Email.`,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						`sms_column_name`: {
+							Description: `The name of the contact column to evaluate for SMS.`,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						`operator`: {
+							Description: `The operator to use when comparing values.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`value`: {
+							Description: `The period value to compare against the contact's data.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			`last_attempt_overall_condition_settings`: {
+				Description: `The settings for a 'last attempt overall' condition.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`media_types`: {
+							Description: `A list of media types to evaluate.`,
+							Optional:    true,
+							Type:        schema.TypeList,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(outbounddigitalrulesetMediaTypes, false),
+							},
+						},
+						`operator`: {
+							Description: `The operator to use when comparing values.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`value`: {
+							Description: `The period value to compare against the contact's data.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			`last_result_by_column_condition_settings`: {
+				Description: `The settings for a 'last result by column' condition.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`email_column_name`: {
+							Description: `The name of the contact column to evaluate for Email.`,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						`email_wrapup_codes`: {
+							Description: `A list of wrapup code identifiers to match for Email.`,
+							Optional:    true,
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						`sms_column_name`: {
+							Description: `The name of the contact column to evaluate for SMS.`,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						`sms_wrapup_codes`: {
+							Description: `A list of wrapup code identifiers to match for SMS.`,
+							Optional:    true,
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			`last_result_overall_condition_settings`: {
+				Description: `The settings for a 'last result overall' condition.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`email_wrapup_codes`: {
+							Description: `A list of wrapup code identifiers to match for Email.`,
+							Optional:    true,
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						`sms_wrapup_codes`: {
+							Description: `A list of wrapup code identifiers to match for SMS.`,
+							Optional:    true,
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			`data_action_condition_settings`: {
+				Description: `The settings for a 'data action' condition.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`data_action_id`: {
+							Description: `The Data Action Id to use for this condition.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`contact_id_field`: {
+							Description: `The input field from the data action that the contactId will be passed into.`,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						`data_not_found_resolution`: {
+							Description: `The result of this condition if the data action returns a result indicating there was no data.`,
+							Required:    true,
+							Type:        schema.TypeBool,
+						},
+						`predicates`: {
+							Description: `A list of predicates defining the comparisons to use for this condition.`,
+							Optional:    true,
+							Type:        schema.TypeSet,
+							Elem:        outbounddigitalrulesetdataactionconditionpredicateResource,
+						},
+						`contact_column_to_data_action_field_mappings`: {
+							Description: `A list of mappings defining which contact data fields will be passed to which data action input fields.`,
+							Optional:    true,
+							Type:        schema.TypeSet,
+							Elem:        outbounddigitalrulesetcontactcolumnfieldmappingResource,
+						},
+					},
+				},
+			},
+		},
+	}
+	outbounddigitalrulesetdataactionconditionpredicateResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			`output_field`: {
+				Description: `The name of an output field from the data action's output to use for this condition.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			`output_operator`: {
+				Description: `The operation with which to evaluate this condition.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			`comparison_value`: {
+				Description: `The value to compare against for this condition.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			`inverted`: {
+				Description: `If true, inverts the result of evaluating this Predicate.`,
+				Optional:    true,
+				Default:     false,
+				Type:        schema.TypeBool,
+			},
+			`output_field_missing_resolution`: {
+				Description: `The result of this predicate if the requested output field is missing from the data action's result.`,
+				Required:    true,
+				Type:        schema.TypeBool,
+			},
+		},
+	}
+	outbounddigitalrulesetcontactcolumnfieldmappingResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			`contact_column_name`: {
+				Description: `The name of a contact column whose data will be passed to the data action.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			`data_action_field`: {
+				Description: `The name of an input field from the data action that the contact column data will be passed to.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+	outbounddigitalrulesetdigitalactionResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			`update_contact_column_action_settings`: {
+				Description: `The settings for an 'update contact column' action.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`properties`: {
+							Description: `A mapping of contact columns to their new values.`,
+							Required:    true,
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						`update_option`: {
+							Description:  `The type of update to make to the specified contact column(s).`,
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{`Set`, `Increment`, `Decrement`, `CurrentTime`}, false),
+						},
+					},
+				},
+			},
+			`do_not_send`: {
+				Description: `Do not send a message to the contact.`,
+				Optional:    true,
+				Default:     false,
+				Type:        schema.TypeBool,
+			},
+			`append_to_dnc_action_settings`: {
+				Description: `The settings for an 'Append to DNC' action.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`expire`: {
+							Description: `Whether to expire the record appended to the DNC list.`,
+							Required:    true,
+							Type:        schema.TypeBool,
+						},
+						`expiration_duration`: {
+							Description: `If 'expire' is set to true, how long to keep the record (ISO-8601 duration).`,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						`list_type`: {
+							Description: `The Dnc List Type to append entries to.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			`mark_contact_uncontactable_action_settings`: {
+				Description: `The settings for a 'mark contact uncontactable' action.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`media_types`: {
+							Description: `A list of media types to evaluate.`,
+							Required:    true,
+							Type:        schema.TypeList,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(outbounddigitalrulesetMediaTypes, false),
+							},
+						},
+					},
+				},
+			},
+			`mark_contact_address_uncontactable`: {
+				Description: `Mark the address of the contact as uncontactable.`,
+				Optional:    true,
+				Default:     false,
+				Type:        schema.TypeBool,
+			},
+			`set_content_template_action_settings`: {
+				Description: `The settings for a 'Set content template' action.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`sms_content_template_id`: {
+							Description: `The content template to use for SMS.`,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						`email_content_template_id`: {
+							Description: `The content template to use for Email.`,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+)
+
+func resourceOutboundDigitalruleset() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud outbound digital rule set`,
+
+		CreateContext: createWithPooledClient(createOutboundDigitalruleset),
+		ReadContext:   readWithPooledClient(readOutboundDigitalruleset),
+		UpdateContext: updateWithPooledClient(updateOutboundDigitalruleset),
+		DeleteContext: deleteWithPooledClient(deleteOutboundDigitalruleset),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		CustomizeDiff: customizeContactListColumnsDiff("contact_list_id", getOutboundDigitalrulesetContactListColumnRefs, "rules"),
+		Schema: map[string]*schema.Schema{
+			`name`: {
+				Description: `The name of the digital rule set.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			`contact_list_id`: {
+				Description: `A ContactList to provide suggestions for contact columns on relevant conditions and actions.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`rules`: {
+				Description: `The list of rules.`,
+				Required:    true,
+				Type:        schema.TypeList,
+				Elem:        outbounddigitalrulesetdigitalruleResource,
+			},
+		},
+	}
+}
+
+func getAllOutboundDigitalruleset(_ context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(ResourceIDMetaMap)
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(clientConfig)
+
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		sdkdigitalrulesetentitylisting, _, getErr := outboundApi.GetOutboundDigitalrulesets(pageSize, pageNum, "", "", "", nil)
+		if getErr != nil {
+			return nil, diag.Errorf("Error requesting page of Outbound Digital Rule Set: %s", getErr)
+		}
+
+		if sdkdigitalrulesetentitylisting.Entities == nil || len(*sdkdigitalrulesetentitylisting.Entities) == 0 {
+			break
+		}
+
+		for _, entity := range *sdkdigitalrulesetentitylisting.Entities {
+			resources[*entity.Id] = &ResourceMeta{Name: *entity.Name}
+		}
+	}
+
+	return resources, nil
+}
+
+func outboundDigitalrulesetExporter() *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(getAllOutboundDigitalruleset),
+		RefAttrs: map[string]*RefAttrSettings{
+			"contact_list_id": {
+				RefType: "genesyscloud_outbound_contact_list",
+			},
+			"rules.conditions.data_action_condition_settings.data_action_id": {
+				RefType: "genesyscloud_integration_action",
+			},
+			"rules.conditions.last_result_by_column_condition_settings.email_wrapup_codes": {
+				RefType: "genesyscloud_routing_wrapupcode",
+			},
+			"rules.conditions.last_result_by_column_condition_settings.sms_wrapup_codes": {
+				RefType: "genesyscloud_routing_wrapupcode",
+			},
+			"rules.conditions.last_result_overall_condition_settings.email_wrapup_codes": {
+				RefType: "genesyscloud_routing_wrapupcode",
+			},
+			"rules.conditions.last_result_overall_condition_settings.sms_wrapup_codes": {
+				RefType: "genesyscloud_routing_wrapupcode",
+			},
+			// /api/v2/responsemanagement/responses/{responseId}
+			"rules.actions.set_content_template_action_settings.sms_content_template_id":   {},
+			"rules.actions.set_content_template_action_settings.email_content_template_id": {},
+		},
+	}
+}
+
+func createOutboundDigitalruleset(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+
+	sdkdigitalruleset := platformclientv2.Digitalruleset{
+		Name:        &name,
+		ContactList: buildSdkDomainEntityRef(d, "contact_list_id"),
+		Rules:       buildSdkoutbounddigitalrulesetDigitalruleSlice(d.Get("rules").([]interface{})),
+	}
+
+	log.Printf("Creating Outbound Digital Rule Set %s", name)
+	outboundDigitalruleset, _, err := outboundApi.PostOutboundDigitalrulesets(sdkdigitalruleset)
+	if err != nil {
+		return diag.Errorf("Failed to create Outbound Digital Rule Set %s: %s", name, err)
+	}
+
+	d.SetId(*outboundDigitalruleset.Id)
+
+	log.Printf("Created Outbound Digital Rule Set %s %s", name, *outboundDigitalruleset.Id)
+	return readOutboundDigitalruleset(ctx, d, meta)
+}
+
+func updateOutboundDigitalruleset(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+
+	sdkdigitalruleset := platformclientv2.Digitalruleset{
+		Name:        &name,
+		ContactList: buildSdkDomainEntityRef(d, "contact_list_id"),
+		Rules:       buildSdkoutbounddigitalrulesetDigitalruleSlice(d.Get("rules").([]interface{})),
+	}
+
+	log.Printf("Updating Outbound Digital Rule Set %s", name)
+	diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		// Get current Outbound Digital Rule Set version
+		outboundDigitalruleset, resp, getErr := outboundApi.GetOutboundDigitalruleset(d.Id())
+		if getErr != nil {
+			return resp, diag.Errorf("Failed to read Outbound Digital Rule Set %s: %s", d.Id(), getErr)
+		}
+		sdkdigitalruleset.Version = outboundDigitalruleset.Version
+		_, resp, updateErr := outboundApi.PutOutboundDigitalruleset(d.Id(), sdkdigitalruleset)
+		if updateErr != nil {
+			return resp, diag.Errorf("Failed to update Outbound Digital Rule Set %s: %s", name, updateErr)
+		}
+		return nil, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated Outbound Digital Rule Set %s", name)
+	return readOutboundDigitalruleset(ctx, d, meta)
+}
+
+func readOutboundDigitalruleset(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+
+	log.Printf("Reading Outbound Digital Rule Set %s", d.Id())
+
+	return withRetriesForRead(ctx, d, func() *resource.RetryError {
+		sdkdigitalruleset, resp, getErr := outboundApi.GetOutboundDigitalruleset(d.Id())
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to read Outbound Digital Rule Set %s: %s", d.Id(), getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read Outbound Digital Rule Set %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceOutboundDigitalruleset())
+
+		if sdkdigitalruleset.Name != nil {
+			d.Set("name", *sdkdigitalruleset.Name)
+		}
+		if sdkdigitalruleset.ContactList != nil && sdkdigitalruleset.ContactList.Id != nil {
+			d.Set("contact_list_id", *sdkdigitalruleset.ContactList.Id)
+		} else {
+			d.Set("contact_list_id", nil)
+		}
+		if sdkdigitalruleset.Rules != nil {
+			d.Set("rules", flattenSdkoutbounddigitalrulesetDigitalruleSlice(*sdkdigitalruleset.Rules))
+		} else {
+			d.Set("rules", nil)
+		}
+
+		log.Printf("Read Outbound Digital Rule Set %s %s", d.Id(), *sdkdigitalruleset.Name)
+
+		return cc.CheckState()
+	})
+}
+
+func deleteOutboundDigitalruleset(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+
+	diagErr := retryWhen(isStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		log.Printf("Deleting Outbound Digital Rule Set")
+		resp, err := outboundApi.DeleteOutboundDigitalruleset(d.Id())
+		if err != nil {
+			return resp, diag.Errorf("Failed to delete Outbound Digital Rule Set: %s", err)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	return withRetries(ctx, 30*time.Second, func() *resource.RetryError {
+		_, resp, err := outboundApi.GetOutboundDigitalruleset(d.Id())
+		if err != nil {
+			if isStatus404(resp) {
+				// Outbound Digital Rule Set deleted
+				log.Printf("Deleted Outbound Digital Rule Set %s", d.Id())
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error deleting Outbound Digital Rule Set %s: %s", d.Id(), err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Outbound Digital Rule Set %s still exists", d.Id()))
+	})
+}
+
+// getOutbounddigitalrulesetSettingsMap returns the map of a MaxItems 1 settings block, or nil if the block is not set
+func getOutbounddigitalrulesetSettingsMap(settingsMap map[string]interface{}, key string) map[string]interface{} {
+	settingsList, ok := settingsMap[key].([]interface{})
+	if !ok || len(settingsList) == 0 || settingsList[0] == nil {
+		return nil
+	}
+	return settingsList[0].(map[string]interface{})
+}
+
+func buildSdkoutbounddigitalrulesetOptionalString(settingsMap map[string]interface{}, key string) *string {
+	if value := settingsMap[key].(string); value != "" {
+		return &value
+	}
+	return nil
+}
+
+func buildSdkoutbounddigitalrulesetStringList(settingsMap map[string]interface{}, key string) *[]string {
+	values := InterfaceListToStrings(settingsMap[key].([]interface{}))
+	return &values
+}
+
+func buildSdkoutbounddigitalrulesetDataactionconditionpredicateSlice(predicates *schema.Set) *[]platformclientv2.Digitaldataactionconditionpredicate {
+	sdkPredicateSlice := make([]platformclientv2.Digitaldataactionconditionpredicate, 0)
+	for _, configpredicate := range predicates.List() {
+		predicateMap := configpredicate.(map[string]interface{})
+		sdkPredicateSlice = append(sdkPredicateSlice, platformclientv2.Digitaldataactionconditionpredicate{
+			OutputField:                  platformclientv2.String(predicateMap["output_field"].(string)),
+			OutputOperator:               platformclientv2.String(predicateMap["output_operator"].(string)),
+			ComparisonValue:              platformclientv2.String(predicateMap["comparison_value"].(string)),
+			Inverted:                     platformclientv2.Bool(predicateMap["inverted"].(bool)),
+			OutputFieldMissingResolution: platformclientv2.Bool(predicateMap["output_field_missing_resolution"].(bool)),
+		})
+	}
+	return &sdkPredicateSlice
+}
+
+func buildSdkoutbounddigitalrulesetContactcolumnfieldmappingSlice(mappings *schema.Set) *[]platformclientv2.Dataactioncontactcolumnfieldmapping {
+	sdkMappingSlice := make([]platformclientv2.Dataactioncontactcolumnfieldmapping, 0)
+	for _, configmapping := range mappings.List() {
+		mappingMap := configmapping.(map[string]interface{})
+		sdkMappingSlice = append(sdkMappingSlice, platformclientv2.Dataactioncontactcolumnfieldmapping{
+			ContactColumnName: platformclientv2.String(mappingMap["contact_column_name"].(string)),
+			DataActionField:   platformclientv2.String(mappingMap["data_action_field"].(string)),
+		})
+	}
+	return &sdkMappingSlice
+}
+
+func buildSdkoutbounddigitalrulesetDigitalconditionSlice(digitalconditionList []interface{}) *[]platformclientv2.Digitalcondition {
+	sdkDigitalconditionSlice := make([]platformclientv2.Digitalcondition, 0)
+	for _, configdigitalcondition := range digitalconditionList {
+		digitalconditionMap := configdigitalcondition.(map[string]interface{})
+		sdkDigitalcondition := platformclientv2.Digitalcondition{
+			Inverted: platformclientv2.Bool(digitalconditionMap["inverted"].(bool)),
+		}
+
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalconditionMap, "contact_column_condition_settings"); settings != nil {
+			sdkDigitalcondition.ContactColumnConditionSettings = &platformclientv2.Contactcolumnconditionsettings{
+				ColumnName: platformclientv2.String(settings["column_name"].(string)),
+				Operator:   platformclientv2.String(settings["operator"].(string)),
+				Value:      platformclientv2.String(settings["value"].(string)),
+				ValueType:  platformclientv2.String(settings["value_type"].(string)),
+			}
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalconditionMap, "contact_address_condition_settings"); settings != nil {
+			sdkDigitalcondition.ContactAddressConditionSettings = &platformclientv2.Contactaddressconditionsettings{
+				Operator: platformclientv2.String(settings["operator"].(string)),
+				Value:    platformclientv2.String(settings["value"].(string)),
+			}
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalconditionMap, "contact_address_type_condition_settings"); settings != nil {
+			sdkDigitalcondition.ContactAddressTypeConditionSettings = &platformclientv2.Contactaddresstypeconditionsettings{
+				Operator: platformclientv2.String(settings["operator"].(string)),
+				Value:    platformclientv2.String(settings["value"].(string)),
+			}
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalconditionMap, "last_attempt_by_column_condition_settings"); settings != nil {
+			sdkDigitalcondition.LastAttemptByColumnConditionSettings = &platformclientv2.Lastattemptbycolumnconditionsettings{
+				EmailColumnName: buildSdkoutbounddigitalrulesetOptionalString(settings, "email_column_name"),
+				SmsColumnName:   buildSdkoutbounddigitalrulesetOptionalString(settings, "sms_column_name"),
+				Operator:        platformclientv2.String(settings["operator"].(string)),
+				Value:           platformclientv2.String(settings["value"].(string)),
+			}
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalconditionMap, "last_attempt_overall_condition_settings"); settings != nil {
+			sdkDigitalcondition.LastAttemptOverallConditionSettings = &platformclientv2.Lastattemptoverallconditionsettings{
+				MediaTypes: buildSdkoutbounddigitalrulesetStringList(settings, "media_types"),
+				Operator:   platformclientv2.String(settings["operator"].(string)),
+				Value:      platformclientv2.String(settings["value"].(string)),
+			}
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalconditionMap, "last_result_by_column_condition_settings"); settings != nil {
+			sdkDigitalcondition.LastResultByColumnConditionSettings = &platformclientv2.Lastresultbycolumnconditionsettings{
+				EmailColumnName:  buildSdkoutbounddigitalrulesetOptionalString(settings, "email_column_name"),
+				EmailWrapupCodes: buildSdkoutbounddigitalrulesetStringList(settings, "email_wrapup_codes"),
+				SmsColumnName:    buildSdkoutbounddigitalrulesetOptionalString(settings, "sms_column_name"),
+				SmsWrapupCodes:   buildSdkoutbounddigitalrulesetStringList(settings, "sms_wrapup_codes"),
+			}
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalconditionMap, "last_result_overall_condition_settings"); settings != nil {
+			sdkDigitalcondition.LastResultOverallConditionSettings = &platformclientv2.Lastresultoverallconditionsettings{
+				EmailWrapupCodes: buildSdkoutbounddigitalrulesetStringList(settings, "email_wrapup_codes"),
+				SmsWrapupCodes:   buildSdkoutbounddigitalrulesetStringList(settings, "sms_wrapup_codes"),
+			}
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalconditionMap, "data_action_condition_settings"); settings != nil {
+			sdkDigitalcondition.DataActionConditionSettings = &platformclientv2.Dataactionconditionsettings{
+				DataActionId:                           platformclientv2.String(settings["data_action_id"].(string)),
+				ContactIdField:                         buildSdkoutbounddigitalrulesetOptionalString(settings, "contact_id_field"),
+				DataNotFoundResolution:                 platformclientv2.Bool(settings["data_not_found_resolution"].(bool)),
+				Predicates:                             buildSdkoutbounddigitalrulesetDataactionconditionpredicateSlice(settings["predicates"].(*schema.Set)),
+				ContactColumnToDataActionFieldMappings: buildSdkoutbounddigitalrulesetContactcolumnfieldmappingSlice(settings["contact_column_to_data_action_field_mappings"].(*schema.Set)),
+			}
+		}
+
+		sdkDigitalconditionSlice = append(sdkDigitalconditionSlice, sdkDigitalcondition)
+	}
+	return &sdkDigitalconditionSlice
+}
+
+func buildSdkoutbounddigitalrulesetDigitalactionSlice(digitalactionList []interface{}) *[]platformclientv2.Digitalaction {
+	sdkDigitalactionSlice := make([]platformclientv2.Digitalaction, 0)
+	for _, configdigitalaction := range digitalactionList {
+		var sdkDigitalaction platformclientv2.Digitalaction
+		digitalactionMap := configdigitalaction.(map[string]interface{})
+
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalactionMap, "update_contact_column_action_settings"); settings != nil {
+			properties := make(map[string]string)
+			for column, value := range settings["properties"].(map[string]interface{}) {
+				properties[column] = value.(string)
+			}
+			sdkDigitalaction.UpdateContactColumnActionSettings = &platformclientv2.Updatecontactcolumnactionsettings{
+				Properties:   &properties,
+				UpdateOption: platformclientv2.String(settings["update_option"].(string)),
+			}
+		}
+		if digitalactionMap["do_not_send"].(bool) {
+			var emptySettings interface{} = map[string]interface{}{}
+			sdkDigitalaction.DoNotSendActionSettings = &emptySettings
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalactionMap, "append_to_dnc_action_settings"); settings != nil {
+			sdkDigitalaction.AppendToDncActionSettings = &platformclientv2.Appendtodncactionsettings{
+				Expire:             platformclientv2.Bool(settings["expire"].(bool)),
+				ExpirationDuration: buildSdkoutbounddigitalrulesetOptionalString(settings, "expiration_duration"),
+				ListType:           platformclientv2.String(settings["list_type"].(string)),
+			}
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalactionMap, "mark_contact_uncontactable_action_settings"); settings != nil {
+			sdkDigitalaction.MarkContactUncontactableActionSettings = &platformclientv2.Markcontactuncontactableactionsettings{
+				MediaTypes: buildSdkoutbounddigitalrulesetStringList(settings, "media_types"),
+			}
+		}
+		if digitalactionMap["mark_contact_address_uncontactable"].(bool) {
+			var emptySettings interface{} = map[string]interface{}{}
+			sdkDigitalaction.MarkContactAddressUncontactableActionSettings = &emptySettings
+		}
+		if settings := getOutbounddigitalrulesetSettingsMap(digitalactionMap, "set_content_template_action_settings"); settings != nil {
+			sdkDigitalaction.SetContentTemplateActionSettings = &platformclientv2.Setcontenttemplateactionsettings{
+				SmsContentTemplateId:   buildSdkoutbounddigitalrulesetOptionalString(settings, "sms_content_template_id"),
+				EmailContentTemplateId: buildSdkoutbounddigitalrulesetOptionalString(settings, "email_content_template_id"),
+			}
+		}
+
+		sdkDigitalactionSlice = append(sdkDigitalactionSlice, sdkDigitalaction)
+	}
+	return &sdkDigitalactionSlice
+}
+
+func buildSdkoutbounddigitalrulesetDigitalruleSlice(digitalruleList []interface{}) *[]platformclientv2.Digitalrule {
+	sdkDigitalruleSlice := make([]platformclientv2.Digitalrule, 0)
+	for _, configdigitalrule := range digitalruleList {
+		digitalruleMap := configdigitalrule.(map[string]interface{})
+		sdkDigitalruleSlice = append(sdkDigitalruleSlice, platformclientv2.Digitalrule{
+			Name:       platformclientv2.String(digitalruleMap["name"].(string)),
+			Order:      platformclientv2.Int(digitalruleMap["order"].(int)),
+			Category:   platformclientv2.String(digitalruleMap["category"].(string)),
+			Conditions: buildSdkoutbounddigitalrulesetDigitalconditionSlice(digitalruleMap["conditions"].([]interface{})),
+			Actions:    buildSdkoutbounddigitalrulesetDigitalactionSlice(digitalruleMap["actions"].([]interface{})),
+		})
+	}
+	return &sdkDigitalruleSlice
+}
+
+func flattenSdkoutbounddigitalrulesetOptionalString(settingsMap map[string]interface{}, key string, value *string) {
+	if value != nil {
+		settingsMap[key] = *value
+	}
+}
+
+func flattenSdkoutbounddigitalrulesetStringList(settingsMap map[string]interface{}, key string, values *[]string) {
+	if values != nil {
+		settingsMap[key] = stringListToInterfaceList(*values)
+	}
+}
+
+func flattenSdkoutbounddigitalrulesetDataactionconditionpredicateSlice(predicates []platformclientv2.Digitaldataactionconditionpredicate) *schema.Set {
+	if len(predicates) == 0 {
+		return nil
+	}
+
+	predicateSet := schema.NewSet(schema.HashResource(outbounddigitalrulesetdataactionconditionpredicateResource), []interface{}{})
+	for _, predicate := range predicates {
+		predicateMap := make(map[string]interface{})
+
+		flattenSdkoutbounddigitalrulesetOptionalString(predicateMap, "output_field", predicate.OutputField)
+		flattenSdkoutbounddigitalrulesetOptionalString(predicateMap, "output_operator", predicate.OutputOperator)
+		flattenSdkoutbounddigitalrulesetOptionalString(predicateMap, "comparison_value", predicate.ComparisonValue)
+		if predicate.Inverted != nil {
+			predicateMap["inverted"] = *predicate.Inverted
+		}
+		if predicate.OutputFieldMissingResolution != nil {
+			predicateMap["output_field_missing_resolution"] = *predicate.OutputFieldMissingResolution
+		}
+
+		predicateSet.Add(predicateMap)
+	}
+
+	return predicateSet
+}
+
+func flattenSdkoutbounddigitalrulesetContactcolumnfieldmappingSlice(mappings []platformclientv2.Dataactioncontactcolumnfieldmapping) *schema.Set {
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	mappingSet := schema.NewSet(schema.HashResource(outbounddigitalrulesetcontactcolumnfieldmappingResource), []interface{}{})
+	for _, mapping := range mappings {
+		mappingMap := make(map[string]interface{})
+
+		flattenSdkoutbounddigitalrulesetOptionalString(mappingMap, "contact_column_name", mapping.ContactColumnName)
+		flattenSdkoutbounddigitalrulesetOptionalString(mappingMap, "data_action_field", mapping.DataActionField)
+
+		mappingSet.Add(mappingMap)
+	}
+
+	return mappingSet
+}
+
+func flattenSdkoutbounddigitalrulesetDigitalconditionSlice(digitalconditions []platformclientv2.Digitalcondition) []interface{} {
+	if len(digitalconditions) == 0 {
+		return nil
+	}
+
+	var digitalconditionList []interface{}
+	for _, digitalcondition := range digitalconditions {
+		digitalconditionMap := make(map[string]interface{})
+
+		if digitalcondition.Inverted != nil {
+			digitalconditionMap["inverted"] = *digitalcondition.Inverted
+		}
+		if settings := digitalcondition.ContactColumnConditionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "column_name", settings.ColumnName)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "operator", settings.Operator)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "value", settings.Value)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "value_type", settings.ValueType)
+			digitalconditionMap["contact_column_condition_settings"] = []interface{}{settingsMap}
+		}
+		if settings := digitalcondition.ContactAddressConditionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "operator", settings.Operator)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "value", settings.Value)
+			digitalconditionMap["contact_address_condition_settings"] = []interface{}{settingsMap}
+		}
+		if settings := digitalcondition.ContactAddressTypeConditionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "operator", settings.Operator)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "value", settings.Value)
+			digitalconditionMap["contact_address_type_condition_settings"] = []interface{}{settingsMap}
+		}
+		if settings := digitalcondition.LastAttemptByColumnConditionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "email_column_name", settings.EmailColumnName)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "sms_column_name", settings.SmsColumnName)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "operator", settings.Operator)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "value", settings.Value)
+			digitalconditionMap["last_attempt_by_column_condition_settings"] = []interface{}{settingsMap}
+		}
+		if settings := digitalcondition.LastAttemptOverallConditionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetStringList(settingsMap, "media_types", settings.MediaTypes)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "operator", settings.Operator)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "value", settings.Value)
+			digitalconditionMap["last_attempt_overall_condition_settings"] = []interface{}{settingsMap}
+		}
+		if settings := digitalcondition.LastResultByColumnConditionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "email_column_name", settings.EmailColumnName)
+			flattenSdkoutbounddigitalrulesetStringList(settingsMap, "email_wrapup_codes", settings.EmailWrapupCodes)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "sms_column_name", settings.SmsColumnName)
+			flattenSdkoutbounddigitalrulesetStringList(settingsMap, "sms_wrapup_codes", settings.SmsWrapupCodes)
+			digitalconditionMap["last_result_by_column_condition_settings"] = []interface{}{settingsMap}
+		}
+		if settings := digitalcondition.LastResultOverallConditionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetStringList(settingsMap, "email_wrapup_codes", settings.EmailWrapupCodes)
+			flattenSdkoutbounddigitalrulesetStringList(settingsMap, "sms_wrapup_codes", settings.SmsWrapupCodes)
+			digitalconditionMap["last_result_overall_condition_settings"] = []interface{}{settingsMap}
+		}
+		if settings := digitalcondition.DataActionConditionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "data_action_id", settings.DataActionId)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "contact_id_field", settings.ContactIdField)
+			if settings.DataNotFoundResolution != nil {
+				settingsMap["data_not_found_resolution"] = *settings.DataNotFoundResolution
+			}
+			if settings.Predicates != nil {
+				settingsMap["predicates"] = flattenSdkoutbounddigitalrulesetDataactionconditionpredicateSlice(*settings.Predicates)
+			}
+			if settings.ContactColumnToDataActionFieldMappings != nil {
+				settingsMap["contact_column_to_data_action_field_mappings"] = flattenSdkoutbounddigitalrulesetContactcolumnfieldmappingSlice(*settings.ContactColumnToDataActionFieldMappings)
+			}
+			digitalconditionMap["data_action_condition_settings"] = []interface{}{settingsMap}
+		}
+
+		digitalconditionList = append(digitalconditionList, digitalconditionMap)
+	}
+
+	return digitalconditionList
+}
+
+func flattenSdkoutbounddigitalrulesetDigitalactionSlice(digitalactions []platformclientv2.Digitalaction) []interface{} {
+	if len(digitalactions) == 0 {
+		return nil
+	}
+
+	var digitalactionList []interface{}
+	for _, digitalaction := range digitalactions {
+		digitalactionMap := make(map[string]interface{})
+
+		if settings := digitalaction.UpdateContactColumnActionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			if settings.Properties != nil {
+				properties := make(map[string]interface{})
+				for column, value := range *settings.Properties {
+					properties[column] = value
+				}
+				settingsMap["properties"] = properties
+			}
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "update_option", settings.UpdateOption)
+			digitalactionMap["update_contact_column_action_settings"] = []interface{}{settingsMap}
+		}
+		digitalactionMap["do_not_send"] = digitalaction.DoNotSendActionSettings != nil
+		if settings := digitalaction.AppendToDncActionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			if settings.Expire != nil {
+				settingsMap["expire"] = *settings.Expire
+			}
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "expiration_duration", settings.ExpirationDuration)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "list_type", settings.ListType)
+			digitalactionMap["append_to_dnc_action_settings"] = []interface{}{settingsMap}
+		}
+		if settings := digitalaction.MarkContactUncontactableActionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetStringList(settingsMap, "media_types", settings.MediaTypes)
+			digitalactionMap["mark_contact_uncontactable_action_settings"] = []interface{}{settingsMap}
+		}
+		digitalactionMap["mark_contact_address_uncontactable"] = digitalaction.MarkContactAddressUncontactableActionSettings != nil
+		if settings := digitalaction.SetContentTemplateActionSettings; settings != nil {
+			settingsMap := make(map[string]interface{})
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "sms_content_template_id", settings.SmsContentTemplateId)
+			flattenSdkoutbounddigitalrulesetOptionalString(settingsMap, "email_content_template_id", settings.EmailContentTemplateId)
+			digitalactionMap["set_content_template_action_settings"] = []interface{}{settingsMap}
+		}
+
+		digitalactionList = append(digitalactionList, digitalactionMap)
+	}
+
+	return digitalactionList
+}
+
+func flattenSdkoutbounddigitalrulesetDigitalruleSlice(digitalrules []platformclientv2.Digitalrule) []interface{} {
+	if len(digitalrules) == 0 {
+		return nil
+	}
+
+	var digitalruleList []interface{}
+	for _, digitalrule := range digitalrules {
+		digitalruleMap := make(map[string]interface{})
+
+		flattenSdkoutbounddigitalrulesetOptionalString(digitalruleMap, "name", digitalrule.Name)
+		if digitalrule.Order != nil {
+			digitalruleMap["order"] = *digitalrule.Order
+		}
+		flattenSdkoutbounddigitalrulesetOptionalString(digitalruleMap, "category", digitalrule.Category)
+		if digitalrule.Conditions != nil {
+			digitalruleMap["conditions"] = flattenSdkoutbounddigitalrulesetDigitalconditionSlice(*digitalrule.Conditions)
+		}
+		if digitalrule.Actions != nil {
+			digitalruleMap["actions"] = flattenSdkoutbounddigitalrulesetDigitalactionSlice(*digitalrule.Actions)
+		}
+
+		digitalruleList = append(digitalruleList, digitalruleMap)
+	}
+
+	return digitalruleList
+}
+
+// getOutboundDigitalrulesetContactListColumnRefs returns the contact list columns referenced by the rule conditions and actions
+func getOutboundDigitalrulesetContactListColumnRefs(diff *schema.ResourceDiff) []contactListColumnRef {
+	var refs []contactListColumnRef
+	for i, rule := range diff.Get("rules").([]interface{}) {
+		ruleMap, _ := rule.(map[string]interface{})
+		conditions, _ := ruleMap["conditions"].([]interface{})
+		for j, condition := range conditions {
+			conditionMap, _ := condition.(map[string]interface{})
+			path := fmt.Sprintf("rules.%d.conditions.%d", i, j)
+			if settings := getOutbounddigitalrulesetSettingsMap(conditionMap, "contact_column_condition_settings"); settings != nil {
+				refs = appendContactListColumnRef(refs, path+".contact_column_condition_settings.0.column_name", settings["column_name"], contactListColumnAny)
+			}
+			for _, key := range []string{"last_attempt_by_column_condition_settings", "last_result_by_column_condition_settings"} {
+				if settings := getOutbounddigitalrulesetSettingsMap(conditionMap, key); settings != nil {
+					refs = appendContactListColumnRef(refs, path+"."+key+".0.email_column_name", settings["email_column_name"], contactListColumnEmail)
+					refs = appendContactListColumnRef(refs, path+"."+key+".0.sms_column_name", settings["sms_column_name"], contactListColumnPhone)
+				}
+			}
+			if settings := getOutbounddigitalrulesetSettingsMap(conditionMap, "data_action_condition_settings"); settings != nil {
+				refs = appendContactListColumnMappingRefs(refs, path+".data_action_condition_settings.0.contact_column_to_data_action_field_mappings", settings["contact_column_to_data_action_field_mappings"])
+			}
+		}
+		actions, _ := ruleMap["actions"].([]interface{})
+		for j, action := range actions {
+			actionMap, _ := action.(map[string]interface{})
+			if settings := getOutbounddigitalrulesetSettingsMap(actionMap, "update_contact_column_action_settings"); settings != nil {
+				refs = appendContactListColumnKeyRefs(refs, fmt.Sprintf("rules.%d.actions.%d.update_contact_column_action_settings.0.properties", i, j), settings["properties"])
+			}
+		}
+	}
+	return refs
+}
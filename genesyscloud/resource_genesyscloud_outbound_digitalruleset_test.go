@@ -0,0 +1,172 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourceOutboundDigitalruleset(t *testing.T) {
+	t.Parallel()
+	var (
+		contactListResourceId = "contact-list"
+		contactListName       = "Test Contact List " + uuid.NewString()
+		columnNames           = []string{strconv.Quote("Email"), strconv.Quote("Cell"), strconv.Quote("Attempts")}
+
+		digitalRuleSetResourceId = "digital-rule-set"
+		digitalRuleSetName1      = "Test Digital Rule Set " + uuid.NewString()
+		digitalRuleSetName2      = "Test Digital Rule Set " + uuid.NewString()
+	)
+
+	contactListConfig := generateOutboundContactList(
+		contactListResourceId,
+		contactListName,
+		nullValue,
+		nullValue,
+		[]string{},
+		columnNames,
+		falseValue,
+		nullValue,
+		nullValue,
+		generateEmailColumnsBlock(
+			"Email",
+			"Work",
+			nullValue,
+		),
+		generatePhoneColumnsBlock(
+			"Cell",
+			"cell",
+			nullValue,
+		),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: contactListConfig + fmt.Sprintf(`
+resource "genesyscloud_outbound_digitalruleset" "%s" {
+	name            = "%s"
+	contact_list_id = genesyscloud_outbound_contact_list.%s.id
+	rules {
+		name     = "Skip opted out"
+		order    = 0
+		category = "PreContact"
+		conditions {
+			contact_column_condition_settings {
+				column_name = "Attempts"
+				operator    = "GreaterThan"
+				value       = "3"
+				value_type  = "Numeric"
+			}
+		}
+		actions {
+			do_not_send = true
+		}
+	}
+}
+`, digitalRuleSetResourceId, digitalRuleSetName1, contactListResourceId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "name", digitalRuleSetName1),
+					resource.TestCheckResourceAttrPair("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "contact_list_id", "genesyscloud_outbound_contact_list."+contactListResourceId, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.0.category", "PreContact"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.0.conditions.0.contact_column_condition_settings.0.column_name", "Attempts"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.0.actions.0.do_not_send", trueValue),
+				),
+			},
+			{
+				// Update name and add a post contact rule
+				Config: contactListConfig + fmt.Sprintf(`
+resource "genesyscloud_outbound_digitalruleset" "%s" {
+	name            = "%s"
+	contact_list_id = genesyscloud_outbound_contact_list.%s.id
+	rules {
+		name     = "Skip opted out"
+		order    = 0
+		category = "PreContact"
+		conditions {
+			inverted = true
+			contact_address_type_condition_settings {
+				operator = "Equals"
+				value    = "Work"
+			}
+		}
+		actions {
+			mark_contact_address_uncontactable = true
+		}
+	}
+	rules {
+		name     = "Count attempts"
+		order    = 1
+		category = "PostContact"
+		conditions {
+			last_attempt_overall_condition_settings {
+				media_types = ["Email", "Sms"]
+				operator    = "Before"
+				value       = "P1D"
+			}
+		}
+		actions {
+			update_contact_column_action_settings {
+				properties = {
+					Attempts = "1"
+				}
+				update_option = "Increment"
+			}
+		}
+		actions {
+			mark_contact_uncontactable_action_settings {
+				media_types = ["Email"]
+			}
+		}
+	}
+}
+`, digitalRuleSetResourceId, digitalRuleSetName2, contactListResourceId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "name", digitalRuleSetName2),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.0.conditions.0.inverted", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.0.actions.0.do_not_send", falseValue),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.0.actions.0.mark_contact_address_uncontactable", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.1.category", "PostContact"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.1.conditions.0.last_attempt_overall_condition_settings.0.media_types.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.1.actions.0.update_contact_column_action_settings.0.properties.Attempts", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_digitalruleset."+digitalRuleSetResourceId, "rules.1.actions.1.mark_contact_uncontactable_action_settings.0.media_types.0", "Email"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_outbound_digitalruleset." + digitalRuleSetResourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyOutboundDigitalrulesetDestroyed,
+	})
+}
+
+func testVerifyOutboundDigitalrulesetDestroyed(state *terraform.State) error {
+	outboundAPI := platformclientv2.NewOutboundApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_outbound_digitalruleset" {
+			continue
+		}
+		digitalRuleSet, resp, err := outboundAPI.GetOutboundDigitalruleset(rs.Primary.ID)
+		if digitalRuleSet != nil {
+			return fmt.Errorf("digital rule set (%s) still exists", rs.Primary.ID)
+		} else if isStatus404(resp) {
+			// Digital rule set not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All digital rule sets destroyed
+	return nil
+}
@@ -62,6 +62,66 @@ var (
 			},
 		},
 	}
+	outboundmessagingcampaignemailconfigResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			`email_columns`: {
+				Description: `The Contact List columns specifying the email address(es) of the contact.`,
+				Required:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`content_template_id`: {
+				Description: `The content template used to formulate the email to send to the contact.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			`from_address`: {
+				Description: `The email address that will be used as the sender of the email.`,
+				Required:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`domain_id`: {
+							Description: `The outbound domain used for the email address.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`friendly_name`: {
+							Description: `The friendly name of the email address.`,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						`local_part`: {
+							Description: `The local part of the email address.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			`reply_to_address`: {
+				Description: `The email address that replies from the contact are sent to.`,
+				Optional:    true,
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`domain_id`: {
+							Description: `The inbound domain used for the email address.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						`route_id`: {
+							Description: `The inbound route used for the email address.`,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
 )
 
 func resourceOutboundMessagingCampaign() *schema.Resource {
@@ -76,6 +136,7 @@ func resourceOutboundMessagingCampaign() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeContactListColumnsDiff("contact_list_id", getOutboundMessagingCampaignContactListColumnRefs, "contact_sorts", "sms_config", "email_config"),
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The campaign name.`,
@@ -146,12 +207,27 @@ func resourceOutboundMessagingCampaign() *schema.Resource {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			`rule_set_ids`: {
+				Description: `The digital rule sets to apply to this messaging campaign.`,
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			`sms_config`: {
-				Description: `Configuration for this messaging campaign to send SMS messages.`,
-				Required:    true,
-				MaxItems:    1,
-				Type:        schema.TypeSet,
-				Elem:        outboundmessagingcampaignsmsconfigResource,
+				Description:  `Configuration for this messaging campaign to send SMS messages.`,
+				Optional:     true,
+				MaxItems:     1,
+				Type:         schema.TypeSet,
+				Elem:         outboundmessagingcampaignsmsconfigResource,
+				ExactlyOneOf: []string{`sms_config`, `email_config`},
+			},
+			`email_config`: {
+				Description:  `Configuration for this messaging campaign to send email messages.`,
+				Optional:     true,
+				MaxItems:     1,
+				Type:         schema.TypeSet,
+				Elem:         outboundmessagingcampaignemailconfigResource,
+				ExactlyOneOf: []string{`sms_config`, `email_config`},
 			},
 		},
 	}
@@ -184,13 +260,18 @@ func outboundMessagingcampaignExporter() *ResourceExporter {
 	return &ResourceExporter{
 		GetResourcesFunc: getAllWithPooledClient(getAllOutboundMessagingcampaign),
 		RefAttrs: map[string]*RefAttrSettings{
-			`division_id`:             {RefType: "genesyscloud_auth_division"},
-			`contact_list_id`:         {RefType: "genesyscloud_outbound_contact_list"},
-			`contact_list_filter_ids`: {RefType: "genesyscloud_outbound_contactlistfilter"},
-			`dnc_list_ids`:            {RefType: "genesyscloud_outbound_dnclist"},
-			`callable_time_set_id`:    {RefType: "genesyscloud_outbound_callabletimeset"},
+			`division_id`:                             {RefType: "genesyscloud_auth_division"},
+			`contact_list_id`:                         {RefType: "genesyscloud_outbound_contact_list"},
+			`contact_list_filter_ids`:                 {RefType: "genesyscloud_outbound_contactlistfilter"},
+			`dnc_list_ids`:                            {RefType: "genesyscloud_outbound_dnclist"},
+			`callable_time_set_id`:                    {RefType: "genesyscloud_outbound_callabletimeset"},
+			`rule_set_ids`:                            {RefType: "genesyscloud_outbound_digitalruleset"},
+			`email_config.from_address.domain_id`:     {RefType: "genesyscloud_routing_email_domain"},
+			`email_config.reply_to_address.domain_id`: {RefType: "genesyscloud_routing_email_domain"},
+			`email_config.reply_to_address.route_id`:  {RefType: "genesyscloud_routing_email_route"},
 			// /api/v2/responsemanagement/responses/{responseId}
-			`sms_config.content_template_id`: {},
+			`sms_config.content_template_id`:   {},
+			`email_config.content_template_id`: {},
 		},
 	}
 }
@@ -213,7 +294,9 @@ func createOutboundMessagingcampaign(ctx context.Context, d *schema.ResourceData
 		ContactSorts:       buildSdkoutboundmessagingcampaignContactsortSlice(d.Get("contact_sorts").([]interface{})),
 		MessagesPerMinute:  &messagesPerMinute,
 		ContactListFilters: buildSdkDomainEntityRefArr(d, "contact_list_filter_ids"),
+		RuleSets:           buildSdkDomainEntityRefArr(d, "rule_set_ids"),
 		SmsConfig:          buildSdkoutboundmessagingcampaignSmsconfig(d.Get("sms_config").(*schema.Set)),
+		EmailConfig:        buildSdkoutboundmessagingcampaignEmailconfig(d.Get("email_config").(*schema.Set)),
 	}
 
 	if name != "" {
@@ -254,7 +337,9 @@ func updateOutboundMessagingcampaign(ctx context.Context, d *schema.ResourceData
 		ContactSorts:       buildSdkoutboundmessagingcampaignContactsortSlice(d.Get("contact_sorts").([]interface{})),
 		MessagesPerMinute:  &messagesPerMinute,
 		ContactListFilters: buildSdkDomainEntityRefArr(d, "contact_list_filter_ids"),
+		RuleSets:           buildSdkDomainEntityRefArr(d, "rule_set_ids"),
 		SmsConfig:          buildSdkoutboundmessagingcampaignSmsconfig(d.Get("sms_config").(*schema.Set)),
+		EmailConfig:        buildSdkoutboundmessagingcampaignEmailconfig(d.Get("email_config").(*schema.Set)),
 	}
 
 	if name != "" {
@@ -342,8 +427,20 @@ func readOutboundMessagingcampaign(ctx context.Context, d *schema.ResourceData,
 			}
 			d.Set("contact_list_filter_ids", contactListFilterIds)
 		}
+		if sdkmessagingcampaign.RuleSets != nil {
+			d.Set("rule_set_ids", sdkDomainEntityRefArrToList(*sdkmessagingcampaign.RuleSets))
+		} else {
+			d.Set("rule_set_ids", nil)
+		}
 		if sdkmessagingcampaign.SmsConfig != nil {
 			d.Set("sms_config", flattenSdkOutboundMessagingCampaignSmsconfig(sdkmessagingcampaign.SmsConfig))
+		} else {
+			d.Set("sms_config", nil)
+		}
+		if sdkmessagingcampaign.EmailConfig != nil {
+			d.Set("email_config", flattenSdkOutboundMessagingCampaignEmailconfig(sdkmessagingcampaign.EmailConfig))
+		} else {
+			d.Set("email_config", nil)
 		}
 
 		log.Printf("Read Outbound Messagingcampaign %s %s", d.Id(), *sdkmessagingcampaign.Name)
@@ -406,7 +503,7 @@ func buildSdkoutboundmessagingcampaignContactsortSlice(contactSort []interface{}
 }
 
 func buildSdkoutboundmessagingcampaignSmsconfig(smsconfig *schema.Set) *platformclientv2.Smsconfig {
-	if smsconfig == nil {
+	if smsconfig == nil || smsconfig.Len() == 0 {
 		return nil
 	}
 	var sdkSmsconfig platformclientv2.Smsconfig
@@ -484,3 +581,94 @@ func flattenSdkOutboundMessagingCampaignSmsconfig(smsconfig *platformclientv2.Sm
 
 	return smsconfigSet
 }
+
+func buildSdkoutboundmessagingcampaignEmailconfig(emailconfig *schema.Set) *platformclientv2.Emailconfig {
+	if emailconfig == nil || emailconfig.Len() == 0 {
+		return nil
+	}
+	var sdkEmailconfig platformclientv2.Emailconfig
+	emailconfigMap := emailconfig.List()[0].(map[string]interface{})
+
+	emailColumns := InterfaceListToStrings(emailconfigMap["email_columns"].([]interface{}))
+	sdkEmailconfig.EmailColumns = &emailColumns
+	if contentTemplateId := emailconfigMap["content_template_id"].(string); contentTemplateId != "" {
+		sdkEmailconfig.ContentTemplate = &platformclientv2.Domainentityref{Id: &contentTemplateId}
+	}
+	if fromAddressList := emailconfigMap["from_address"].([]interface{}); len(fromAddressList) > 0 {
+		fromAddressMap := fromAddressList[0].(map[string]interface{})
+		sdkEmailconfig.FromAddress = &platformclientv2.Fromemailaddress{
+			Domain:    &platformclientv2.Domainentityref{Id: platformclientv2.String(fromAddressMap["domain_id"].(string))},
+			LocalPart: platformclientv2.String(fromAddressMap["local_part"].(string)),
+		}
+		if friendlyName := fromAddressMap["friendly_name"].(string); friendlyName != "" {
+			sdkEmailconfig.FromAddress.FriendlyName = &friendlyName
+		}
+	}
+	if replyToAddressList := emailconfigMap["reply_to_address"].([]interface{}); len(replyToAddressList) > 0 {
+		replyToAddressMap := replyToAddressList[0].(map[string]interface{})
+		sdkEmailconfig.ReplyToAddress = &platformclientv2.Replytoemailaddress{
+			Domain: &platformclientv2.Domainentityref{Id: platformclientv2.String(replyToAddressMap["domain_id"].(string))},
+			Route:  &platformclientv2.Domainentityref{Id: platformclientv2.String(replyToAddressMap["route_id"].(string))},
+		}
+	}
+
+	return &sdkEmailconfig
+}
+
+func flattenSdkOutboundMessagingCampaignEmailconfig(emailconfig *platformclientv2.Emailconfig) *schema.Set {
+	if emailconfig == nil {
+		return nil
+	}
+
+	emailconfigSet := schema.NewSet(schema.HashResource(outboundmessagingcampaignemailconfigResource), []interface{}{})
+	emailconfigMap := make(map[string]interface{})
+
+	if emailconfig.EmailColumns != nil {
+		emailconfigMap["email_columns"] = stringListToInterfaceList(*emailconfig.EmailColumns)
+	}
+	if emailconfig.ContentTemplate != nil && emailconfig.ContentTemplate.Id != nil {
+		emailconfigMap["content_template_id"] = *emailconfig.ContentTemplate.Id
+	}
+	if emailconfig.FromAddress != nil {
+		fromAddressMap := make(map[string]interface{})
+		if emailconfig.FromAddress.Domain != nil && emailconfig.FromAddress.Domain.Id != nil {
+			fromAddressMap["domain_id"] = *emailconfig.FromAddress.Domain.Id
+		}
+		if emailconfig.FromAddress.FriendlyName != nil {
+			fromAddressMap["friendly_name"] = *emailconfig.FromAddress.FriendlyName
+		}
+		if emailconfig.FromAddress.LocalPart != nil {
+			fromAddressMap["local_part"] = *emailconfig.FromAddress.LocalPart
+		}
+		emailconfigMap["from_address"] = []interface{}{fromAddressMap}
+	}
+	if emailconfig.ReplyToAddress != nil {
+		replyToAddressMap := make(map[string]interface{})
+		if emailconfig.ReplyToAddress.Domain != nil && emailconfig.ReplyToAddress.Domain.Id != nil {
+			replyToAddressMap["domain_id"] = *emailconfig.ReplyToAddress.Domain.Id
+		}
+		if emailconfig.ReplyToAddress.Route != nil && emailconfig.ReplyToAddress.Route.Id != nil {
+			replyToAddressMap["route_id"] = *emailconfig.ReplyToAddress.Route.Id
+		}
+		emailconfigMap["reply_to_address"] = []interface{}{replyToAddressMap}
+	}
+
+	emailconfigSet.Add(emailconfigMap)
+
+	return emailconfigSet
+}
+
+// getOutboundMessagingCampaignContactListColumnRefs returns the contact list columns referenced by the messaging campaign
+func getOutboundMessagingCampaignContactListColumnRefs(diff *schema.ResourceDiff) []contactListColumnRef {
+	refs := appendContactSortColumnRefs(nil, diff.Get("contact_sorts").([]interface{}))
+	for _, smsconfig := range diff.Get("sms_config").(*schema.Set).List() {
+		smsconfigMap, _ := smsconfig.(map[string]interface{})
+		refs = appendContactListColumnRef(refs, "sms_config.message_column", smsconfigMap["message_column"], contactListColumnAny)
+		refs = appendContactListColumnRef(refs, "sms_config.phone_column", smsconfigMap["phone_column"], contactListColumnPhone)
+	}
+	for _, emailconfig := range diff.Get("email_config").(*schema.Set).List() {
+		emailconfigMap, _ := emailconfig.(map[string]interface{})
+		refs = appendContactListColumnRefs(refs, "email_config.email_columns", emailconfigMap["email_columns"], contactListColumnEmail)
+	}
+	return refs
+}
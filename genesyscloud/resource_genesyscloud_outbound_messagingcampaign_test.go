@@ -11,10 +11,49 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestOutboundMessagingCampaignEmailconfig(t *testing.T) {
+	emailconfig := schema.NewSet(schema.HashResource(outboundmessagingcampaignemailconfigResource), []interface{}{
+		map[string]interface{}{
+			"email_columns":       []interface{}{"Work Email", "Personal Email"},
+			"content_template_id": "template-id",
+			"from_address": []interface{}{map[string]interface{}{
+				"domain_id":     "outbound.example.com",
+				"friendly_name": "Support",
+				"local_part":    "support",
+			}},
+			"reply_to_address": []interface{}{map[string]interface{}{
+				"domain_id": "inbound.example.com",
+				"route_id":  "route-id",
+			}},
+		},
+	})
+
+	sdkEmailconfig := buildSdkoutboundmessagingcampaignEmailconfig(emailconfig)
+	assert.Equal(t, []string{"Work Email", "Personal Email"}, *sdkEmailconfig.EmailColumns)
+	assert.Equal(t, "template-id", *sdkEmailconfig.ContentTemplate.Id)
+	assert.Equal(t, "outbound.example.com", *sdkEmailconfig.FromAddress.Domain.Id)
+	assert.Equal(t, "Support", *sdkEmailconfig.FromAddress.FriendlyName)
+	assert.Equal(t, "support", *sdkEmailconfig.FromAddress.LocalPart)
+	assert.Equal(t, "inbound.example.com", *sdkEmailconfig.ReplyToAddress.Domain.Id)
+	assert.Equal(t, "route-id", *sdkEmailconfig.ReplyToAddress.Route.Id)
+
+	flattened := flattenSdkOutboundMessagingCampaignEmailconfig(sdkEmailconfig)
+	assert.True(t, emailconfig.Equal(flattened), "Flattened email config %v does not match %v", flattened.List(), emailconfig.List())
+
+	// The reply-to address and friendly name are optional
+	sdkEmailconfig.ReplyToAddress = nil
+	sdkEmailconfig.FromAddress.FriendlyName = nil
+	flattenedMap := flattenSdkOutboundMessagingCampaignEmailconfig(sdkEmailconfig).List()[0].(map[string]interface{})
+	assert.Empty(t, flattenedMap["reply_to_address"])
+	assert.Nil(t, buildSdkoutboundmessagingcampaignEmailconfig(schema.NewSet(schema.HashResource(outboundmessagingcampaignemailconfigResource), nil)))
+}
+
 /*
 This test can only pass in a test org because it requires an active provisioned sms phone number
 Endpoint `POST /api/v2/routing/sms/phonenumbers` creates an active/valid phone number in test orgs only.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
@@ -254,6 +255,7 @@ func resourceOutboundRuleset() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeContactListColumnsDiff("contact_list_id", getOutboundRulesetContactListColumnRefs, "rules"),
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name of the RuleSet.`,
@@ -823,3 +825,31 @@ func flattenSdkoutboundrulesetDialerruleSlice(dialerrules []platformclientv2.Dia
 
 	return dialerruleList
 }
+
+// getOutboundRulesetContactListColumnRefs returns the contact list columns referenced by the rule conditions and actions
+func getOutboundRulesetContactListColumnRefs(diff *schema.ResourceDiff) []contactListColumnRef {
+	var refs []contactListColumnRef
+	for i, rule := range diff.Get("rules").([]interface{}) {
+		ruleMap, _ := rule.(map[string]interface{})
+		conditions, _ := ruleMap["conditions"].([]interface{})
+		for j, condition := range conditions {
+			conditionMap, _ := condition.(map[string]interface{})
+			path := fmt.Sprintf("rules.%d.conditions.%d", i, j)
+			refs = appendContactListColumnRef(refs, path+".attribute_name", conditionMap["attribute_name"], contactListColumnAny)
+			if propertyType, _ := conditionMap["property_type"].(string); strings.HasSuffix(propertyType, "_BY_COLUMN") {
+				refs = appendContactListColumnRef(refs, path+".property", conditionMap["property"], contactListColumnAny)
+			}
+			refs = appendContactListColumnMappingRefs(refs, path+".contact_column_to_data_action_field_mappings", conditionMap["contact_column_to_data_action_field_mappings"])
+		}
+		actions, _ := ruleMap["actions"].([]interface{})
+		for j, action := range actions {
+			actionMap, _ := action.(map[string]interface{})
+			path := fmt.Sprintf("rules.%d.actions.%d", i, j)
+			if actionMap["action_type_name"] == "MODIFY_CONTACT_ATTRIBUTE" {
+				refs = appendContactListColumnKeyRefs(refs, path+".properties", actionMap["properties"])
+			}
+			refs = appendContactListColumnMappingRefs(refs, path+".contact_column_to_data_action_field_mappings", actionMap["contact_column_to_data_action_field_mappings"])
+		}
+	}
+	return refs
+}
@@ -0,0 +1,390 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+// Format of the interval start and end times, in the time zone of the schedule
+const outboundScheduleIntervalFormat = "2006-01-02T15:04:05.000"
+
+var (
+	outboundScheduleIntervalResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			`start`: {
+				Description:      `The scheduled start time, in the time zone of the schedule. Format: yyyy-MM-ddTHH:mm:ss.SSS`,
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateOutboundScheduleIntervalTime,
+			},
+			`end`: {
+				Description:      `The scheduled end time, in the time zone of the schedule. Format: yyyy-MM-ddTHH:mm:ss.SSS`,
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateOutboundScheduleIntervalTime,
+			},
+		},
+	}
+)
+
+func resourceOutboundSchedule() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud outbound schedule. Declares the intervals during which a campaign or a sequence runs.`,
+
+		CreateContext: createWithPooledClient(createOutboundSchedule),
+		ReadContext:   readWithPooledClient(readOutboundSchedule),
+		UpdateContext: updateWithPooledClient(updateOutboundSchedule),
+		DeleteContext: deleteWithPooledClient(deleteOutboundSchedule),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		CustomizeDiff: customizeOutboundScheduleDiff,
+		Schema: map[string]*schema.Schema{
+			`campaign_id`: {
+				Description:  `The campaign that this schedule is for.`,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{`campaign_id`, `sequence_id`},
+			},
+			`sequence_id`: {
+				Description:  `The sequence that this schedule is for.`,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{`campaign_id`, `sequence_id`},
+			},
+			`intervals`: {
+				Description: `The intervals during which to run the campaign or sequence.`,
+				Required:    true,
+				Type:        schema.TypeList,
+				MinItems:    1,
+				Elem:        outboundScheduleIntervalResource,
+			},
+			`time_zone`: {
+				Description: `The time zone of the intervals; for example, America/Indianapolis.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func getAllOutboundSchedules(_ context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(ResourceIDMetaMap)
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(clientConfig)
+
+	campaignSchedules, _, getErr := outboundApi.GetOutboundSchedulesCampaigns()
+	if getErr != nil {
+		return nil, diag.Errorf("Failed to get outbound campaign schedules: %s", getErr)
+	}
+	for _, campaignSchedule := range campaignSchedules {
+		if campaignSchedule.Campaign != nil && campaignSchedule.Campaign.Id != nil {
+			resources[*campaignSchedule.Campaign.Id] = &ResourceMeta{Name: outboundScheduleResourceName(campaignSchedule.Name, *campaignSchedule.Campaign.Id)}
+		}
+	}
+
+	sequenceSchedules, _, getErr := outboundApi.GetOutboundSchedulesSequences()
+	if getErr != nil {
+		return nil, diag.Errorf("Failed to get outbound sequence schedules: %s", getErr)
+	}
+	for _, sequenceSchedule := range sequenceSchedules {
+		if sequenceSchedule.Sequence != nil && sequenceSchedule.Sequence.Id != nil {
+			resources[*sequenceSchedule.Sequence.Id] = &ResourceMeta{Name: outboundScheduleResourceName(sequenceSchedule.Name, *sequenceSchedule.Sequence.Id)}
+		}
+	}
+
+	return resources, nil
+}
+
+func outboundScheduleResourceName(name *string, id string) string {
+	if name != nil && *name != "" {
+		return *name
+	}
+	return id
+}
+
+func outboundScheduleExporter() *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(getAllOutboundSchedules),
+		RefAttrs: map[string]*RefAttrSettings{
+			`campaign_id`: {
+				RefType: "genesyscloud_outbound_campaign",
+			},
+			`sequence_id`: {
+				RefType: "genesyscloud_outbound_sequence",
+			},
+		},
+	}
+}
+
+func createOutboundSchedule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if campaignId := d.Get("campaign_id").(string); campaignId != "" {
+		d.SetId(campaignId)
+	} else {
+		d.SetId(d.Get("sequence_id").(string))
+	}
+
+	log.Printf("Creating Outbound Schedule %s", d.Id())
+	if diagErr := putOutboundSchedule(d, meta); diagErr != nil {
+		d.SetId("")
+		return diagErr
+	}
+
+	log.Printf("Created Outbound Schedule %s", d.Id())
+	return readOutboundSchedule(ctx, d, meta)
+}
+
+func updateOutboundSchedule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating Outbound Schedule %s", d.Id())
+	if diagErr := putOutboundSchedule(d, meta); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated Outbound Schedule %s", d.Id())
+	return readOutboundSchedule(ctx, d, meta)
+}
+
+func putOutboundSchedule(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+
+	intervals := buildSdkOutboundScheduleIntervals(d.Get("intervals").([]interface{}))
+	timeZone := d.Get("time_zone").(string)
+
+	return retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		if d.Get("campaign_id").(string) != "" {
+			campaignSchedule := platformclientv2.Campaignschedule{
+				Intervals: intervals,
+				TimeZone:  &timeZone,
+				Campaign:  &platformclientv2.Domainentityref{Id: platformclientv2.String(d.Id())},
+			}
+			// Schedules are versioned once they exist
+			current, resp, getErr := outboundApi.GetOutboundSchedulesCampaign(d.Id())
+			if getErr != nil && !isStatus404(resp) {
+				return resp, diag.Errorf("Failed to read Outbound Schedule of campaign %s: %s", d.Id(), getErr)
+			}
+			if current != nil {
+				campaignSchedule.Version = current.Version
+			}
+			_, resp, putErr := outboundApi.PutOutboundSchedulesCampaign(d.Id(), campaignSchedule)
+			if putErr != nil {
+				return resp, diag.Errorf("Failed to update Outbound Schedule of campaign %s: %s", d.Id(), putErr)
+			}
+			return nil, nil
+		}
+
+		sequenceSchedule := platformclientv2.Sequenceschedule{
+			Intervals: intervals,
+			TimeZone:  &timeZone,
+			Sequence:  &platformclientv2.Domainentityref{Id: platformclientv2.String(d.Id())},
+		}
+		current, resp, getErr := outboundApi.GetOutboundSchedulesSequence(d.Id())
+		if getErr != nil && !isStatus404(resp) {
+			return resp, diag.Errorf("Failed to read Outbound Schedule of sequence %s: %s", d.Id(), getErr)
+		}
+		if current != nil {
+			sequenceSchedule.Version = current.Version
+		}
+		_, resp, putErr := outboundApi.PutOutboundSchedulesSequence(d.Id(), sequenceSchedule)
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update Outbound Schedule of sequence %s: %s", d.Id(), putErr)
+		}
+		return nil, nil
+	})
+}
+
+func readOutboundSchedule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+
+	log.Printf("Reading Outbound Schedule %s", d.Id())
+
+	return withRetriesForRead(ctx, d, func() *resource.RetryError {
+		var (
+			intervals *[]platformclientv2.Scheduleinterval
+			timeZone  *string
+		)
+
+		// Imported schedules have neither ID set, so look for a campaign schedule first
+		sequenceId := d.Get("sequence_id").(string)
+		if sequenceId == "" {
+			campaignSchedule, resp, getErr := outboundApi.GetOutboundSchedulesCampaign(d.Id())
+			if getErr != nil && (d.Get("campaign_id").(string) != "" || !isStatus404(resp)) {
+				if isStatus404(resp) {
+					return resource.RetryableError(fmt.Errorf("Failed to read Outbound Schedule %s: %s", d.Id(), getErr))
+				}
+				return resource.NonRetryableError(fmt.Errorf("Failed to read Outbound Schedule %s: %s", d.Id(), getErr))
+			}
+			if campaignSchedule != nil {
+				d.Set("campaign_id", d.Id())
+				d.Set("sequence_id", nil)
+				intervals = campaignSchedule.Intervals
+				timeZone = campaignSchedule.TimeZone
+			} else {
+				sequenceId = d.Id()
+			}
+		}
+
+		if sequenceId != "" {
+			sequenceSchedule, resp, getErr := outboundApi.GetOutboundSchedulesSequence(d.Id())
+			if getErr != nil {
+				if isStatus404(resp) {
+					return resource.RetryableError(fmt.Errorf("Failed to read Outbound Schedule %s: %s", d.Id(), getErr))
+				}
+				return resource.NonRetryableError(fmt.Errorf("Failed to read Outbound Schedule %s: %s", d.Id(), getErr))
+			}
+			d.Set("sequence_id", d.Id())
+			d.Set("campaign_id", nil)
+			intervals = sequenceSchedule.Intervals
+			timeZone = sequenceSchedule.TimeZone
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceOutboundSchedule())
+
+		if intervals != nil {
+			d.Set("intervals", flattenSdkOutboundScheduleIntervals(*intervals))
+		} else {
+			d.Set("intervals", nil)
+		}
+		if timeZone != nil {
+			d.Set("time_zone", *timeZone)
+		} else {
+			d.Set("time_zone", nil)
+		}
+
+		log.Printf("Read Outbound Schedule %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func deleteOutboundSchedule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+
+	isCampaign := d.Get("campaign_id").(string) != ""
+
+	diagErr := retryWhen(isStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		log.Printf("Deleting Outbound Schedule %s", d.Id())
+		var (
+			resp *platformclientv2.APIResponse
+			err  error
+		)
+		if isCampaign {
+			resp, err = outboundApi.DeleteOutboundSchedulesCampaign(d.Id())
+		} else {
+			resp, err = outboundApi.DeleteOutboundSchedulesSequence(d.Id())
+		}
+		if err != nil && !isStatus404(resp) {
+			return resp, diag.Errorf("Failed to delete Outbound Schedule %s: %s", d.Id(), err)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	return withRetries(ctx, 30*time.Second, func() *resource.RetryError {
+		var (
+			resp *platformclientv2.APIResponse
+			err  error
+		)
+		if isCampaign {
+			_, resp, err = outboundApi.GetOutboundSchedulesCampaign(d.Id())
+		} else {
+			_, resp, err = outboundApi.GetOutboundSchedulesSequence(d.Id())
+		}
+		if err != nil {
+			if isStatus404(resp) {
+				// Outbound Schedule deleted
+				log.Printf("Deleted Outbound Schedule %s", d.Id())
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error deleting Outbound Schedule %s: %s", d.Id(), err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Outbound Schedule %s still exists", d.Id()))
+	})
+}
+
+// customizeOutboundScheduleDiff rejects intervals that end before they start or overlap each other
+func customizeOutboundScheduleDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("intervals") {
+		return nil
+	}
+
+	type interval struct{ start, end time.Time }
+	var parsed []interval
+	for i, intervalItem := range diff.Get("intervals").([]interface{}) {
+		intervalMap := intervalItem.(map[string]interface{})
+		start, startErr := time.Parse(outboundScheduleIntervalFormat, intervalMap["start"].(string))
+		end, endErr := time.Parse(outboundScheduleIntervalFormat, intervalMap["end"].(string))
+		if startErr != nil || endErr != nil {
+			// Unknown or invalid values are reported by the attribute validation
+			continue
+		}
+		if !end.After(start) {
+			return fmt.Errorf("interval %d ends at %s which is not after its start %s", i, intervalMap["end"], intervalMap["start"])
+		}
+		for j, other := range parsed {
+			if start.Before(other.end) && other.start.Before(end) {
+				return fmt.Errorf("interval %d overlaps interval %d", i, j)
+			}
+		}
+		parsed = append(parsed, interval{start, end})
+	}
+	return nil
+}
+
+// Validates an interval time is in the format 2006-01-02T15:04:05.000
+func validateOutboundScheduleIntervalTime(date interface{}, _ cty.Path) diag.Diagnostics {
+	if dateStr, ok := date.(string); ok {
+		if _, err := time.Parse(outboundScheduleIntervalFormat, dateStr); err != nil {
+			return diag.Errorf("Failed to parse interval time %s, expected format yyyy-MM-ddTHH:mm:ss.SSS: %s", dateStr, err)
+		}
+		return nil
+	}
+	return diag.Errorf("Interval time %v is not a string", date)
+}
+
+func buildSdkOutboundScheduleIntervals(intervalList []interface{}) *[]platformclientv2.Scheduleinterval {
+	intervals := make([]platformclientv2.Scheduleinterval, 0, len(intervalList))
+	for _, intervalItem := range intervalList {
+		intervalMap := intervalItem.(map[string]interface{})
+		intervals = append(intervals, platformclientv2.Scheduleinterval{
+			Start: platformclientv2.String(intervalMap["start"].(string)),
+			End:   platformclientv2.String(intervalMap["end"].(string)),
+		})
+	}
+	return &intervals
+}
+
+func flattenSdkOutboundScheduleIntervals(intervals []platformclientv2.Scheduleinterval) []interface{} {
+	intervalList := make([]interface{}, 0, len(intervals))
+	for _, interval := range intervals {
+		intervalMap := make(map[string]interface{})
+		// The times are local to the schedule time zone even when returned with a zone designator
+		if interval.Start != nil {
+			intervalMap["start"] = strings.TrimSuffix(*interval.Start, "Z")
+		}
+		if interval.End != nil {
+			intervalMap["end"] = strings.TrimSuffix(*interval.End, "Z")
+		}
+		intervalList = append(intervalList, intervalMap)
+	}
+	return intervalList
+}
@@ -0,0 +1,160 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourceOutboundSchedule(t *testing.T) {
+	t.Parallel()
+	var (
+		// Schedule
+		scheduleResource = "outbound_schedule"
+		timeZone1        = "America/Indianapolis"
+		timeZone2        = "Europe/Dublin"
+
+		// Campaign resources
+		campaignResourceId    = "campaign_resource"
+		campaignName          = "Campaign " + uuid.NewString()
+		contactListResourceId = "contact_list"
+		carResourceId         = "car"
+		siteId                = "site"
+		outboundFlowFilePath  = "../examples/resources/genesyscloud_flow/outboundcall_flow_example.yaml"
+		flowName              = "test flow " + uuid.NewString()
+		emergencyNumber       = "+13172947331"
+	)
+
+	// necessary to avoid errors during site creation
+	err := authorizeSdk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = deleteLocationWithNumber(emergencyNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	campaign := fmt.Sprintf(`
+data "genesyscloud_auth_division_home" "home" {}
+`) + generateOutboundCampaignBasic(
+		campaignResourceId,
+		campaignName,
+		contactListResourceId,
+		siteId,
+		emergencyNumber,
+		carResourceId,
+		nullValue,
+		outboundFlowFilePath,
+		"schedule-test-flow",
+		flowName,
+		"${data.genesyscloud_auth_division_home.home.name}",
+		"schedule-test-location",
+		"schedule-test-wrapupcode",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: campaign + generateOutboundSchedule(
+					scheduleResource,
+					"genesyscloud_outbound_campaign."+campaignResourceId+".id",
+					timeZone1,
+					generateOutboundScheduleInterval("2040-01-01T08:00:00.000", "2040-01-01T17:00:00.000"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_outbound_schedule."+scheduleResource, "campaign_id",
+						"genesyscloud_outbound_campaign."+campaignResourceId, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_schedule."+scheduleResource, "time_zone", timeZone1),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_schedule."+scheduleResource, "intervals.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_schedule."+scheduleResource, "intervals.0.start", "2040-01-01T08:00:00.000"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_schedule."+scheduleResource, "intervals.0.end", "2040-01-01T17:00:00.000"),
+				),
+			},
+			{
+				// Overlapping intervals are rejected at plan time
+				Config: campaign + generateOutboundSchedule(
+					scheduleResource,
+					"genesyscloud_outbound_campaign."+campaignResourceId+".id",
+					timeZone1,
+					generateOutboundScheduleInterval("2040-01-01T08:00:00.000", "2040-01-01T17:00:00.000"),
+					generateOutboundScheduleInterval("2040-01-01T16:00:00.000", "2040-01-01T18:00:00.000"),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("overlaps"),
+			},
+			{
+				// Update time zone and add an interval
+				Config: campaign + generateOutboundSchedule(
+					scheduleResource,
+					"genesyscloud_outbound_campaign."+campaignResourceId+".id",
+					timeZone2,
+					generateOutboundScheduleInterval("2040-01-01T08:00:00.000", "2040-01-01T17:00:00.000"),
+					generateOutboundScheduleInterval("2040-01-02T08:00:00.000", "2040-01-02T17:00:00.000"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_outbound_schedule."+scheduleResource, "time_zone", timeZone2),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_schedule."+scheduleResource, "intervals.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_schedule."+scheduleResource, "intervals.1.start", "2040-01-02T08:00:00.000"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_outbound_schedule." + scheduleResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyOutboundScheduleDestroyed,
+	})
+}
+
+func generateOutboundSchedule(resourceId string, campaignId string, timeZone string, intervals ...string) string {
+	return fmt.Sprintf(`
+resource "genesyscloud_outbound_schedule" "%s" {
+	campaign_id = %s
+	time_zone   = "%s"
+	%s
+}
+`, resourceId, campaignId, timeZone, strings.Join(intervals, "\n"))
+}
+
+func generateOutboundScheduleInterval(start string, end string) string {
+	return fmt.Sprintf(`
+	intervals {
+		start = "%s"
+		end   = "%s"
+	}
+	`, start, end)
+}
+
+func testVerifyOutboundScheduleDestroyed(state *terraform.State) error {
+	outboundAPI := platformclientv2.NewOutboundApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_outbound_schedule" {
+			continue
+		}
+		schedule, resp, err := outboundAPI.GetOutboundSchedulesCampaign(rs.Primary.ID)
+		if schedule != nil {
+			return fmt.Errorf("outbound schedule (%s) still exists", rs.Primary.ID)
+		} else if isStatus404(resp) {
+			// Outbound schedule not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("unexpected error: %s", err)
+		}
+	}
+	// Success. All outbound schedules destroyed
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -182,6 +183,11 @@ func evaluationFormExporter() *ResourceExporter {
 		GetResourcesFunc: getAllWithPooledClient(getAllEvaluationForms),
 		RefAttrs:         map[string]*RefAttrSettings{}, // No references
 		AllowZeroValues:  []string{"question_groups.questions.answer_options.value", "question_groups.weight"},
+		ExcludedAttributes: []string{
+			"context_id",
+			"published_version_id",
+			"published_versions",
+		},
 	}
 }
 
@@ -203,7 +209,13 @@ func resourceEvaluationForm() *schema.Resource {
 				Required:    true,
 			},
 			"published": {
-				Description: "Specifies if the evalutaion form is published.",
+				Description: "Specifies if the evalutaion form is published. Once published, a new version is only published when the name or question groups differ from the latest published version.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"keep_previous_version_live": {
+				Description: "If true, changes to a published form are saved to its unpublished version and the latest published version stays live. Set back to false to publish the changes. A form without a published version is still published when `published` is set to true.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
@@ -215,6 +227,22 @@ func resourceEvaluationForm() *schema.Resource {
 				MinItems:    1,
 				Elem:        evaluationFormQuestionGroup,
 			},
+			"context_id": {
+				Description: "The ID shared by all versions of the form.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"published_version_id": {
+				Description: "The ID of the latest published version of the form.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"published_versions": {
+				Description: "The published versions of the form, newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        qualityFormVersionResource,
+			},
 		},
 	}
 }
@@ -286,6 +314,18 @@ func readEvaluationForm(ctx context.Context, d *schema.ResourceData, meta interf
 		if evaluationForm.QuestionGroups != nil {
 			d.Set("question_groups", flattenQuestionGroups(evaluationForm.QuestionGroups))
 		}
+		if evaluationForm.ContextId != nil {
+			d.Set("context_id", *evaluationForm.ContextId)
+		}
+
+		versions, resp, getErr := getEvaluationFormVersions(qualityAPI, d.Id())
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to read versions of evaluation form %s: %s", d.Id(), getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read versions of evaluation form %s: %s", d.Id(), getErr))
+		}
+		setQualityFormPublishedVersions(d, versions)
 
 		return cc.CheckState()
 	})
@@ -294,6 +334,8 @@ func readEvaluationForm(ctx context.Context, d *schema.ResourceData, meta interf
 func updateEvaluationForm(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	published := d.Get("published").(bool)
+	// A previous version can only be kept live if the form was already published
+	keepPreviousVersionLive := d.Get("keep_previous_version_live").(bool) && !d.HasChange("published") && d.Get("published_version_id").(string) != ""
 
 	questionGroups, qgErr := buildSdkQuestionGroups(d)
 	if qgErr != nil {
@@ -309,25 +351,31 @@ func updateEvaluationForm(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("Failed to get evaluation form versions %s", name)
 	}
 
-	unpublishedForm := (*formVersions.Entities)[0]
+	form := &(*formVersions.Entities)[0]
 
-	log.Printf("Updating Evaluation Form %s", name)
-	form, _, err := qualityAPI.PutQualityFormsEvaluation(*unpublishedForm.Id, platformclientv2.Evaluationform{
-		Name:           &name,
-		QuestionGroups: questionGroups,
-	})
-	if err != nil {
-		return diag.Errorf("Failed to update evaluation form %s", name)
+	if d.HasChanges("name", "question_groups") {
+		log.Printf("Updating Evaluation Form %s", name)
+		form, _, err = qualityAPI.PutQualityFormsEvaluation(*form.Id, platformclientv2.Evaluationform{
+			Name:           &name,
+			QuestionGroups: questionGroups,
+		})
+		if err != nil {
+			return diag.Errorf("Failed to update evaluation form %s", name)
+		}
+	} else {
+		// Version listings may not include the question groups needed to compare the form with its published version
+		form, _, err = qualityAPI.GetQualityFormsEvaluation(*form.Id)
+		if err != nil {
+			return diag.Errorf("Failed to get evaluation form %s: %s", name, err)
+		}
 	}
 
 	// Set published property on evaluation form update.
 	if published {
-		_, _, err := qualityAPI.PostQualityPublishedformsEvaluations(platformclientv2.Publishform{
-			Id:        form.Id,
-			Published: &published,
-		})
-		if err != nil {
-			return diag.Errorf("Failed to publish evaluation form %s", name)
+		if keepPreviousVersionLive {
+			log.Printf("Keeping the previous version of evaluation form %s live", name)
+		} else if diagErr := publishEvaluationFormChanges(qualityAPI, form, d.HasChange("published")); diagErr != nil {
+			return diagErr
 		}
 	} else {
 		// If published property is reset to false, set the resource Id to the latest unpublished form
@@ -338,6 +386,37 @@ func updateEvaluationForm(ctx context.Context, d *schema.ResourceData, meta inte
 	return readEvaluationForm(ctx, d, meta)
 }
 
+// publishEvaluationFormChanges publishes the form unless it matches the latest published version or force is set
+func publishEvaluationFormChanges(qualityAPI *platformclientv2.QualityApi, form *platformclientv2.Evaluationform, force bool) diag.Diagnostics {
+	name := valueOrEmpty(form.Name)
+
+	if !force {
+		publishedForm, resp, err := qualityAPI.GetQualityPublishedformsEvaluation(*form.Id)
+		if err != nil && !isStatus404(resp) {
+			return diag.Errorf("Failed to get the published version of evaluation form %s: %s", name, err)
+		}
+		if err == nil && !evaluationFormChanged(form, publishedForm) {
+			log.Printf("Evaluation form %s matches its published version %s. Skipping publish.", name, valueOrEmpty(publishedForm.Id))
+			return nil
+		}
+	}
+
+	published := true
+	if _, _, err := qualityAPI.PostQualityPublishedformsEvaluations(platformclientv2.Publishform{
+		Id:        form.Id,
+		Published: &published,
+	}); err != nil {
+		return diag.Errorf("Failed to publish evaluation form %s", name)
+	}
+	return nil
+}
+
+// evaluationFormChanged returns true if the name or question groups of a form differ from another version of it
+func evaluationFormChanged(form, other *platformclientv2.Evaluationform) bool {
+	return valueOrEmpty(form.Name) != valueOrEmpty(other.Name) ||
+		!reflect.DeepEqual(flattenQuestionGroups(form.QuestionGroups), flattenQuestionGroups(other.QuestionGroups))
+}
+
 func deleteEvaluationForm(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 
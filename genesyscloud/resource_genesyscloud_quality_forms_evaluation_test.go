@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceEvaluationFormBasic(t *testing.T) {
@@ -130,9 +131,10 @@ func TestAccResourceEvaluationFormBasic(t *testing.T) {
 			},
 			{
 				// Import/Read
-				ResourceName:      "genesyscloud_quality_forms_evaluation." + formResource1,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "genesyscloud_quality_forms_evaluation." + formResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_previous_version_live"},
 			},
 		},
 		CheckDestroy: testVerifyEvaluationFormDestroyed,
@@ -249,9 +251,10 @@ func TestAccResourceEvaluationFormComplete(t *testing.T) {
 			},
 			{
 				// Import/Read
-				ResourceName:      "genesyscloud_quality_forms_evaluation." + formResource1,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "genesyscloud_quality_forms_evaluation." + formResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_previous_version_live"},
 			},
 		},
 		CheckDestroy: testVerifyEvaluationFormDestroyed,
@@ -319,15 +322,184 @@ func TestAccResourceEvaluationFormRepublishing(t *testing.T) {
 			},
 			{
 				// Import/Read
-				ResourceName:      "genesyscloud_quality_forms_evaluation." + formResource1,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "genesyscloud_quality_forms_evaluation." + formResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_previous_version_live"},
 			},
 		},
 		CheckDestroy: testVerifyEvaluationFormDestroyed,
 	})
 }
 
+func TestAccResourceEvaluationFormKeepPreviousVersionLive(t *testing.T) {
+	formResource1 := "test-evaluation-form-1"
+
+	evaluationForm1 := EvaluationFormStruct{
+		Name:      "terraform-form-evaluations-" + uuid.NewString(),
+		Published: true,
+		QuestionGroups: []EvaluationFormQuestionGroupStruct{
+			{
+				Name:   "Test Question Group 1",
+				Weight: 1,
+				Questions: []EvaluationFormQuestionStruct{
+					{
+						Text: "Did the agent perform the opening spiel?",
+						AnswerOptions: []AnswerOptionStruct{
+							{
+								Text:  "Yes",
+								Value: 1,
+							},
+							{
+								Text:  "No",
+								Value: 0,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Change a question
+	evaluationForm2 := evaluationForm1
+	evaluationForm2.QuestionGroups = []EvaluationFormQuestionGroupStruct{evaluationForm1.QuestionGroups[0]}
+	evaluationForm2.QuestionGroups[0].Questions = []EvaluationFormQuestionStruct{evaluationForm1.QuestionGroups[0].Questions[0]}
+	evaluationForm2.QuestionGroups[0].Questions[0].Text = "Did the agent greet the customer?"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Publish form on creation
+				Config: generateEvaluationFormResourceWithKeepPreviousVersionLive(formResource1, &evaluationForm1, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.#", "1"),
+					resource.TestCheckResourceAttrPair("genesyscloud_quality_forms_evaluation."+formResource1, "published_version_id", "genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.0.id"),
+				),
+			},
+			{
+				// Keep the published version live while the question changes
+				Config: generateEvaluationFormResourceWithKeepPreviousVersionLive(formResource1, &evaluationForm2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.0.questions.0.text", evaluationForm2.QuestionGroups[0].Questions[0].Text),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.#", "1"),
+				),
+			},
+			{
+				// Cut over to the changed question
+				Config: generateEvaluationFormResourceWithKeepPreviousVersionLive(formResource1, &evaluationForm2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.#", "2"),
+					resource.TestCheckResourceAttrPair("genesyscloud_quality_forms_evaluation."+formResource1, "published_version_id", "genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.0.id"),
+				),
+			},
+			{
+				// Save the original question without publishing it
+				Config: generateEvaluationFormResourceWithKeepPreviousVersionLive(formResource1, &evaluationForm1, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.0.questions.0.text", evaluationForm1.QuestionGroups[0].Questions[0].Text),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.#", "2"),
+				),
+			},
+			{
+				// Changing the question back to the published version does not publish a new version
+				Config: generateEvaluationFormResourceWithKeepPreviousVersionLive(formResource1, &evaluationForm2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.0.questions.0.text", evaluationForm2.QuestionGroups[0].Questions[0].Text),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.#", "2"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyEvaluationFormDestroyed,
+	})
+}
+
+func TestAccResourceEvaluationFormPublishWithKeepPreviousVersionLive(t *testing.T) {
+	formResource1 := "test-evaluation-form-1"
+
+	evaluationForm := EvaluationFormStruct{
+		Name:      "New Form " + uuid.NewString(),
+		Published: false,
+		QuestionGroups: []EvaluationFormQuestionGroupStruct{
+			{
+				Name:   "Test Question Group 1",
+				Weight: 1,
+				Questions: []EvaluationFormQuestionStruct{
+					{
+						Text: "Did the agent perform the opening spiel?",
+						AnswerOptions: []AnswerOptionStruct{
+							{
+								Text:  "Yes",
+								Value: 1,
+							},
+							{
+								Text:  "No",
+								Value: 0,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	publishedEvaluationForm := evaluationForm
+	publishedEvaluationForm.Published = true
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create an unpublished form
+				Config: generateEvaluationFormResourceWithKeepPreviousVersionLive(formResource1, &evaluationForm, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published", falseValue),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.#", "0"),
+				),
+			},
+			{
+				// No previous version is live, so the form is published
+				Config: generateEvaluationFormResourceWithKeepPreviousVersionLive(formResource1, &publishedEvaluationForm, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.#", "1"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyEvaluationFormDestroyed,
+	})
+}
+
+func TestEvaluationFormChanged(t *testing.T) {
+	form := func(name, question string) *platformclientv2.Evaluationform {
+		return &platformclientv2.Evaluationform{
+			Id:   platformclientv2.String(uuid.NewString()),
+			Name: &name,
+			QuestionGroups: &[]platformclientv2.Evaluationquestiongroup{
+				{
+					Id:     platformclientv2.String(uuid.NewString()),
+					Name:   platformclientv2.String("Group"),
+					Weight: platformclientv2.Float32(1),
+					Questions: &[]platformclientv2.Evaluationquestion{
+						{
+							Id:   platformclientv2.String(uuid.NewString()),
+							Text: &question,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// IDs differ between versions of a form
+	assert.False(t, evaluationFormChanged(form("Form", "Question"), form("Form", "Question")))
+	assert.True(t, evaluationFormChanged(form("Form", "Question"), form("Form", "Changed question")))
+	assert.True(t, evaluationFormChanged(form("Form", "Question"), form("Renamed form", "Question")))
+}
+
 func testVerifyEvaluationFormDestroyed(state *terraform.State) error {
 	qualityAPI := platformclientv2.NewQualityApi()
 	for _, rs := range state.RootModule().Resources {
@@ -355,3 +527,18 @@ func testVerifyEvaluationFormDestroyed(state *terraform.State) error {
 	// Success. All Evaluation forms destroyed
 	return nil
 }
+
+func generateEvaluationFormResourceWithKeepPreviousVersionLive(resourceID string, evaluationForm *EvaluationFormStruct, keepPreviousVersionLive bool) string {
+	return fmt.Sprintf(`resource "genesyscloud_quality_forms_evaluation" "%s" {
+		name = "%s"
+		published = %v
+		keep_previous_version_live = %v
+		%s
+	}
+	`, resourceID,
+		evaluationForm.Name,
+		evaluationForm.Published,
+		keepPreviousVersionLive,
+		GenerateEvaluationFormQuestionGroups(&evaluationForm.QuestionGroups),
+	)
+}
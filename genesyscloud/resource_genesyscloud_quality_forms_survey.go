@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"time"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
@@ -178,6 +179,11 @@ func surveyFormExporter() *ResourceExporter {
 		GetResourcesFunc: getAllWithPooledClient(getAllSurveyForms),
 		RefAttrs:         map[string]*RefAttrSettings{}, // No references
 		AllowZeroValues:  []string{"question_groups.questions.answer_options.value"},
+		ExcludedAttributes: []string{
+			"context_id",
+			"published_version_id",
+			"published_versions",
+		},
 	}
 }
 
@@ -199,7 +205,13 @@ func resourceSurveyForm() *schema.Resource {
 				Required:    true,
 			},
 			"published": {
-				Description: "Specifies if the survey form is published.",
+				Description: "Specifies if the survey form is published. Once published, a new version is only published when the form differs from the latest published version.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"keep_previous_version_live": {
+				Description: "If true, changes to a published form are saved to its unpublished version and the latest published version stays live. Set back to false to publish the changes. A form without a published version is still published when `published` is set to true.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
@@ -233,6 +245,22 @@ func resourceSurveyForm() *schema.Resource {
 				MinItems:    1,
 				Elem:        surveyQuestionGroup,
 			},
+			"context_id": {
+				Description: "The ID shared by all versions of the form.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"published_version_id": {
+				Description: "The ID of the latest published version of the form.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"published_versions": {
+				Description: "The published versions of the form, newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        qualityFormVersionResource,
+			},
 		},
 	}
 }
@@ -324,6 +352,18 @@ func readSurveyForm(ctx context.Context, d *schema.ResourceData, meta interface{
 		if surveyForm.QuestionGroups != nil {
 			d.Set("question_groups", flattenSurveyQuestionGroups(surveyForm.QuestionGroups))
 		}
+		if surveyForm.ContextId != nil {
+			d.Set("context_id", *surveyForm.ContextId)
+		}
+
+		versions, resp, getErr := getSurveyFormVersions(qualityAPI, d.Id())
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to read versions of survey form %s: %s", d.Id(), getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read versions of survey form %s: %s", d.Id(), getErr))
+		}
+		setQualityFormPublishedVersions(d, versions)
 
 		return cc.CheckState()
 	})
@@ -336,6 +376,8 @@ func updateSurveyForm(ctx context.Context, d *schema.ResourceData, meta interfac
 	footer := d.Get("footer").(string)
 	disabled := d.Get("disabled").(bool)
 	published := d.Get("published").(bool)
+	// A previous version can only be kept live if the form was already published
+	keepPreviousVersionLive := d.Get("keep_previous_version_live").(bool) && !d.HasChange("published") && d.Get("published_version_id").(string) != ""
 
 	questionGroups, qgErr := buildSurveyQuestionGroups(d)
 	if qgErr != nil {
@@ -361,34 +403,40 @@ func updateSurveyForm(ctx context.Context, d *schema.ResourceData, meta interfac
 			}
 		}
 
-		log.Printf("Updating Survey Form %s", name)
-		form, putResp, err := qualityAPI.PutQualityFormsSurvey(latestUnpublishedVersion, platformclientv2.Surveyform{
-			Name:           &name,
-			Disabled:       &disabled,
-			Language:       &language,
-			Header:         &header,
-			Footer:         &footer,
-			QuestionGroups: questionGroups,
-		})
+		form, getResp, err := qualityAPI.GetQualityFormsSurvey(latestUnpublishedVersion)
 		if err != nil {
-			return putResp, diag.Errorf("Failed to update survey form %s: %v", name, err)
+			return getResp, diag.Errorf("Failed to get survey form %s: %v", name, err)
+		}
+
+		if d.HasChanges("name", "disabled", "language", "header", "footer", "question_groups") {
+			log.Printf("Updating Survey Form %s", name)
+			var putResp *platformclientv2.APIResponse
+			form, putResp, err = qualityAPI.PutQualityFormsSurvey(latestUnpublishedVersion, platformclientv2.Surveyform{
+				Name:           &name,
+				Disabled:       &disabled,
+				Language:       &language,
+				Header:         &header,
+				Footer:         &footer,
+				QuestionGroups: questionGroups,
+			})
+			if err != nil {
+				return putResp, diag.Errorf("Failed to update survey form %s: %v", name, err)
+			}
+			log.Printf("Updated survey form %s %s", name, *form.Id)
 		}
-		log.Printf("Updated survey form %s %s", name, *form.Id)
 
 		// Set published property on survey form update.
 		if published {
-			_, postResp, err := qualityAPI.PostQualityPublishedformsSurveys(platformclientv2.Publishform{
-				Id:        form.Id,
-				Published: &published,
-			})
-			if err != nil {
-				return postResp, diag.Errorf("Failed to publish survey form %s", name)
+			if keepPreviousVersionLive {
+				log.Printf("Keeping the previous version of survey form %s live", name)
+			} else if postResp, diagErr := publishSurveyFormChanges(qualityAPI, form, d.HasChange("published")); diagErr != nil {
+				return postResp, diagErr
 			}
 		} else {
 			// If published property is reset to false, set the resource Id to the latest unpublished form
 			d.SetId(*form.Id)
 		}
-		return putResp, nil
+		return getResp, nil
 	})
 	if diagErr != nil {
 		return diagErr
@@ -396,6 +444,42 @@ func updateSurveyForm(ctx context.Context, d *schema.ResourceData, meta interfac
 	return readSurveyForm(ctx, d, meta)
 }
 
+// publishSurveyFormChanges publishes the form unless it matches the latest published version or force is set
+func publishSurveyFormChanges(qualityAPI *platformclientv2.QualityApi, form *platformclientv2.Surveyform, force bool) (*platformclientv2.APIResponse, diag.Diagnostics) {
+	name := valueOrEmpty(form.Name)
+
+	if !force {
+		publishedForm, resp, err := qualityAPI.GetQualityPublishedformsSurvey(*form.Id)
+		if err != nil && !isStatus404(resp) {
+			return resp, diag.Errorf("Failed to get the published version of survey form %s: %s", name, err)
+		}
+		if err == nil && !surveyFormChanged(form, publishedForm) {
+			log.Printf("Survey form %s matches its published version %s. Skipping publish.", name, valueOrEmpty(publishedForm.Id))
+			return resp, nil
+		}
+	}
+
+	published := true
+	_, postResp, err := qualityAPI.PostQualityPublishedformsSurveys(platformclientv2.Publishform{
+		Id:        form.Id,
+		Published: &published,
+	})
+	if err != nil {
+		return postResp, diag.Errorf("Failed to publish survey form %s", name)
+	}
+	return postResp, nil
+}
+
+// surveyFormChanged returns true if the content of a form differs from another version of it
+func surveyFormChanged(form, other *platformclientv2.Surveyform) bool {
+	return valueOrEmpty(form.Name) != valueOrEmpty(other.Name) ||
+		valueOrEmpty(form.Language) != valueOrEmpty(other.Language) ||
+		valueOrEmpty(form.Header) != valueOrEmpty(other.Header) ||
+		valueOrEmpty(form.Footer) != valueOrEmpty(other.Footer) ||
+		(form.Disabled != nil && *form.Disabled) != (other.Disabled != nil && *other.Disabled) ||
+		!reflect.DeepEqual(flattenSurveyQuestionGroups(form.QuestionGroups), flattenSurveyQuestionGroups(other.QuestionGroups))
+}
+
 func deleteSurveyForm(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 
@@ -160,9 +160,10 @@ func TestAccResourceSurveyFormBasic(t *testing.T) {
 			},
 			{
 				// Import/Read
-				ResourceName:      "genesyscloud_quality_forms_survey." + formResource1,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "genesyscloud_quality_forms_survey." + formResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_previous_version_live"},
 			},
 		},
 		CheckDestroy: testVerifySurveyFormDestroyed,
@@ -268,9 +269,10 @@ func TestAccResourceSurveyFormComplete(t *testing.T) {
 			},
 			{
 				// Import/Read
-				ResourceName:      "genesyscloud_quality_forms_survey." + formResource1,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "genesyscloud_quality_forms_survey." + formResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_previous_version_live"},
 			},
 		},
 		CheckDestroy: testVerifySurveyFormDestroyed,
@@ -339,9 +341,10 @@ func TestAccResourceSurveyFormRepublishing(t *testing.T) {
 			},
 			{
 				// Import/Read
-				ResourceName:      "genesyscloud_quality_forms_survey." + formResource1,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "genesyscloud_quality_forms_survey." + formResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_previous_version_live"},
 			},
 		},
 		CheckDestroy: testVerifySurveyFormDestroyed,
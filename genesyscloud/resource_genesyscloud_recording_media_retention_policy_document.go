@@ -0,0 +1,821 @@
+package genesyscloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+	"gopkg.in/yaml.v3"
+)
+
+// Types of the objects a policy document references by name
+const (
+	policyDocumentRefQueue          = "queue"
+	policyDocumentRefUser           = "user"
+	policyDocumentRefWrapupCode     = "wrap-up code"
+	policyDocumentRefLanguage       = "language"
+	policyDocumentRefEvaluationForm = "evaluation form"
+)
+
+var policyDocumentRefTypes = []string{
+	policyDocumentRefQueue,
+	policyDocumentRefUser,
+	policyDocumentRefWrapupCode,
+	policyDocumentRefLanguage,
+	policyDocumentRefEvaluationForm,
+}
+
+var policyDocumentDirections = []string{"INBOUND", "OUTBOUND"}
+
+// The YAML decoder names the Go types in its errors, which mean nothing to the author of the document
+var policyDocumentTypeNamePattern = regexp.MustCompile(` in type [\w.]+`)
+
+// mediaRetentionPolicyDocument is the YAML or JSON format of a media retention policy. Each media type has one rule.
+type mediaRetentionPolicyDocument struct {
+	Description string                            `yaml:"description"`
+	Order       int                               `yaml:"order"`
+	Enabled     *bool                             `yaml:"enabled"`
+	Call        *mediaRetentionPolicyDocumentRule `yaml:"call"`
+	Chat        *mediaRetentionPolicyDocumentRule `yaml:"chat"`
+	Email       *mediaRetentionPolicyDocumentRule `yaml:"email"`
+	Message     *mediaRetentionPolicyDocumentRule `yaml:"message"`
+}
+
+type mediaRetentionPolicyDocumentRule struct {
+	Conditions mediaRetentionPolicyDocumentConditions `yaml:"conditions"`
+	Actions    mediaRetentionPolicyDocumentActions    `yaml:"actions"`
+}
+
+type mediaRetentionPolicyDocumentConditions struct {
+	Queues      []string `yaml:"queues"`
+	Users       []string `yaml:"users"`
+	WrapupCodes []string `yaml:"wrapup_codes"`
+	Languages   []string `yaml:"languages"`
+	DateRanges  []string `yaml:"date_ranges"`
+	Directions  []string `yaml:"directions"`
+}
+
+type mediaRetentionPolicyDocumentActions struct {
+	RetainRecording  bool                                     `yaml:"retain_recording"`
+	DeleteRecording  bool                                     `yaml:"delete_recording"`
+	AlwaysDelete     bool                                     `yaml:"always_delete"`
+	ArchiveAfterDays int                                      `yaml:"archive_after_days"`
+	DeleteAfterDays  int                                      `yaml:"delete_after_days"`
+	Evaluations      []mediaRetentionPolicyDocumentEvaluation `yaml:"evaluations"`
+}
+
+type mediaRetentionPolicyDocumentEvaluation struct {
+	Form      string `yaml:"form"`
+	Evaluator string `yaml:"evaluator"`
+}
+
+type mediaRetentionPolicyDocumentMediaRule struct {
+	media string
+	rule  *mediaRetentionPolicyDocumentRule
+}
+
+// rules returns the rules set in the document by media type
+func (doc *mediaRetentionPolicyDocument) rules() []mediaRetentionPolicyDocumentMediaRule {
+	var rules []mediaRetentionPolicyDocumentMediaRule
+	for _, r := range []mediaRetentionPolicyDocumentMediaRule{
+		{"call", doc.Call},
+		{"chat", doc.Chat},
+		{"email", doc.Email},
+		{"message", doc.Message},
+	} {
+		if r.rule != nil {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+// mediaRetentionPolicyReference is the ID of an object referenced by name. Evaluation forms also have a context ID.
+type mediaRetentionPolicyReference struct {
+	Id        string
+	ContextId string
+}
+
+// mediaRetentionPolicyReferences holds the referenced objects by type and name
+type mediaRetentionPolicyReferences map[string]map[string]mediaRetentionPolicyReference
+
+func resourceMediaRetentionPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Media Retention Policy authored as a YAML or JSON document. " +
+			"The document has optional `description`, `order` and `enabled` (default true) keys and one rule per media type under the `call`, `chat`, `email` and `message` keys. " +
+			"Each rule has `conditions` (`queues`, `users`, `wrapup_codes`, `languages`, `date_ranges` and, for calls, `directions`) and `actions` " +
+			"(`retain_recording`, `delete_recording`, `always_delete`, `archive_after_days`, `delete_after_days` and `evaluations` with a `form` and an `evaluator`). " +
+			"Queues, wrap-up codes, languages and evaluation forms are referenced by name and users by email or name. " +
+			"The document is validated at plan time. The names it references are resolved when the policy is applied, so they can refer to objects created in the same apply. " +
+			"Changes made to the policy outside of Terraform are reverted on the next apply. Use `genesyscloud_recording_media_retention_policy` for settings the document does not support.",
+		CreateContext: createWithPooledClient(createMediaRetentionPolicyDocument),
+		ReadContext:   readWithPooledClient(readMediaRetentionPolicyDocument),
+		UpdateContext: updateWithPooledClient(updateMediaRetentionPolicyDocument),
+		DeleteContext: deleteWithPooledClient(deleteMediaRetentionPolicy),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeMediaRetentionPolicyDocumentDiff,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The policy name. Changing the name attribute will cause the policy to be dropped and recreated with a new ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"policy_document": {
+				Description:      "The policy as a YAML or JSON document, for example from `file()`, `templatefile()` or `yamlencode()`. Formatting changes that do not change the policy are ignored.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateMediaRetentionPolicyDocument,
+				DiffSuppressFunc: suppressEquivalentMediaRetentionPolicyDocuments,
+			},
+			"policy_hash": {
+				Description: "Hash of the policy as last applied. Empty when the policy was changed outside of Terraform or imported.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// parseMediaRetentionPolicyDocument decodes a YAML or JSON policy document. Unknown keys are rejected.
+func parseMediaRetentionPolicyDocument(document string) (*mediaRetentionPolicyDocument, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(document))
+	decoder.KnownFields(true)
+
+	var doc mediaRetentionPolicyDocument
+	if err := decoder.Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("the policy document is empty")
+		}
+		return nil, fmt.Errorf("failed to parse the policy document: %s", policyDocumentTypeNamePattern.ReplaceAllString(err.Error(), ""))
+	}
+	return &doc, nil
+}
+
+// mediaRetentionPolicyDocumentProblems returns the problems of a parsed policy document, each prefixed with the path of the key it concerns
+func mediaRetentionPolicyDocumentProblems(doc *mediaRetentionPolicyDocument) []string {
+	var problems []string
+	addProblem := func(path string, format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)))
+	}
+	checkNames := func(path string, names []string) {
+		seen := make(map[string]bool)
+		for i, name := range names {
+			if strings.TrimSpace(name) == "" {
+				addProblem(fmt.Sprintf("%s[%d]", path, i), "must not be empty")
+			} else if seen[name] {
+				addProblem(fmt.Sprintf("%s[%d]", path, i), "%s is listed more than once", name)
+			}
+			seen[name] = true
+		}
+	}
+
+	if doc.Order < 0 {
+		addProblem("order", "must not be negative")
+	}
+
+	rules := doc.rules()
+	if len(rules) == 0 {
+		problems = append(problems, "the policy must have a rule for at least one of call, chat, email or message")
+	}
+
+	for _, r := range rules {
+		conditions := r.rule.Conditions
+		conditionsPath := r.media + ".conditions"
+		checkNames(conditionsPath+".queues", conditions.Queues)
+		checkNames(conditionsPath+".users", conditions.Users)
+		checkNames(conditionsPath+".wrapup_codes", conditions.WrapupCodes)
+		checkNames(conditionsPath+".languages", conditions.Languages)
+
+		for i, dateRange := range conditions.DateRanges {
+			if err := validatePolicyDocumentDateRange(dateRange); err != nil {
+				addProblem(fmt.Sprintf("%s.date_ranges[%d]", conditionsPath, i), "%s", err)
+			}
+		}
+
+		if r.media != "call" && len(conditions.Directions) > 0 {
+			addProblem(conditionsPath+".directions", "is only supported for call")
+		}
+		for i, direction := range conditions.Directions {
+			if !StringInSlice(direction, policyDocumentDirections) {
+				addProblem(fmt.Sprintf("%s.directions[%d]", conditionsPath, i), "must be one of %s, got %s", strings.Join(policyDocumentDirections, ", "), direction)
+			}
+		}
+
+		actions := r.rule.Actions
+		actionsPath := r.media + ".actions"
+		if !actions.RetainRecording && !actions.DeleteRecording && !actions.AlwaysDelete && len(actions.Evaluations) == 0 {
+			addProblem(actionsPath, "at least one of retain_recording, delete_recording, always_delete or evaluations must be set")
+		}
+		if actions.RetainRecording && actions.DeleteRecording {
+			addProblem(actionsPath, "retain_recording and delete_recording cannot both be true")
+		}
+		if actions.RetainRecording && actions.AlwaysDelete {
+			addProblem(actionsPath, "retain_recording and always_delete cannot both be true")
+		}
+		if (actions.DeleteRecording || actions.AlwaysDelete) && len(actions.Evaluations) > 0 {
+			addProblem(actionsPath+".evaluations", "cannot be assigned when the recording is deleted by delete_recording or always_delete")
+		}
+
+		if actions.ArchiveAfterDays < 0 {
+			addProblem(actionsPath+".archive_after_days", "must not be negative")
+		}
+		if actions.DeleteAfterDays < 0 {
+			addProblem(actionsPath+".delete_after_days", "must not be negative")
+		}
+		if (actions.ArchiveAfterDays > 0 || actions.DeleteAfterDays > 0) && !actions.RetainRecording {
+			addProblem(actionsPath, "archive_after_days and delete_after_days require retain_recording to be true")
+		}
+		if actions.ArchiveAfterDays > 0 && actions.DeleteAfterDays > 0 && actions.ArchiveAfterDays >= actions.DeleteAfterDays {
+			addProblem(actionsPath+".archive_after_days", "must be less than delete_after_days (%d)", actions.DeleteAfterDays)
+		}
+
+		for i, evaluation := range actions.Evaluations {
+			evaluationPath := fmt.Sprintf("%s.evaluations[%d]", actionsPath, i)
+			if strings.TrimSpace(evaluation.Form) == "" {
+				addProblem(evaluationPath+".form", "is required")
+			}
+			if strings.TrimSpace(evaluation.Evaluator) == "" {
+				addProblem(evaluationPath+".evaluator", "is required")
+			}
+		}
+	}
+
+	return problems
+}
+
+// validatePolicyDocumentDateRange checks that a date range is an ISO-8601 interval of two date times, the first before the second
+func validatePolicyDocumentDateRange(dateRange string) error {
+	dates := strings.Split(dateRange, "/")
+	if len(dates) != 2 {
+		return fmt.Errorf("%s must be an ISO-8601 interval of two date times separated by /", dateRange)
+	}
+	start, err := time.Parse(time.RFC3339, dates[0])
+	if err != nil {
+		return fmt.Errorf("%s is not an ISO-8601 date time", dates[0])
+	}
+	end, err := time.Parse(time.RFC3339, dates[1])
+	if err != nil {
+		return fmt.Errorf("%s is not an ISO-8601 date time", dates[1])
+	}
+	if !start.Before(end) {
+		return fmt.Errorf("the start of %s must be before its end", dateRange)
+	}
+	return nil
+}
+
+func validateMediaRetentionPolicyDocument(value interface{}, path cty.Path) diag.Diagnostics {
+	document, ok := value.(string)
+	if !ok {
+		return diag.Errorf("Policy document %v is not a string", value)
+	}
+	doc, err := parseMediaRetentionPolicyDocument(document)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid media retention policy document",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	var diags diag.Diagnostics
+	for _, problem := range mediaRetentionPolicyDocumentProblems(doc) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid media retention policy document",
+			Detail:        problem,
+			AttributePath: path,
+		})
+	}
+	return diags
+}
+
+func suppressEquivalentMediaRetentionPolicyDocuments(_, old, new string, _ *schema.ResourceData) bool {
+	oldDoc, err := parseMediaRetentionPolicyDocument(old)
+	if err != nil {
+		return false
+	}
+	newDoc, err := parseMediaRetentionPolicyDocument(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldDoc, newDoc)
+}
+
+// customizeMediaRetentionPolicyDocumentDiff plans an update when the policy was changed outside of Terraform or imported,
+// so the document is applied again
+func customizeMediaRetentionPolicyDocumentDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChange("policy_document") || diff.Get("policy_hash").(string) == "" {
+		return diff.SetNewComputed("policy_hash")
+	}
+	return nil
+}
+
+// mediaRetentionPolicyDocumentNames returns the names referenced by a policy document by reference type
+func mediaRetentionPolicyDocumentNames(doc *mediaRetentionPolicyDocument) map[string][]string {
+	names := make(map[string][]string)
+	addNames := func(refType string, refNames ...string) {
+		for _, name := range refNames {
+			if !StringInSlice(name, names[refType]) {
+				names[refType] = append(names[refType], name)
+			}
+		}
+	}
+	for _, r := range doc.rules() {
+		addNames(policyDocumentRefQueue, r.rule.Conditions.Queues...)
+		addNames(policyDocumentRefUser, r.rule.Conditions.Users...)
+		addNames(policyDocumentRefWrapupCode, r.rule.Conditions.WrapupCodes...)
+		addNames(policyDocumentRefLanguage, r.rule.Conditions.Languages...)
+		for _, evaluation := range r.rule.Actions.Evaluations {
+			addNames(policyDocumentRefEvaluationForm, evaluation.Form)
+			addNames(policyDocumentRefUser, evaluation.Evaluator)
+		}
+	}
+	return names
+}
+
+// buildMediaRetentionPolicyDocumentMediaPolicies builds the media policies of a document with the referenced objects resolved in refs
+func buildMediaRetentionPolicyDocumentMediaPolicies(doc *mediaRetentionPolicyDocument, refs mediaRetentionPolicyReferences) *platformclientv2.Mediapolicies {
+	mediaPolicies := &platformclientv2.Mediapolicies{}
+	if doc.Call != nil {
+		conditions := doc.Call.Conditions
+		mediaPolicies.CallPolicy = &platformclientv2.Callmediapolicy{
+			Actions: buildMediaRetentionPolicyDocumentActions(doc.Call.Actions, refs),
+			Conditions: &platformclientv2.Callmediapolicyconditions{
+				ForUsers:    buildPolicyDocumentUsers(refs, conditions.Users),
+				DateRanges:  buildPolicyDocumentStrings(conditions.DateRanges),
+				ForQueues:   buildPolicyDocumentQueues(refs, conditions.Queues),
+				WrapupCodes: buildPolicyDocumentWrapupCodes(refs, conditions.WrapupCodes),
+				Languages:   buildPolicyDocumentLanguages(refs, conditions.Languages),
+				Directions:  buildPolicyDocumentStrings(conditions.Directions),
+			},
+		}
+	}
+	if doc.Chat != nil {
+		conditions := doc.Chat.Conditions
+		mediaPolicies.ChatPolicy = &platformclientv2.Chatmediapolicy{
+			Actions: buildMediaRetentionPolicyDocumentActions(doc.Chat.Actions, refs),
+			Conditions: &platformclientv2.Chatmediapolicyconditions{
+				ForUsers:    buildPolicyDocumentUsers(refs, conditions.Users),
+				DateRanges:  buildPolicyDocumentStrings(conditions.DateRanges),
+				ForQueues:   buildPolicyDocumentQueues(refs, conditions.Queues),
+				WrapupCodes: buildPolicyDocumentWrapupCodes(refs, conditions.WrapupCodes),
+				Languages:   buildPolicyDocumentLanguages(refs, conditions.Languages),
+			},
+		}
+	}
+	if doc.Email != nil {
+		conditions := doc.Email.Conditions
+		mediaPolicies.EmailPolicy = &platformclientv2.Emailmediapolicy{
+			Actions: buildMediaRetentionPolicyDocumentActions(doc.Email.Actions, refs),
+			Conditions: &platformclientv2.Emailmediapolicyconditions{
+				ForUsers:    buildPolicyDocumentUsers(refs, conditions.Users),
+				DateRanges:  buildPolicyDocumentStrings(conditions.DateRanges),
+				ForQueues:   buildPolicyDocumentQueues(refs, conditions.Queues),
+				WrapupCodes: buildPolicyDocumentWrapupCodes(refs, conditions.WrapupCodes),
+				Languages:   buildPolicyDocumentLanguages(refs, conditions.Languages),
+			},
+		}
+	}
+	if doc.Message != nil {
+		conditions := doc.Message.Conditions
+		mediaPolicies.MessagePolicy = &platformclientv2.Messagemediapolicy{
+			Actions: buildMediaRetentionPolicyDocumentActions(doc.Message.Actions, refs),
+			Conditions: &platformclientv2.Messagemediapolicyconditions{
+				ForUsers:    buildPolicyDocumentUsers(refs, conditions.Users),
+				DateRanges:  buildPolicyDocumentStrings(conditions.DateRanges),
+				ForQueues:   buildPolicyDocumentQueues(refs, conditions.Queues),
+				WrapupCodes: buildPolicyDocumentWrapupCodes(refs, conditions.WrapupCodes),
+				Languages:   buildPolicyDocumentLanguages(refs, conditions.Languages),
+			},
+		}
+	}
+	return mediaPolicies
+}
+
+func buildMediaRetentionPolicyDocumentActions(actions mediaRetentionPolicyDocumentActions, refs mediaRetentionPolicyReferences) *platformclientv2.Policyactions {
+	policyActions := &platformclientv2.Policyactions{
+		RetainRecording: &actions.RetainRecording,
+		DeleteRecording: &actions.DeleteRecording,
+		AlwaysDelete:    &actions.AlwaysDelete,
+	}
+
+	if actions.ArchiveAfterDays > 0 || actions.DeleteAfterDays > 0 {
+		retentionDuration := &platformclientv2.Retentionduration{}
+		if actions.ArchiveAfterDays > 0 {
+			storageMedium := "CLOUDARCHIVE"
+			retentionDuration.ArchiveRetention = &platformclientv2.Archiveretention{
+				Days:          &actions.ArchiveAfterDays,
+				StorageMedium: &storageMedium,
+			}
+		}
+		if actions.DeleteAfterDays > 0 {
+			retentionDuration.DeleteRetention = &platformclientv2.Deleteretention{
+				Days: &actions.DeleteAfterDays,
+			}
+		}
+		policyActions.RetentionDuration = retentionDuration
+	}
+
+	if len(actions.Evaluations) > 0 {
+		assignments := make([]platformclientv2.Evaluationassignment, 0, len(actions.Evaluations))
+		for _, evaluation := range actions.Evaluations {
+			form := refs[policyDocumentRefEvaluationForm][evaluation.Form]
+			evaluator := refs[policyDocumentRefUser][evaluation.Evaluator]
+			assignments = append(assignments, platformclientv2.Evaluationassignment{
+				EvaluationForm: &platformclientv2.Evaluationform{Id: &form.Id, ContextId: &form.ContextId},
+				User:           &platformclientv2.User{Id: &evaluator.Id},
+			})
+		}
+		policyActions.AssignEvaluations = &assignments
+	}
+
+	return policyActions
+}
+
+func buildPolicyDocumentStrings(values []string) *[]string {
+	strs := append(make([]string, 0, len(values)), values...)
+	return &strs
+}
+
+func buildPolicyDocumentUsers(refs mediaRetentionPolicyReferences, names []string) *[]platformclientv2.User {
+	users := make([]platformclientv2.User, 0, len(names))
+	for _, name := range names {
+		id := refs[policyDocumentRefUser][name].Id
+		users = append(users, platformclientv2.User{Id: &id})
+	}
+	return &users
+}
+
+func buildPolicyDocumentQueues(refs mediaRetentionPolicyReferences, names []string) *[]platformclientv2.Queue {
+	queues := make([]platformclientv2.Queue, 0, len(names))
+	for _, name := range names {
+		id := refs[policyDocumentRefQueue][name].Id
+		queues = append(queues, platformclientv2.Queue{Id: &id})
+	}
+	return &queues
+}
+
+func buildPolicyDocumentWrapupCodes(refs mediaRetentionPolicyReferences, names []string) *[]platformclientv2.Wrapupcode {
+	wrapupCodes := make([]platformclientv2.Wrapupcode, 0, len(names))
+	for _, name := range names {
+		id := refs[policyDocumentRefWrapupCode][name].Id
+		wrapupCodes = append(wrapupCodes, platformclientv2.Wrapupcode{Id: &id})
+	}
+	return &wrapupCodes
+}
+
+func buildPolicyDocumentLanguages(refs mediaRetentionPolicyReferences, names []string) *[]platformclientv2.Language {
+	languages := make([]platformclientv2.Language, 0, len(names))
+	for _, name := range names {
+		id := refs[policyDocumentRefLanguage][name].Id
+		languages = append(languages, platformclientv2.Language{Id: &id})
+	}
+	return &languages
+}
+
+// resolveMediaRetentionPolicyDocumentNames looks up the objects referenced by name in a policy document.
+// All names that are not found are reported together.
+func resolveMediaRetentionPolicyDocumentNames(ctx context.Context, sdkConfig *platformclientv2.Configuration, doc *mediaRetentionPolicyDocument) (mediaRetentionPolicyReferences, diag.Diagnostics) {
+	lookups := policyDocumentLookups(sdkConfig)
+
+	refs := make(mediaRetentionPolicyReferences)
+	for _, refType := range policyDocumentRefTypes {
+		refs[refType] = make(map[string]mediaRetentionPolicyReference)
+	}
+	names := mediaRetentionPolicyDocumentNames(doc)
+	// Retry in case an object was only just created and is not yet indexed
+	diagErr := withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var problems []string
+		for _, refType := range policyDocumentRefTypes {
+			for _, name := range names[refType] {
+				if _, resolved := refs[refType][name]; resolved {
+					continue
+				}
+				ref, err := lookups[refType](name)
+				if err != nil {
+					return resource.NonRetryableError(fmt.Errorf("Failed to look up %s %s referenced by the policy document: %s", refType, name, err))
+				}
+				if ref == nil {
+					problems = append(problems, fmt.Sprintf("no %s found with name %s", refType, name))
+					continue
+				}
+				refs[refType][name] = *ref
+			}
+		}
+		if len(problems) > 0 {
+			return resource.RetryableError(fmt.Errorf("Invalid references in policy_document: %s", strings.Join(problems, "; ")))
+		}
+		return nil
+	})
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	return refs, nil
+}
+
+// policyDocumentLookups returns the functions looking up the objects referenced by a policy document by reference type
+func policyDocumentLookups(sdkConfig *platformclientv2.Configuration) map[string]func(name string) (*mediaRetentionPolicyReference, error) {
+	return map[string]func(name string) (*mediaRetentionPolicyReference, error){
+		policyDocumentRefQueue:          getPolicyDocumentQueue(platformclientv2.NewRoutingApiWithConfig(sdkConfig)),
+		policyDocumentRefUser:           getPolicyDocumentUser(platformclientv2.NewUsersApiWithConfig(sdkConfig)),
+		policyDocumentRefWrapupCode:     getPolicyDocumentWrapupCode(platformclientv2.NewRoutingApiWithConfig(sdkConfig)),
+		policyDocumentRefLanguage:       getPolicyDocumentLanguage(platformclientv2.NewRoutingApiWithConfig(sdkConfig)),
+		policyDocumentRefEvaluationForm: getPolicyDocumentEvaluationForm(platformclientv2.NewQualityApiWithConfig(sdkConfig)),
+	}
+}
+
+func getPolicyDocumentQueue(routingAPI *platformclientv2.RoutingApi) func(name string) (*mediaRetentionPolicyReference, error) {
+	return func(name string) (*mediaRetentionPolicyReference, error) {
+		for pageNum := 1; ; pageNum++ {
+			const pageSize = 100
+			queues, _, getErr := routingAPI.GetRoutingQueues(pageNum, pageSize, name, "", nil, nil, nil, false)
+			if getErr != nil {
+				return nil, getErr
+			}
+			if queues.Entities == nil || len(*queues.Entities) == 0 {
+				return nil, nil
+			}
+			for _, queue := range *queues.Entities {
+				if queue.Name != nil && *queue.Name == name {
+					return &mediaRetentionPolicyReference{Id: *queue.Id}, nil
+				}
+			}
+		}
+	}
+}
+
+func getPolicyDocumentWrapupCode(routingAPI *platformclientv2.RoutingApi) func(name string) (*mediaRetentionPolicyReference, error) {
+	return func(name string) (*mediaRetentionPolicyReference, error) {
+		for pageNum := 1; ; pageNum++ {
+			const pageSize = 100
+			wrapupCodes, _, getErr := routingAPI.GetRoutingWrapupcodes(pageSize, pageNum, "", "", []string{}, name, []string{})
+			if getErr != nil {
+				return nil, getErr
+			}
+			if wrapupCodes.Entities == nil || len(*wrapupCodes.Entities) == 0 {
+				return nil, nil
+			}
+			for _, wrapupCode := range *wrapupCodes.Entities {
+				if wrapupCode.Name != nil && *wrapupCode.Name == name {
+					return &mediaRetentionPolicyReference{Id: *wrapupCode.Id}, nil
+				}
+			}
+		}
+	}
+}
+
+func getPolicyDocumentLanguage(routingAPI *platformclientv2.RoutingApi) func(name string) (*mediaRetentionPolicyReference, error) {
+	return func(name string) (*mediaRetentionPolicyReference, error) {
+		for pageNum := 1; ; pageNum++ {
+			const pageSize = 100
+			languages, _, getErr := routingAPI.GetRoutingLanguages(pageSize, pageNum, "", name, nil)
+			if getErr != nil {
+				return nil, getErr
+			}
+			if languages.Entities == nil || len(*languages.Entities) == 0 {
+				return nil, nil
+			}
+			for _, language := range *languages.Entities {
+				if language.Name != nil && *language.Name == name {
+					return &mediaRetentionPolicyReference{Id: *language.Id}, nil
+				}
+			}
+		}
+	}
+}
+
+func getPolicyDocumentEvaluationForm(qualityAPI *platformclientv2.QualityApi) func(name string) (*mediaRetentionPolicyReference, error) {
+	return func(name string) (*mediaRetentionPolicyReference, error) {
+		for pageNum := 1; ; pageNum++ {
+			const pageSize = 100
+			forms, _, getErr := qualityAPI.GetQualityFormsEvaluations(pageSize, pageNum, "", "", "", "", name, "")
+			if getErr != nil {
+				return nil, getErr
+			}
+			if forms.Entities == nil || len(*forms.Entities) == 0 {
+				return nil, nil
+			}
+			for _, form := range *forms.Entities {
+				if form.Name != nil && *form.Name == name {
+					return &mediaRetentionPolicyReference{Id: *form.Id, ContextId: valueOrEmpty(form.ContextId)}, nil
+				}
+			}
+		}
+	}
+}
+
+// getPolicyDocumentUser looks up a user by email when the name contains an @, otherwise by name. A name must match exactly one user.
+func getPolicyDocumentUser(usersAPI *platformclientv2.UsersApi) func(name string) (*mediaRetentionPolicyReference, error) {
+	return func(name string) (*mediaRetentionPolicyReference, error) {
+		exactSearchType := "EXACT"
+		field := "name"
+		if strings.Contains(name, "@") {
+			field = "email"
+		}
+		users, _, getErr := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
+			Query: &[]platformclientv2.Usersearchcriteria{{
+				VarType: &exactSearchType,
+				Fields:  &[]string{field},
+				Value:   &name,
+			}},
+		})
+		if getErr != nil {
+			return nil, getErr
+		}
+		if users.Results == nil || len(*users.Results) == 0 {
+			return nil, nil
+		}
+		if len(*users.Results) > 1 {
+			return nil, fmt.Errorf("%d users match %s. Reference the user by email instead", len(*users.Results), name)
+		}
+		return &mediaRetentionPolicyReference{Id: *(*users.Results)[0].Id}, nil
+	}
+}
+
+// buildMediaRetentionPolicyDocument parses and validates the policy document of the resource and builds its media policies
+func buildMediaRetentionPolicyDocument(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) (*mediaRetentionPolicyDocument, *platformclientv2.Mediapolicies, diag.Diagnostics) {
+	// The document is validated again as values that are only known after apply are not validated at plan time
+	if diagErr := validateMediaRetentionPolicyDocument(d.Get("policy_document"), cty.GetAttrPath("policy_document")); diagErr.HasError() {
+		return nil, nil, diagErr
+	}
+	doc, err := parseMediaRetentionPolicyDocument(d.Get("policy_document").(string))
+	if err != nil {
+		return nil, nil, diag.FromErr(err)
+	}
+
+	refs, diagErr := resolveMediaRetentionPolicyDocumentNames(ctx, sdkConfig, doc)
+	if diagErr != nil {
+		return nil, nil, diagErr
+	}
+	return doc, buildMediaRetentionPolicyDocumentMediaPolicies(doc, refs), nil
+}
+
+func createMediaRetentionPolicyDocument(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	recordingAPI := platformclientv2.NewRecordingApiWithConfig(sdkConfig)
+
+	doc, mediaPolicies, diagErr := buildMediaRetentionPolicyDocument(ctx, d, sdkConfig)
+	if diagErr != nil {
+		return diagErr
+	}
+	enabled := doc.Enabled == nil || *doc.Enabled
+
+	log.Printf("Creating media retention policy %s from a policy document", name)
+	policy, _, err := recordingAPI.PostRecordingMediaretentionpolicies(platformclientv2.Policycreate{
+		Name:          &name,
+		Order:         &doc.Order,
+		Description:   &doc.Description,
+		Enabled:       &enabled,
+		MediaPolicies: mediaPolicies,
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create media retention policy %s: %s", name, err)
+	}
+
+	d.SetId(*policy.Id)
+	log.Printf("Created media retention policy %s %s", name, *policy.Id)
+	return readAppliedMediaRetentionPolicyDocument(ctx, d, meta)
+}
+
+func updateMediaRetentionPolicyDocument(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	recordingAPI := platformclientv2.NewRecordingApiWithConfig(sdkConfig)
+
+	doc, mediaPolicies, diagErr := buildMediaRetentionPolicyDocument(ctx, d, sdkConfig)
+	if diagErr != nil {
+		return diagErr
+	}
+	enabled := doc.Enabled == nil || *doc.Enabled
+
+	log.Printf("Updating media retention policy %s from a policy document", name)
+	policy, _, err := recordingAPI.PutRecordingMediaretentionpolicy(d.Id(), platformclientv2.Policy{
+		Name:          &name,
+		Order:         &doc.Order,
+		Description:   &doc.Description,
+		Enabled:       &enabled,
+		MediaPolicies: mediaPolicies,
+	})
+	if err != nil {
+		return diag.Errorf("Failed to update media retention policy %s: %s", name, err)
+	}
+
+	log.Printf("Updated media retention policy %s %s", name, *policy.Id)
+	return readAppliedMediaRetentionPolicyDocument(ctx, d, meta)
+}
+
+func readMediaRetentionPolicyDocument(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readMediaRetentionPolicyDocumentState(ctx, d, meta, false)
+}
+
+// readAppliedMediaRetentionPolicyDocument reads the policy after the document was applied and stores its hash
+func readAppliedMediaRetentionPolicyDocument(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readMediaRetentionPolicyDocumentState(ctx, d, meta, true)
+}
+
+func readMediaRetentionPolicyDocumentState(ctx context.Context, d *schema.ResourceData, meta interface{}, applied bool) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	recordingAPI := platformclientv2.NewRecordingApiWithConfig(sdkConfig)
+
+	log.Printf("Reading media retention policy %s", d.Id())
+
+	return withRetriesForRead(ctx, d, func() *resource.RetryError {
+		policy, resp, getErr := recordingAPI.GetRecordingMediaretentionpolicy(d.Id())
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("failed to read media retention policy %s: %s", d.Id(), getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("failed to read media retention policy %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceMediaRetentionPolicyDocument())
+		if policy.Name != nil {
+			d.Set("name", *policy.Name)
+		}
+
+		policyHash, err := hashMediaRetentionPolicy(policy)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("failed to hash media retention policy %s: %s", d.Id(), err))
+		}
+		if applied || d.Get("policy_hash").(string) == policyHash {
+			d.Set("policy_hash", policyHash)
+		} else {
+			// An empty hash plans an update that applies the document again
+			log.Printf("Media retention policy %s does not match its policy document", d.Id())
+			d.Set("policy_hash", "")
+		}
+
+		log.Printf("Read media retention policy %s %s", d.Id(), *policy.Name)
+		return cc.CheckState()
+	})
+}
+
+// hashMediaRetentionPolicy hashes the settings of a policy that are managed by a policy document. Referenced objects are
+// reduced to their IDs so renaming a queue or user is not reported as a change of the policy.
+func hashMediaRetentionPolicy(policy *platformclientv2.Policy) (string, error) {
+	policyJson, err := json.Marshal(map[string]interface{}{
+		"description":   policy.Description,
+		"order":         policy.Order,
+		"enabled":       policy.Enabled,
+		"mediaPolicies": policy.MediaPolicies,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var policyMap interface{}
+	if err := json.Unmarshal(policyJson, &policyMap); err != nil {
+		return "", err
+	}
+	normalizedJson, err := json.Marshal(reduceEntitiesToIds(policyMap))
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(normalizedJson)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// reduceEntitiesToIds replaces every object with an id by an object with only that id
+func reduceEntitiesToIds(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if id, ok := v["id"]; ok {
+			return map[string]interface{}{"id": id}
+		}
+		for key, elem := range v {
+			v[key] = reduceEntitiesToIds(elem)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = reduceEntitiesToIds(elem)
+		}
+	}
+	return value
+}
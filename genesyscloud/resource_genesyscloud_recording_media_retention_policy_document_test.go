@@ -0,0 +1,285 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMediaRetentionPolicyDocument = `
+description: Keep support calls
+order: 1
+call:
+  conditions:
+    queues: [Support]
+    directions: [INBOUND]
+    date_ranges: ["2024-01-01T00:00:00Z/2024-12-31T23:59:59Z"]
+  actions:
+    retain_recording: true
+    archive_after_days: 30
+    delete_after_days: 365
+    evaluations:
+      - form: Call Quality
+        evaluator: jane@example.com
+chat:
+  conditions:
+    users: [jane@example.com]
+  actions:
+    delete_recording: true
+`
+
+func TestParseMediaRetentionPolicyDocument(t *testing.T) {
+	doc, err := parseMediaRetentionPolicyDocument(testMediaRetentionPolicyDocument)
+	assert.NoError(t, err)
+	assert.Equal(t, "Keep support calls", doc.Description)
+	assert.Nil(t, doc.Enabled)
+	assert.Equal(t, []string{"Support"}, doc.Call.Conditions.Queues)
+	assert.Equal(t, 30, doc.Call.Actions.ArchiveAfterDays)
+	assert.Nil(t, doc.Email)
+	assert.Len(t, doc.rules(), 2)
+
+	// JSON documents, such as the output of jsonencode, are YAML documents too
+	jsonDoc, err := parseMediaRetentionPolicyDocument("{\n\t\"order\": 2,\n\t\"email\": {\"actions\": {\"always_delete\": true}}\n}")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, jsonDoc.Order)
+	assert.True(t, jsonDoc.Email.Actions.AlwaysDelete)
+
+	_, err = parseMediaRetentionPolicyDocument("call:\n  actions:\n    retain_recordings: true\n")
+	assert.ErrorContains(t, err, "field retain_recordings not found")
+	assert.NotContains(t, err.Error(), "genesyscloud.")
+
+	_, err = parseMediaRetentionPolicyDocument("  \n")
+	assert.EqualError(t, err, "the policy document is empty")
+}
+
+func TestMediaRetentionPolicyDocumentProblems(t *testing.T) {
+	doc, err := parseMediaRetentionPolicyDocument(testMediaRetentionPolicyDocument)
+	assert.NoError(t, err)
+	assert.Empty(t, mediaRetentionPolicyDocumentProblems(doc))
+
+	doc, err = parseMediaRetentionPolicyDocument(`
+order: -1
+chat:
+  conditions:
+    queues: [Support, Support]
+    directions: [INBOUND]
+    date_ranges: ["2024-12-31T00:00:00Z/2024-01-01T00:00:00Z"]
+  actions:
+    retain_recording: true
+    always_delete: true
+    archive_after_days: 30
+    delete_after_days: 30
+    evaluations:
+      - form: Chat Quality
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"order: must not be negative",
+		"chat.conditions.queues[1]: Support is listed more than once",
+		"chat.conditions.date_ranges[0]: the start of 2024-12-31T00:00:00Z/2024-01-01T00:00:00Z must be before its end",
+		"chat.conditions.directions: is only supported for call",
+		"chat.actions: retain_recording and always_delete cannot both be true",
+		"chat.actions.evaluations: cannot be assigned when the recording is deleted by delete_recording or always_delete",
+		"chat.actions.archive_after_days: must be less than delete_after_days (30)",
+		"chat.actions.evaluations[0].evaluator: is required",
+	}, mediaRetentionPolicyDocumentProblems(doc))
+
+	doc, err = parseMediaRetentionPolicyDocument("description: No rules\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"the policy must have a rule for at least one of call, chat, email or message"}, mediaRetentionPolicyDocumentProblems(doc))
+
+	diags := validateMediaRetentionPolicyDocument("message:\n  actions:\n    delete_after_days: 10\n", cty.GetAttrPath("policy_document"))
+	assert.Len(t, diags, 2)
+	assert.Equal(t, "message.actions: at least one of retain_recording, delete_recording, always_delete or evaluations must be set", diags[0].Detail)
+	assert.Equal(t, "message.actions: archive_after_days and delete_after_days require retain_recording to be true", diags[1].Detail)
+}
+
+func TestBuildMediaRetentionPolicyDocumentMediaPolicies(t *testing.T) {
+	doc, err := parseMediaRetentionPolicyDocument(testMediaRetentionPolicyDocument)
+	assert.NoError(t, err)
+
+	names := mediaRetentionPolicyDocumentNames(doc)
+	assert.Equal(t, []string{"Support"}, names[policyDocumentRefQueue])
+	assert.Equal(t, []string{"jane@example.com"}, names[policyDocumentRefUser])
+	assert.Equal(t, []string{"Call Quality"}, names[policyDocumentRefEvaluationForm])
+	assert.Empty(t, names[policyDocumentRefWrapupCode])
+
+	refs := mediaRetentionPolicyReferences{
+		policyDocumentRefQueue:          {"Support": {Id: "queue-id"}},
+		policyDocumentRefUser:           {"jane@example.com": {Id: "user-id"}},
+		policyDocumentRefEvaluationForm: {"Call Quality": {Id: "form-id", ContextId: "form-context-id"}},
+	}
+	mediaPolicies := buildMediaRetentionPolicyDocumentMediaPolicies(doc, refs)
+	assert.Nil(t, mediaPolicies.EmailPolicy)
+	assert.Nil(t, mediaPolicies.MessagePolicy)
+
+	callPolicy := mediaPolicies.CallPolicy
+	assert.Equal(t, "queue-id", *(*callPolicy.Conditions.ForQueues)[0].Id)
+	assert.Equal(t, []string{"INBOUND"}, *callPolicy.Conditions.Directions)
+	assert.Empty(t, *callPolicy.Conditions.WrapupCodes)
+	assert.True(t, *callPolicy.Actions.RetainRecording)
+	assert.Equal(t, "CLOUDARCHIVE", *callPolicy.Actions.RetentionDuration.ArchiveRetention.StorageMedium)
+	assert.Equal(t, 30, *callPolicy.Actions.RetentionDuration.ArchiveRetention.Days)
+	assert.Equal(t, 365, *callPolicy.Actions.RetentionDuration.DeleteRetention.Days)
+	assignment := (*callPolicy.Actions.AssignEvaluations)[0]
+	assert.Equal(t, "form-id", *assignment.EvaluationForm.Id)
+	assert.Equal(t, "form-context-id", *assignment.EvaluationForm.ContextId)
+	assert.Equal(t, "user-id", *assignment.User.Id)
+
+	chatPolicy := mediaPolicies.ChatPolicy
+	assert.Equal(t, "user-id", *(*chatPolicy.Conditions.ForUsers)[0].Id)
+	assert.True(t, *chatPolicy.Actions.DeleteRecording)
+	assert.Nil(t, chatPolicy.Actions.RetentionDuration)
+	assert.Nil(t, chatPolicy.Actions.AssignEvaluations)
+}
+
+func TestHashMediaRetentionPolicy(t *testing.T) {
+	policy := func(queueName string, deleteDays int) *platformclientv2.Policy {
+		return &platformclientv2.Policy{
+			Id:          platformclientv2.String("policy-id"),
+			Description: platformclientv2.String("Keep support calls"),
+			Order:       platformclientv2.Int(1),
+			Enabled:     platformclientv2.Bool(true),
+			MediaPolicies: &platformclientv2.Mediapolicies{
+				CallPolicy: &platformclientv2.Callmediapolicy{
+					Conditions: &platformclientv2.Callmediapolicyconditions{
+						ForQueues: &[]platformclientv2.Queue{{Id: platformclientv2.String("queue-id"), Name: platformclientv2.String(queueName)}},
+					},
+					Actions: &platformclientv2.Policyactions{
+						RetainRecording: platformclientv2.Bool(true),
+						RetentionDuration: &platformclientv2.Retentionduration{
+							DeleteRetention: &platformclientv2.Deleteretention{Days: platformclientv2.Int(deleteDays)},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	hash, err := hashMediaRetentionPolicy(policy("Support", 365))
+	assert.NoError(t, err)
+
+	// Renaming a referenced queue does not change the policy
+	renamedHash, err := hashMediaRetentionPolicy(policy("Customer Support", 365))
+	assert.NoError(t, err)
+	assert.Equal(t, hash, renamedHash)
+
+	changedHash, err := hashMediaRetentionPolicy(policy("Support", 30))
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, changedHash)
+}
+
+func TestAccResourceMediaRetentionPolicyDocument(t *testing.T) {
+	var (
+		policyResource   = "policy-document"
+		policyName       = "terraform-policy-document-" + uuid.NewString()
+		queueResource    = "policy-document-queue"
+		queueName        = "terraform-policy-document-queue-" + uuid.NewString()
+		wrapupResource   = "policy-document-wrapupcode"
+		wrapupName       = "terraform-policy-document-wrapupcode-" + uuid.NewString()
+		policyDocument   = generateMediaRetentionPolicyDocument(queueResource, wrapupResource, 30)
+		updatedDocument  = generateMediaRetentionPolicyDocument(queueResource, wrapupResource, 60)
+		dependencyConfig = generateRoutingQueueResourceBasic(queueResource, queueName) + generateRoutingWrapupcodeResource(wrapupResource, wrapupName)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Unknown names fail the apply
+				Config: dependencyConfig + generateMediaRetentionPolicyDocumentResource(policyResource, policyName, `yamlencode({
+					call = {
+						conditions = {
+							queues = ["terraform-policy-document-missing-queue-`+uuid.NewString()+`"]
+						}
+						actions = {
+							retain_recording = true
+						}
+					}
+				})`),
+				ExpectError: regexp.MustCompile("no queue found with name terraform-policy-document-missing-queue-"),
+			},
+			{
+				// Create
+				Config: dependencyConfig + generateMediaRetentionPolicyDocumentResource(policyResource, policyName, policyDocument),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_recording_media_retention_policy_document."+policyResource, "name", policyName),
+					resource.TestCheckResourceAttrSet("genesyscloud_recording_media_retention_policy_document."+policyResource, "policy_hash"),
+				),
+			},
+			{
+				// Update
+				Config: dependencyConfig + generateMediaRetentionPolicyDocumentResource(policyResource, policyName, updatedDocument),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_recording_media_retention_policy_document."+policyResource, "name", policyName),
+					resource.TestCheckResourceAttrSet("genesyscloud_recording_media_retention_policy_document."+policyResource, "policy_hash"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_recording_media_retention_policy_document." + policyResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_document", "policy_hash"},
+			},
+		},
+		CheckDestroy: testVerifyMediaRetentionPolicyDocumentDestroyed,
+	})
+}
+
+func generateMediaRetentionPolicyDocument(queueResource string, wrapupResource string, deleteAfterDays int) string {
+	return fmt.Sprintf(`yamlencode({
+			description = "Terraform policy document test"
+			order       = 0
+			enabled     = false
+			call = {
+				conditions = {
+					queues       = [genesyscloud_routing_queue.%s.name]
+					wrapup_codes = [genesyscloud_routing_wrapupcode.%s.name]
+					directions   = ["INBOUND"]
+				}
+				actions = {
+					retain_recording  = true
+					delete_after_days = %d
+				}
+			}
+		})`, queueResource, wrapupResource, deleteAfterDays)
+}
+
+func generateMediaRetentionPolicyDocumentResource(resourceID string, name string, policyDocument string) string {
+	return fmt.Sprintf(`resource "genesyscloud_recording_media_retention_policy_document" "%s" {
+		name            = "%s"
+		policy_document = %s
+	}
+	`, resourceID, name, policyDocument)
+}
+
+func testVerifyMediaRetentionPolicyDocumentDestroyed(state *terraform.State) error {
+	recordingAPI := platformclientv2.NewRecordingApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_recording_media_retention_policy_document" {
+			continue
+		}
+
+		policy, resp, err := recordingAPI.GetRecordingMediaretentionpolicy(rs.Primary.ID)
+		if policy != nil {
+			return fmt.Errorf("Policy (%s) still exists", rs.Primary.ID)
+		} else if isStatus404(resp) {
+			// Policy not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All policies destroyed
+	return nil
+}
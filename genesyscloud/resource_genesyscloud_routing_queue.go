@@ -153,23 +153,36 @@ func getAllRoutingQueues(_ context.Context, clientConfig *platformclientv2.Confi
 	// Newly created resources often aren't returned unless there's a delay
 	time.Sleep(5 * time.Second)
 
+	queues, err := getAllSdkRoutingQueues(routingAPI, nil)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get page of queues: %v", err)
+	}
+
+	for _, queue := range queues {
+		resources[*queue.Id] = &ResourceMeta{Name: *queue.Name}
+	}
+
+	return resources, nil
+}
+
+// getAllSdkRoutingQueues pages through every queue in the org, optionally limited to a set of divisions
+func getAllSdkRoutingQueues(routingAPI *platformclientv2.RoutingApi, divisionIds []string) ([]platformclientv2.Queue, error) {
+	var allQueues []platformclientv2.Queue
 	for pageNum := 1; ; pageNum++ {
 		const pageSize = 100
-		queues, _, getErr := routingAPI.GetRoutingQueues(pageNum, pageSize, "", "", nil, nil, nil, false)
+		queues, _, getErr := routingAPI.GetRoutingQueues(pageNum, pageSize, "", "", nil, divisionIds, nil, false)
 		if getErr != nil {
-			return nil, diag.Errorf("Failed to get page of queues: %v", getErr)
+			return nil, getErr
 		}
 
 		if queues.Entities == nil || len(*queues.Entities) == 0 {
 			break
 		}
 
-		for _, queue := range *queues.Entities {
-			resources[*queue.Id] = &ResourceMeta{Name: *queue.Name}
-		}
+		allQueues = append(allQueues, *queues.Entities...)
 	}
 
-	return resources, nil
+	return allQueues, nil
 }
 
 func routingQueueExporter() *ResourceExporter {
@@ -555,181 +568,10 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		}
 
 		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceRoutingQueue())
-		if currentQueue.Name != nil {
-			d.Set("name", *currentQueue.Name)
-		} else {
-			d.Set("name", nil)
-		}
-
-		if currentQueue.Division != nil && currentQueue.Division.Id != nil {
-			d.Set("division_id", *currentQueue.Division.Id)
-		} else {
-			d.Set("division_id", nil)
-		}
-
-		if currentQueue.Description != nil {
-			d.Set("description", *currentQueue.Description)
-		} else {
-			d.Set("description", nil)
-		}
-
-		d.Set("acw_wrapup_prompt", nil)
-		d.Set("acw_timeout_ms", nil)
-		if currentQueue.AcwSettings != nil {
-			if currentQueue.AcwSettings.WrapupPrompt != nil {
-				d.Set("acw_wrapup_prompt", *currentQueue.AcwSettings.WrapupPrompt)
-			}
-			if currentQueue.AcwSettings.TimeoutMs != nil {
-				d.Set("acw_timeout_ms", int(*currentQueue.AcwSettings.TimeoutMs))
-			}
-		}
-
-		if currentQueue.SkillEvaluationMethod != nil {
-			d.Set("skill_evaluation_method", *currentQueue.SkillEvaluationMethod)
-		} else {
-			d.Set("skill_evaluation_method", nil)
-		}
-
-		d.Set("media_settings_call", nil)
-		d.Set("media_settings_callback", nil)
-		d.Set("media_settings_chat", nil)
-		d.Set("media_settings_email", nil)
-		d.Set("media_settings_message", nil)
-
-		if currentQueue.MediaSettings != nil {
-			if currentQueue.MediaSettings.Call != nil {
-				d.Set("media_settings_call", flattenMediaSetting(*currentQueue.MediaSettings.Call))
-			}
-
-			if currentQueue.MediaSettings.Callback != nil {
-				d.Set("media_settings_callback", flattenMediaSettingCallback(*currentQueue.MediaSettings.Callback))
-			}
-
-			if currentQueue.MediaSettings.Chat != nil {
-				d.Set("media_settings_chat", flattenMediaSetting(*currentQueue.MediaSettings.Chat))
-			}
-
-			if currentQueue.MediaSettings.Email != nil {
-				d.Set("media_settings_email", flattenMediaSetting(*currentQueue.MediaSettings.Email))
-			}
-
-			if currentQueue.MediaSettings.Message != nil {
-				d.Set("media_settings_message", flattenMediaSetting(*currentQueue.MediaSettings.Message))
-			}
-
-		}
-
-		if currentQueue.RoutingRules != nil {
-			d.Set("routing_rules", flattenRoutingRules(*currentQueue.RoutingRules))
-		} else {
-			d.Set("routing_rules", nil)
-		}
-
-		if currentQueue.Bullseye != nil && currentQueue.Bullseye.Rings != nil {
-			d.Set("bullseye_rings", flattenBullseyeRings(*currentQueue.Bullseye.Rings))
-		} else {
-			d.Set("bullseye_rings", nil)
-		}
-
-		if currentQueue.QueueFlow != nil && currentQueue.QueueFlow.Id != nil {
-			d.Set("queue_flow_id", *currentQueue.QueueFlow.Id)
-		} else {
-			d.Set("queue_flow_id", nil)
+		if diagErr := flattenQueue(d, currentQueue, routingAPI); diagErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
 		}
 
-		if currentQueue.MessageInQueueFlow != nil && currentQueue.MessageInQueueFlow.Id != nil {
-			d.Set("message_in_queue_flow_id", *currentQueue.MessageInQueueFlow.Id)
-		} else {
-			d.Set("message_in_queue_flow_id", nil)
-		}
-
-		if currentQueue.EmailInQueueFlow != nil && currentQueue.EmailInQueueFlow.Id != nil {
-			d.Set("email_in_queue_flow_id", *currentQueue.EmailInQueueFlow.Id)
-		} else {
-			d.Set("email_in_queue_flow_id", nil)
-		}
-
-		if currentQueue.WhisperPrompt != nil && currentQueue.WhisperPrompt.Id != nil {
-			d.Set("whisper_prompt_id", *currentQueue.WhisperPrompt.Id)
-		} else {
-			d.Set("whisper_prompt_id", nil)
-		}
-
-		if currentQueue.AutoAnswerOnly != nil {
-			d.Set("auto_answer_only", *currentQueue.AutoAnswerOnly)
-		} else {
-			d.Set("auto_answer_only", nil)
-		}
-
-		if currentQueue.EnableTranscription != nil {
-			d.Set("enable_transcription", *currentQueue.EnableTranscription)
-		} else {
-			d.Set("enable_transcription", nil)
-		}
-
-		if currentQueue.EnableManualAssignment != nil {
-			d.Set("enable_manual_assignment", *currentQueue.EnableManualAssignment)
-		} else {
-			d.Set("enable_manual_assignment", nil)
-		}
-
-		if currentQueue.CallingPartyName != nil {
-			d.Set("calling_party_name", *currentQueue.CallingPartyName)
-		} else {
-			d.Set("calling_party_name", nil)
-		}
-
-		if currentQueue.CallingPartyNumber != nil {
-			d.Set("calling_party_number", *currentQueue.CallingPartyNumber)
-		} else {
-			d.Set("calling_party_number", nil)
-		}
-
-		if currentQueue.DefaultScripts != nil {
-			d.Set("default_script_ids", flattenDefaultScripts(*currentQueue.DefaultScripts))
-		} else {
-			d.Set("default_script_ids", nil)
-		}
-
-		if currentQueue.OutboundMessagingAddresses != nil && currentQueue.OutboundMessagingAddresses.SmsAddress != nil {
-			d.Set("outbound_messaging_sms_address_id", *currentQueue.OutboundMessagingAddresses.SmsAddress.Id)
-		} else {
-			d.Set("outbound_messaging_sms_address_id", nil)
-		}
-
-		if currentQueue.OutboundEmailAddress != nil && *currentQueue.OutboundEmailAddress != nil {
-			outboundEmailAddress := *currentQueue.OutboundEmailAddress
-			d.Set("outbound_email_address", []interface{}{flattenQueueEmailAddress(*outboundEmailAddress)})
-		} else {
-			d.Set("outbound_email_address", nil)
-		}
-
-		if currentQueue.DirectRouting != nil {
-			d.Set("direct_routing", []interface{}{flattenDirectRouting(*currentQueue.DirectRouting)})
-		} else {
-			d.Set("direct_routing", nil)
-		}
-
-		members, err := flattenQueueMembers(d.Id(), routingAPI)
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("%v", err))
-		}
-		d.Set("members", members)
-
-		wrapupCodes, err := flattenQueueWrapupCodes(d.Id(), routingAPI)
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("%v", err))
-		}
-		d.Set("wrapup_codes", wrapupCodes)
-
-		skillgroup := "SKILLGROUP"
-		team := "TEAM"
-		group := "GROUP"
-
-		d.Set("skill_groups", flattenQueueMemberGroupsList(currentQueue, &skillgroup))
-		d.Set("teams", flattenQueueMemberGroupsList(currentQueue, &team))
-		d.Set("groups", flattenQueueMemberGroupsList(currentQueue, &group))
-
 		log.Printf("Done reading queue %s %s", d.Id(), *currentQueue.Name)
 		return cc.CheckState()
 	})
@@ -834,6 +676,186 @@ func deleteQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	})
 }
 
+// flattenQueue sets all queue attributes in the resource data. Used by both the resource and data source.
+func flattenQueue(d *schema.ResourceData, currentQueue *platformclientv2.Queue, routingAPI *platformclientv2.RoutingApi) diag.Diagnostics {
+	if currentQueue.Name != nil {
+		d.Set("name", *currentQueue.Name)
+	} else {
+		d.Set("name", nil)
+	}
+
+	if currentQueue.Division != nil && currentQueue.Division.Id != nil {
+		d.Set("division_id", *currentQueue.Division.Id)
+	} else {
+		d.Set("division_id", nil)
+	}
+
+	if currentQueue.Description != nil {
+		d.Set("description", *currentQueue.Description)
+	} else {
+		d.Set("description", nil)
+	}
+
+	d.Set("acw_wrapup_prompt", nil)
+	d.Set("acw_timeout_ms", nil)
+	if currentQueue.AcwSettings != nil {
+		if currentQueue.AcwSettings.WrapupPrompt != nil {
+			d.Set("acw_wrapup_prompt", *currentQueue.AcwSettings.WrapupPrompt)
+		}
+		if currentQueue.AcwSettings.TimeoutMs != nil {
+			d.Set("acw_timeout_ms", int(*currentQueue.AcwSettings.TimeoutMs))
+		}
+	}
+
+	if currentQueue.SkillEvaluationMethod != nil {
+		d.Set("skill_evaluation_method", *currentQueue.SkillEvaluationMethod)
+	} else {
+		d.Set("skill_evaluation_method", nil)
+	}
+
+	d.Set("media_settings_call", nil)
+	d.Set("media_settings_callback", nil)
+	d.Set("media_settings_chat", nil)
+	d.Set("media_settings_email", nil)
+	d.Set("media_settings_message", nil)
+
+	if currentQueue.MediaSettings != nil {
+		if currentQueue.MediaSettings.Call != nil {
+			d.Set("media_settings_call", flattenMediaSetting(*currentQueue.MediaSettings.Call))
+		}
+
+		if currentQueue.MediaSettings.Callback != nil {
+			d.Set("media_settings_callback", flattenMediaSettingCallback(*currentQueue.MediaSettings.Callback))
+		}
+
+		if currentQueue.MediaSettings.Chat != nil {
+			d.Set("media_settings_chat", flattenMediaSetting(*currentQueue.MediaSettings.Chat))
+		}
+
+		if currentQueue.MediaSettings.Email != nil {
+			d.Set("media_settings_email", flattenMediaSetting(*currentQueue.MediaSettings.Email))
+		}
+
+		if currentQueue.MediaSettings.Message != nil {
+			d.Set("media_settings_message", flattenMediaSetting(*currentQueue.MediaSettings.Message))
+		}
+
+	}
+
+	if currentQueue.RoutingRules != nil {
+		d.Set("routing_rules", flattenRoutingRules(*currentQueue.RoutingRules))
+	} else {
+		d.Set("routing_rules", nil)
+	}
+
+	if currentQueue.Bullseye != nil && currentQueue.Bullseye.Rings != nil {
+		d.Set("bullseye_rings", flattenBullseyeRings(*currentQueue.Bullseye.Rings))
+	} else {
+		d.Set("bullseye_rings", nil)
+	}
+
+	if currentQueue.QueueFlow != nil && currentQueue.QueueFlow.Id != nil {
+		d.Set("queue_flow_id", *currentQueue.QueueFlow.Id)
+	} else {
+		d.Set("queue_flow_id", nil)
+	}
+
+	if currentQueue.MessageInQueueFlow != nil && currentQueue.MessageInQueueFlow.Id != nil {
+		d.Set("message_in_queue_flow_id", *currentQueue.MessageInQueueFlow.Id)
+	} else {
+		d.Set("message_in_queue_flow_id", nil)
+	}
+
+	if currentQueue.EmailInQueueFlow != nil && currentQueue.EmailInQueueFlow.Id != nil {
+		d.Set("email_in_queue_flow_id", *currentQueue.EmailInQueueFlow.Id)
+	} else {
+		d.Set("email_in_queue_flow_id", nil)
+	}
+
+	if currentQueue.WhisperPrompt != nil && currentQueue.WhisperPrompt.Id != nil {
+		d.Set("whisper_prompt_id", *currentQueue.WhisperPrompt.Id)
+	} else {
+		d.Set("whisper_prompt_id", nil)
+	}
+
+	if currentQueue.AutoAnswerOnly != nil {
+		d.Set("auto_answer_only", *currentQueue.AutoAnswerOnly)
+	} else {
+		d.Set("auto_answer_only", nil)
+	}
+
+	if currentQueue.EnableTranscription != nil {
+		d.Set("enable_transcription", *currentQueue.EnableTranscription)
+	} else {
+		d.Set("enable_transcription", nil)
+	}
+
+	if currentQueue.EnableManualAssignment != nil {
+		d.Set("enable_manual_assignment", *currentQueue.EnableManualAssignment)
+	} else {
+		d.Set("enable_manual_assignment", nil)
+	}
+
+	if currentQueue.CallingPartyName != nil {
+		d.Set("calling_party_name", *currentQueue.CallingPartyName)
+	} else {
+		d.Set("calling_party_name", nil)
+	}
+
+	if currentQueue.CallingPartyNumber != nil {
+		d.Set("calling_party_number", *currentQueue.CallingPartyNumber)
+	} else {
+		d.Set("calling_party_number", nil)
+	}
+
+	if currentQueue.DefaultScripts != nil {
+		d.Set("default_script_ids", flattenDefaultScripts(*currentQueue.DefaultScripts))
+	} else {
+		d.Set("default_script_ids", nil)
+	}
+
+	if currentQueue.OutboundMessagingAddresses != nil && currentQueue.OutboundMessagingAddresses.SmsAddress != nil {
+		d.Set("outbound_messaging_sms_address_id", *currentQueue.OutboundMessagingAddresses.SmsAddress.Id)
+	} else {
+		d.Set("outbound_messaging_sms_address_id", nil)
+	}
+
+	if currentQueue.OutboundEmailAddress != nil && *currentQueue.OutboundEmailAddress != nil {
+		outboundEmailAddress := *currentQueue.OutboundEmailAddress
+		d.Set("outbound_email_address", []interface{}{flattenQueueEmailAddress(*outboundEmailAddress)})
+	} else {
+		d.Set("outbound_email_address", nil)
+	}
+
+	if currentQueue.DirectRouting != nil {
+		d.Set("direct_routing", []interface{}{flattenDirectRouting(*currentQueue.DirectRouting)})
+	} else {
+		d.Set("direct_routing", nil)
+	}
+
+	members, err := flattenQueueMembers(d.Id(), routingAPI)
+	if err != nil {
+		return err
+	}
+	d.Set("members", members)
+
+	wrapupCodes, err := flattenQueueWrapupCodes(d.Id(), routingAPI)
+	if err != nil {
+		return err
+	}
+	d.Set("wrapup_codes", wrapupCodes)
+
+	skillgroup := "SKILLGROUP"
+	team := "TEAM"
+	group := "GROUP"
+
+	d.Set("skill_groups", flattenQueueMemberGroupsList(currentQueue, &skillgroup))
+	d.Set("teams", flattenQueueMemberGroupsList(currentQueue, &team))
+	d.Set("groups", flattenQueueMemberGroupsList(currentQueue, &group))
+
+	return nil
+}
+
 func buildSdkMediaSettings(d *schema.ResourceData) *platformclientv2.Queuemediasettings {
 	queueMediaSettings := &platformclientv2.Queuemediasettings{}
 
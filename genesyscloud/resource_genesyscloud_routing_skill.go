@@ -19,11 +19,26 @@ func getAllRoutingSkills(_ context.Context, clientConfig *platformclientv2.Confi
 	resources := make(ResourceIDMetaMap)
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(clientConfig)
 
+	skills, err := getAllSdkRoutingSkills(routingAPI)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get page of skills: %v", err)
+	}
+
+	for _, skill := range skills {
+		resources[*skill.Id] = &ResourceMeta{Name: *skill.Name}
+	}
+
+	return resources, nil
+}
+
+// getAllSdkRoutingSkills pages through every non-deleted skill in the org
+func getAllSdkRoutingSkills(routingAPI *platformclientv2.RoutingApi) ([]platformclientv2.Routingskill, error) {
+	var allSkills []platformclientv2.Routingskill
 	for pageNum := 1; ; pageNum++ {
 		const pageSize = 100
 		skills, _, getErr := routingAPI.GetRoutingSkills(pageSize, pageNum, "", nil)
 		if getErr != nil {
-			return nil, diag.Errorf("Failed to get page of skills: %v", getErr)
+			return nil, getErr
 		}
 
 		if skills.Entities == nil || len(*skills.Entities) == 0 {
@@ -32,12 +47,12 @@ func getAllRoutingSkills(_ context.Context, clientConfig *platformclientv2.Confi
 
 		for _, skill := range *skills.Entities {
 			if skill.State != nil && *skill.State != "deleted" {
-				resources[*skill.Id] = &ResourceMeta{Name: *skill.Name}
+				allSkills = append(allSkills, skill)
 			}
 		}
 	}
 
-	return resources, nil
+	return allSkills, nil
 }
 
 func routingSkillExporter() *ResourceExporter {
@@ -109,12 +124,17 @@ func readRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interfac
 			return nil
 		}
 
-		d.Set("name", *skill.Name)
+		flattenRoutingSkill(d, skill)
 		log.Printf("Read skill %s %s", d.Id(), *skill.Name)
 		return cc.CheckState()
 	})
 }
 
+// flattenRoutingSkill sets all skill attributes in the resource data. Used by both the resource and data source.
+func flattenRoutingSkill(d *schema.ResourceData, skill *platformclientv2.Routingskill) {
+	d.Set("name", *skill.Name)
+}
+
 func deleteRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 
@@ -50,6 +50,31 @@ func scriptExporter() *ResourceExporter {
 }
 
 func resourceScript() *schema.Resource {
+	scriptSchema := map[string]*schema.Schema{
+		"script_name": {
+			Description: "Display name for the script. A reliably unique name is recommended.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"filepath": {
+			Description:  "Path to the script file to upload.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validatePath,
+			ForceNew:     true,
+		},
+		"file_content_hash": {
+			Description: "Hash value of the script file content. Used to detect changes. Changes to the file content after substitutions are also detected through `rendered_content_hash`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+	}
+	for attr, attrSchema := range substitutionsSchema(true) {
+		scriptSchema[attr] = attrSchema
+	}
+
 	return &schema.Resource{
 		Description: "Genesys Cloud Script",
 
@@ -60,36 +85,22 @@ func resourceScript() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
-		Schema: map[string]*schema.Schema{
-			"script_name": {
-				Description: "Display name for the script. A reliably unique name is recommended.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-			},
-			"filepath": {
-				Description:  "Path to the script file to upload.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validatePath,
-				ForceNew:     true,
-			},
-			"file_content_hash": {
-				Description: "Hash value of the script file content. Used to detect changes.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-			},
-			"substitutions": {
-				Description: "A substitution is a key value pair where the key is the value you want to replace, and the value is the value to substitute in its place.",
-				Type:        schema.TypeMap,
-				Optional:    true,
-				ForceNew:    true,
-			},
-		},
+		Schema:        scriptSchema,
+		CustomizeDiff: customizeScriptDiff,
 	}
 }
 
+// Scripts cannot be updated, so a change to the rendered script file requires a new script
+func customizeScriptDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeRenderedContentHashDiff(ctx, diff, meta); err != nil {
+		return err
+	}
+	if diff.Id() != "" && diff.HasChange("rendered_content_hash") {
+		return diff.ForceNew("rendered_content_hash")
+	}
+	return nil
+}
+
 func createScript(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		sdkConfig  = meta.(*ProviderMeta).ClientConfig
@@ -101,10 +112,19 @@ func createScript(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	filePath := d.Get("filepath").(string)
 	scriptName := d.Get("script_name").(string)
-	substitutions := d.Get("substitutions").(map[string]interface{})
+	substitutions, typedSubstitutions, err := getSubstitutions(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	strictSubstitutions := d.Get("substitutions_strict").(bool)
 
 	log.Printf("Creating script %s", scriptName)
 
+	renderedHash, err := hashRenderedFileContent(filePath, substitutions, typedSubstitutions, strictSubstitutions)
+	if err != nil {
+		return diag.Errorf("Failed to render script file %s: %v", filePath, err)
+	}
+
 	exists, err := scriptExistsWithName(scriptName, meta)
 	if err != nil {
 		return diag.Errorf("%v", err)
@@ -122,6 +142,8 @@ func createScript(ctx context.Context, d *schema.ResourceData, meta interface{})
 	headers["Authorization"] = "Bearer " + accessToken
 
 	s3Uploader := NewS3Uploader(nil, formData, substitutions, headers, "POST", basePath+"/uploads/v2/scripter")
+	s3Uploader.typedSubstitutions = typedSubstitutions
+	s3Uploader.strictSubstitutions = strictSubstitutions
 	resp, err := s3Uploader.Upload()
 	if err != nil {
 		return diag.Errorf("%v", err)
@@ -147,6 +169,7 @@ func createScript(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(*sdkScripts[0].Id)
+	d.Set("rendered_content_hash", renderedHash)
 
 	log.Printf("Created script %s. ", d.Id())
 	return readScript(ctx, d, meta)
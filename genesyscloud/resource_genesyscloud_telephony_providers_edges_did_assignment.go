@@ -0,0 +1,548 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+const (
+	didOwnerUser  = "USER"
+	didOwnerPhone = "PHONE"
+	didOwnerIvr   = "IVR_CONFIG"
+	didOwnerGroup = "GROUP"
+)
+
+var (
+	// Work number types a DID can be assigned to on a user, in order of preference
+	didUserAddressTypes = []string{"WORK", "WORK2", "WORK3", "WORK4"}
+	didGroupAddressType = "GROUPRING"
+)
+
+func resourceDidAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud DID Assignment. Assigns a DID from a DID pool to a user, phone, IVR configuration or group. " +
+			"Queues cannot own DIDs; route a DID to a queue by assigning it to an IVR configuration. " +
+			"The number must not also be managed on the owner's own resource (e.g. the addresses of a genesyscloud_user or the dnis of a genesyscloud_architect_ivr). " +
+			"The ID of the resource is the E.164 phone number.",
+
+		CreateContext: createWithPooledClient(createDidAssignment),
+		ReadContext:   readWithPooledClient(readDidAssignment),
+		DeleteContext: deleteWithPooledClient(deleteDidAssignment),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		CustomizeDiff: customizeDidAssignmentDiff,
+		Schema: map[string]*schema.Schema{
+			"phone_number": {
+				Description:      "E.164 phone number of the DID. The number must be in the range of a DID pool. Changing the phone_number attribute will cause the assignment to be removed and recreated.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePhoneNumber,
+			},
+			"owner_type": {
+				Description:  "Type of the owner of the DID (USER | PHONE | IVR_CONFIG | GROUP). Changing the owner_type attribute will cause the assignment to be removed and recreated.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{didOwnerUser, didOwnerPhone, didOwnerIvr, didOwnerGroup}, false),
+			},
+			"owner_id": {
+				Description: "ID of the user, phone, IVR configuration or group that owns the DID. Phones must be standalone phones with a line that has no address. Changing the owner_id attribute will cause the assignment to be removed and recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"did_pool_id": {
+				Description: "ID of the DID pool the number belongs to. If set, the phone number is validated against the range of the pool, otherwise it must be in the range of any DID pool.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func getAllDidAssignments(_ context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(ResourceIDMetaMap)
+	telephonyAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
+
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		dids, _, getErr := telephonyAPI.GetTelephonyProvidersEdgesDids(pageSize, pageNum, "", "", "", "", "", nil)
+		if getErr != nil {
+			return nil, diag.Errorf("Failed to get page of DIDs: %v", getErr)
+		}
+
+		if dids.Entities == nil || len(*dids.Entities) == 0 {
+			break
+		}
+
+		for _, did := range *dids.Entities {
+			if did.PhoneNumber == nil || did.Owner == nil || !isManagedDidOwnerType(did.OwnerType) {
+				continue
+			}
+			resources[*did.PhoneNumber] = &ResourceMeta{Name: *did.PhoneNumber}
+		}
+	}
+
+	return resources, nil
+}
+
+func didAssignmentExporter() *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(getAllDidAssignments),
+		RefAttrs: map[string]*RefAttrSettings{
+			"did_pool_id": {RefType: "genesyscloud_telephony_providers_edges_did_pool"},
+		},
+		CustomAttributeResolver: map[string]*RefAttrCustomResolver{
+			"owner_id": {ResolverFunc: DidOwnerResolver},
+		},
+	}
+}
+
+func isManagedDidOwnerType(ownerType *string) bool {
+	return ownerType != nil && StringInSlice(*ownerType, []string{didOwnerUser, didOwnerPhone, didOwnerIvr, didOwnerGroup})
+}
+
+// customizeDidAssignmentDiff validates the phone number against the DID pool ranges at plan time
+func customizeDidAssignmentDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("phone_number", "did_pool_id") {
+		return nil
+	}
+	// did_pool_id is computed when not configured, so check the configured value
+	didPoolConfig := diff.GetRawConfig().GetAttr("did_pool_id")
+	if !diff.NewValueKnown("phone_number") || !didPoolConfig.IsKnown() {
+		// The pool may be created in the same apply
+		return nil
+	}
+
+	phoneNumber := diff.Get("phone_number").(string)
+	didPoolId := ""
+	if !didPoolConfig.IsNull() {
+		didPoolId = didPoolConfig.AsString()
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	telephonyAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	if didPoolId != "" {
+		didPool, _, err := telephonyAPI.GetTelephonyProvidersEdgesDidpool(didPoolId)
+		if err != nil {
+			return fmt.Errorf("Failed to read DID pool %s: %s", didPoolId, err)
+		}
+		if !isPhoneNumberInDidPool(phoneNumber, *didPool) {
+			return fmt.Errorf("Phone number %s is not in the range %s - %s of DID pool %s", phoneNumber, *didPool.StartPhoneNumber, *didPool.EndPhoneNumber, didPoolId)
+		}
+		return nil
+	}
+
+	didPool, err := getDidPoolForNumber(phoneNumber, telephonyAPI)
+	if err != nil {
+		return err
+	}
+	if didPool == nil {
+		return fmt.Errorf("Phone number %s is not in the range of any DID pool", phoneNumber)
+	}
+	return nil
+}
+
+func createDidAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	phoneNumber := d.Get("phone_number").(string)
+	ownerType := d.Get("owner_type").(string)
+	ownerId := d.Get("owner_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+
+	did, err := getDidByPhoneNumber(phoneNumber, platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if did != nil && did.Owner != nil && did.Owner.Id != nil && *did.Owner.Id != ownerId {
+		return diag.Errorf("DID %s is already assigned to %s %s", phoneNumber, *did.OwnerType, *did.Owner.Id)
+	}
+
+	log.Printf("Assigning DID %s to %s %s", phoneNumber, ownerType, ownerId)
+	if diagErr := updateDidOwner(ownerType, ownerId, phoneNumber, true, sdkConfig); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(phoneNumber)
+	log.Printf("Assigned DID %s to %s %s", phoneNumber, ownerType, ownerId)
+	return readDidAssignment(ctx, d, meta)
+}
+
+func readDidAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	telephonyAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Reading DID assignment %s", d.Id())
+	return withRetriesForRead(ctx, d, func() *resource.RetryError {
+		did, err := getDidByPhoneNumber(d.Id(), telephonyAPI)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if did == nil || did.Owner == nil {
+			// The DID only exists while it is assigned and may not be listed yet right after it was assigned
+			if d.IsNewResource() {
+				return resource.RetryableError(fmt.Errorf("DID %s is not assigned", d.Id()))
+			}
+			// The DID was unassigned outside of Terraform
+			log.Printf("DID %s is not assigned", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceDidAssignment())
+		d.Set("phone_number", *did.PhoneNumber)
+		d.Set("owner_type", did.OwnerType)
+		d.Set("owner_id", did.Owner.Id)
+		d.Set("did_pool_id", nil)
+		if did.DidPool != nil {
+			d.Set("did_pool_id", did.DidPool.Id)
+		}
+
+		log.Printf("Read DID assignment %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func deleteDidAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	phoneNumber := d.Get("phone_number").(string)
+	ownerType := d.Get("owner_type").(string)
+	ownerId := d.Get("owner_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	telephonyAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Removing DID %s from %s %s", phoneNumber, ownerType, ownerId)
+	if diagErr := updateDidOwner(ownerType, ownerId, phoneNumber, false, sdkConfig); diagErr != nil {
+		return diagErr
+	}
+
+	return withRetries(ctx, 30*time.Second, func() *resource.RetryError {
+		did, err := getDidByPhoneNumber(phoneNumber, telephonyAPI)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error removing DID %s: %s", phoneNumber, err))
+		}
+		if did == nil || did.Owner == nil || did.Owner.Id == nil || *did.Owner.Id != ownerId {
+			log.Printf("Removed DID %s from %s %s", phoneNumber, ownerType, ownerId)
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("DID %s is still assigned to %s %s", phoneNumber, ownerType, ownerId))
+	})
+}
+
+// updateDidOwner adds the phone number to or removes it from the owner. A deleted owner no longer holds the number.
+func updateDidOwner(ownerType, ownerId, phoneNumber string, assign bool, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	switch ownerType {
+	case didOwnerUser:
+		return updateUserDid(ownerId, phoneNumber, assign, platformclientv2.NewUsersApiWithConfig(sdkConfig))
+	case didOwnerPhone:
+		return updatePhoneDid(ownerId, phoneNumber, assign, platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig))
+	case didOwnerIvr:
+		return updateIvrDid(ownerId, phoneNumber, assign, platformclientv2.NewArchitectApiWithConfig(sdkConfig))
+	case didOwnerGroup:
+		return updateGroupDid(ownerId, phoneNumber, assign, platformclientv2.NewGroupsApiWithConfig(sdkConfig))
+	}
+	return diag.Errorf("Unsupported DID owner type %s", ownerType)
+}
+
+func updateUserDid(userId, phoneNumber string, assign bool, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+	return retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		user, resp, getErr := usersAPI.GetUser(userId, nil, "", "")
+		if getErr != nil {
+			if !assign && isStatus404(resp) {
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to read user %s: %s", userId, getErr)
+		}
+
+		addresses := make([]platformclientv2.Contact, 0)
+		usedTypes := make([]string, 0)
+		if user.Addresses != nil {
+			for _, address := range *user.Addresses {
+				if address.Address != nil && *address.Address == phoneNumber {
+					if assign {
+						// Already assigned
+						return nil, nil
+					}
+					continue
+				}
+				if address.MediaType != nil && *address.MediaType == "PHONE" && address.VarType != nil {
+					usedTypes = append(usedTypes, *address.VarType)
+				}
+				addresses = append(addresses, address)
+			}
+		}
+
+		if assign {
+			addressType := ""
+			for _, workType := range didUserAddressTypes {
+				if !StringInSlice(workType, usedTypes) {
+					addressType = workType
+					break
+				}
+			}
+			if addressType == "" {
+				return nil, diag.Errorf("User %s has no free work number to assign DID %s to", userId, phoneNumber)
+			}
+			addresses = append(addresses, platformclientv2.Contact{
+				Address:   &phoneNumber,
+				MediaType: platformclientv2.String("PHONE"),
+				VarType:   &addressType,
+			})
+		} else if user.Addresses == nil || len(addresses) == len(*user.Addresses) {
+			// Already removed
+			return nil, nil
+		}
+
+		_, resp, patchErr := usersAPI.PatchUser(userId, platformclientv2.Updateuser{
+			Addresses: &addresses,
+			Version:   user.Version,
+		})
+		if patchErr != nil {
+			return resp, diag.Errorf("Failed to update DID %s of user %s: %s", phoneNumber, userId, patchErr)
+		}
+		return resp, nil
+	})
+}
+
+func updatePhoneDid(phoneId, phoneNumber string, assign bool, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) diag.Diagnostics {
+	return retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		phone, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesPhone(phoneId)
+		if getErr != nil {
+			if !assign && isStatus404(resp) {
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to read phone %s: %s", phoneId, getErr)
+		}
+		if phone.Lines == nil {
+			return nil, diag.Errorf("Phone %s has no lines", phoneId)
+		}
+
+		lineIndex := -1
+		for i, line := range *phone.Lines {
+			lineAddress := getLineStationIdentityAddress(line)
+			if lineAddress == phoneNumber {
+				if assign {
+					// Already assigned
+					return nil, nil
+				}
+				lineIndex = i
+				break
+			}
+			if assign && lineAddress == "" && lineIndex == -1 {
+				lineIndex = i
+			}
+		}
+		if lineIndex == -1 {
+			if assign {
+				return nil, diag.Errorf("Phone %s has no line without an address to assign DID %s to", phoneId, phoneNumber)
+			}
+			// Already removed
+			return nil, nil
+		}
+
+		line := &(*phone.Lines)[lineIndex]
+		properties := make(map[string]interface{})
+		if line.Properties != nil {
+			properties = *line.Properties
+		}
+		if assign {
+			properties["station_identity_address"] = map[string]interface{}{
+				"value": map[string]interface{}{
+					"instance": phoneNumber,
+				},
+			}
+		} else {
+			delete(properties, "station_identity_address")
+		}
+		line.Properties = &properties
+
+		_, resp, putErr := edgesAPI.PutTelephonyProvidersEdgesPhone(phoneId, *phone)
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update DID %s of phone %s: %s", phoneNumber, phoneId, putErr)
+		}
+		return resp, nil
+	})
+}
+
+func getLineStationIdentityAddress(line platformclientv2.Line) string {
+	if line.Properties == nil {
+		return ""
+	}
+	if property, ok := (*line.Properties)["station_identity_address"].(map[string]interface{}); ok {
+		if value, ok := property["value"].(map[string]interface{}); ok {
+			if instance, ok := value["instance"].(string); ok {
+				return instance
+			}
+		}
+	}
+	return ""
+}
+
+func updateIvrDid(ivrId, phoneNumber string, assign bool, architectAPI *platformclientv2.ArchitectApi) diag.Diagnostics {
+	return retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		ivr, resp, getErr := architectAPI.GetArchitectIvr(ivrId)
+		if getErr != nil {
+			if !assign && isStatus404(resp) {
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to read IVR config %s: %s", ivrId, getErr)
+		}
+
+		dnis := make([]string, 0)
+		if ivr.Dnis != nil {
+			for _, number := range *ivr.Dnis {
+				if number == phoneNumber {
+					if assign {
+						// Already assigned
+						return nil, nil
+					}
+					continue
+				}
+				dnis = append(dnis, number)
+			}
+		}
+
+		if assign {
+			dnis = append(dnis, phoneNumber)
+		} else if ivr.Dnis == nil || len(dnis) == len(*ivr.Dnis) {
+			// Already removed
+			return nil, nil
+		}
+		ivr.Dnis = &dnis
+
+		_, resp, putErr := architectAPI.PutArchitectIvr(ivrId, *ivr)
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update DID %s of IVR config %s: %s", phoneNumber, ivrId, putErr)
+		}
+		return resp, nil
+	})
+}
+
+func updateGroupDid(groupId, phoneNumber string, assign bool, groupsAPI *platformclientv2.GroupsApi) diag.Diagnostics {
+	return retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		group, resp, getErr := groupsAPI.GetGroup(groupId)
+		if getErr != nil {
+			if !assign && isStatus404(resp) {
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to read group %s: %s", groupId, getErr)
+		}
+
+		addresses := make([]platformclientv2.Groupcontact, 0)
+		if group.Addresses != nil {
+			for _, address := range *group.Addresses {
+				if address.Address != nil && *address.Address == phoneNumber {
+					if assign {
+						// Already assigned
+						return nil, nil
+					}
+					continue
+				}
+				addresses = append(addresses, address)
+			}
+		}
+
+		if assign {
+			addresses = append(addresses, platformclientv2.Groupcontact{
+				Address:   &phoneNumber,
+				MediaType: &groupPhoneType,
+				VarType:   &didGroupAddressType,
+			})
+		} else if group.Addresses == nil || len(addresses) == len(*group.Addresses) {
+			// Already removed
+			return nil, nil
+		}
+
+		var ownerIds *[]string
+		if group.Owners != nil {
+			ids := make([]string, 0, len(*group.Owners))
+			for _, owner := range *group.Owners {
+				ids = append(ids, *owner.Id)
+			}
+			ownerIds = &ids
+		}
+
+		_, resp, putErr := groupsAPI.PutGroup(groupId, platformclientv2.Groupupdate{
+			Version:      group.Version,
+			Name:         group.Name,
+			Description:  group.Description,
+			Visibility:   group.Visibility,
+			RulesVisible: group.RulesVisible,
+			Addresses:    &addresses,
+			OwnerIds:     ownerIds,
+		})
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update DID %s of group %s: %s", phoneNumber, groupId, putErr)
+		}
+		return resp, nil
+	})
+}
+
+// getDidByPhoneNumber returns the DID record of an assigned number or nil if the number is not assigned
+func getDidByPhoneNumber(phoneNumber string, telephonyAPI *platformclientv2.TelephonyProvidersEdgeApi) (*platformclientv2.Did, error) {
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		dids, _, getErr := telephonyAPI.GetTelephonyProvidersEdgesDids(pageSize, pageNum, "", "", phoneNumber, "", "", nil)
+		if getErr != nil {
+			return nil, fmt.Errorf("Error requesting DID %s: %s", phoneNumber, getErr)
+		}
+		if dids.Entities == nil || len(*dids.Entities) == 0 {
+			return nil, nil
+		}
+		for _, did := range *dids.Entities {
+			if did.PhoneNumber != nil && *did.PhoneNumber == phoneNumber {
+				return &did, nil
+			}
+		}
+	}
+}
+
+// getDidPoolForNumber returns the DID pool with a range containing the phone number or nil if there is none
+func getDidPoolForNumber(phoneNumber string, telephonyAPI *platformclientv2.TelephonyProvidersEdgeApi) (*platformclientv2.Didpool, error) {
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		didPools, _, getErr := telephonyAPI.GetTelephonyProvidersEdgesDidpools(pageSize, pageNum, "", nil)
+		if getErr != nil {
+			return nil, fmt.Errorf("Failed to get page of DID pools: %v", getErr)
+		}
+		if didPools.Entities == nil || len(*didPools.Entities) == 0 {
+			return nil, nil
+		}
+		for _, didPool := range *didPools.Entities {
+			if didPool.State != nil && *didPool.State == "deleted" {
+				continue
+			}
+			if isPhoneNumberInDidPool(phoneNumber, didPool) {
+				return &didPool, nil
+			}
+		}
+	}
+}
+
+// isPhoneNumberInDidPool compares E.164 numbers of the same length, which sort the same as their numeric values
+func isPhoneNumberInDidPool(phoneNumber string, didPool platformclientv2.Didpool) bool {
+	if didPool.StartPhoneNumber == nil || didPool.EndPhoneNumber == nil {
+		return false
+	}
+	start, end := *didPool.StartPhoneNumber, *didPool.EndPhoneNumber
+	if len(phoneNumber) != len(start) || len(phoneNumber) != len(end) {
+		return false
+	}
+	return phoneNumber >= start && phoneNumber <= end
+}
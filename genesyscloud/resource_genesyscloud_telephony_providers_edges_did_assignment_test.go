@@ -0,0 +1,143 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourceDidAssignment(t *testing.T) {
+	var (
+		didPoolRes              = "didPool"
+		didPoolStartPhoneNumber = "+14175550031"
+		didPoolEndPhoneNumber   = "+14175550033"
+		didAssignmentRes        = "didAssignment"
+		didPhoneNumber          = "+14175550032"
+		ivrConfigRes            = "ivrConfig"
+		ivrConfigName           = "test-config" + uuid.NewString()
+		userRes                 = "user"
+		userEmail               = "terraform-did-" + uuid.NewString() + "@example.com"
+	)
+
+	if err := authorizeSdk(); err != nil {
+		t.Fatal(err)
+	}
+	deleteIvrStartingWith("test-config")
+	if err := deleteDidPoolWithNumber(didPoolStartPhoneNumber); err != nil {
+		t.Fatalf("error deleting did pool start number: %v", err)
+	}
+	if err := deleteDidPoolWithNumber(didPoolEndPhoneNumber); err != nil {
+		t.Fatalf("error deleting did pool end number: %v", err)
+	}
+
+	didPool := generateDidPoolResource(&didPoolStruct{
+		didPoolRes,
+		didPoolStartPhoneNumber,
+		didPoolEndPhoneNumber,
+		nullValue, // No description
+		nullValue, // No comments
+		nullValue, // No provider
+	})
+	// The IVR must not manage its dnis
+	ivrConfig := fmt.Sprintf(`resource "genesyscloud_architect_ivr" "%s" {
+		name = "%s"
+	}
+	`, ivrConfigRes, ivrConfigName)
+	user := GenerateBasicUserResource(userRes, userEmail, "Terraform DID User")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Assign to an IVR
+				Config: didPool + ivrConfig + generateDidAssignmentResource(
+					didAssignmentRes,
+					didPhoneNumber,
+					didOwnerIvr,
+					"genesyscloud_architect_ivr."+ivrConfigRes+".id",
+					"genesyscloud_telephony_providers_edges_did_pool."+didPoolRes+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_did_assignment."+didAssignmentRes, "phone_number", didPhoneNumber),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_did_assignment."+didAssignmentRes, "owner_type", didOwnerIvr),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_did_assignment."+didAssignmentRes, "owner_id", "genesyscloud_architect_ivr."+ivrConfigRes, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_did_assignment."+didAssignmentRes, "did_pool_id", "genesyscloud_telephony_providers_edges_did_pool."+didPoolRes, "id"),
+				),
+			},
+			{
+				// Number outside of the pool range is rejected at plan time
+				Config: didPool + ivrConfig + generateDidAssignmentResource(
+					didAssignmentRes,
+					"+14175550034",
+					didOwnerIvr,
+					"genesyscloud_architect_ivr."+ivrConfigRes+".id",
+					"genesyscloud_telephony_providers_edges_did_pool."+didPoolRes+".id",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not in the range"),
+			},
+			{
+				// Move to a user
+				Config: didPool + ivrConfig + user + generateDidAssignmentResource(
+					didAssignmentRes,
+					didPhoneNumber,
+					didOwnerUser,
+					"genesyscloud_user."+userRes+".id",
+					nullValue,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_did_assignment."+didAssignmentRes, "owner_type", didOwnerUser),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_did_assignment."+didAssignmentRes, "owner_id", "genesyscloud_user."+userRes, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_did_assignment."+didAssignmentRes, "did_pool_id", "genesyscloud_telephony_providers_edges_did_pool."+didPoolRes, "id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_telephony_providers_edges_did_assignment." + didAssignmentRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyDidAssignmentsDestroyed,
+	})
+}
+
+func testVerifyDidAssignmentsDestroyed(state *terraform.State) error {
+	telephonyAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_telephony_providers_edges_did_assignment" {
+			continue
+		}
+
+		did, err := getDidByPhoneNumber(rs.Primary.ID, telephonyAPI)
+		if err != nil {
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+		if did != nil && did.Owner != nil {
+			return fmt.Errorf("DID (%s) is still assigned", rs.Primary.ID)
+		}
+	}
+	// Success. All DIDs unassigned
+	return nil
+}
+
+func generateDidAssignmentResource(
+	resourceID,
+	phoneNumber,
+	ownerType,
+	ownerId,
+	didPoolId string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_did_assignment" "%s" {
+		phone_number = "%s"
+		owner_type = "%s"
+		owner_id = %s
+		did_pool_id = %s
+	}
+	`, resourceID, phoneNumber, ownerType, ownerId, didPoolId)
+}
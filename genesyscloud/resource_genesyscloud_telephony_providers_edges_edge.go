@@ -0,0 +1,508 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+var (
+	edgeSoftwareUpdateResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Description: "The edge software version to update to, e.g. 2.0.5600.0. If not set, the latest release available to the edge is used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"download_start_time": {
+				Description:  "The time to start downloading the update, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"execute_start_time": {
+				Description:  "The start of the window in which the update can be applied, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"execute_stop_time": {
+				Description:  "The end of the window in which the update can be applied, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"execute_on_idle": {
+				Description: "Apply the update as soon as the edge is idle within the window.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"max_download_rate": {
+				Description:  "The maximum download rate of the update in Kbps. If not set, there is no limit.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"call_draining_wait_time_seconds": {
+				Description:  "How long to wait for active calls to drain before applying the update.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+
+	edgePhysicalInterfaceResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "ID of the physical interface. Used as the physical_adapter_id of logical interfaces.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the physical interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"friendly_name": {
+				Description: "Friendly name of the physical interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"hardware_address": {
+				Description: "Hardware (MAC) address of the physical interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"port_label": {
+				Description: "Label of the port on the edge device.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+)
+
+func resourceEdge() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Edge. Registers an edge device with a site. Network settings are managed with the genesyscloud_telephony_providers_edges_edge_logical_interface resource.",
+
+		CreateContext: createWithPooledClient(createEdge),
+		ReadContext:   readWithPooledClient(readEdge),
+		UpdateContext: updateWithPooledClient(updateEdge),
+		DeleteContext: deleteWithPooledClient(deleteEdge),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the edge.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The edge description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"site_id": {
+				Description: "The site to which the edge is assigned.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"edge_group_id": {
+				Description: "The edge group of the edge. If not set, the edge is not added to an edge group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"edge_deployment_type": {
+				Description:  "The deployment type of the edge. Changing the deployment type requires the edge to be recreated.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HARDWARE",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"HARDWARE", "LDM", "MMS", "LDM_AND_MMS"}, false),
+			},
+			"pairing_id": {
+				Description: "The pairing ID of a hardware edge in the format 00000-00000-00000-00000-00000. Required when edge_deployment_type is HARDWARE.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"proxy": {
+				Description: "HTTP proxy used by the edge on the WAN port. Can be a hostname, FQDN, IPv4 or IPv6 address. If no port is included, port 80 is used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"software_update": {
+				Description: "Schedules a software update of the edge within a window. The update is scheduled when this block is added or changed and is not read back once it has been applied.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        edgeSoftwareUpdateResource,
+			},
+			"state": {
+				Description: "Indicates if the edge is active, inactive, or deleted.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status_code": {
+				Description: "The current status of the edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"online_status": {
+				Description: "The online status of the edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"software_version": {
+				Description: "The software version running on the edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"serial_number": {
+				Description: "The serial number of the edge device.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"physical_interfaces": {
+				Description: "The physical network interfaces of the edge device.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        edgePhysicalInterfaceResource,
+			},
+		},
+	}
+}
+
+func getAllEdges(_ context.Context, sdkConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(ResourceIDMetaMap)
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		edges, _, getErr := edgesAPI.GetTelephonyProvidersEdges(pageSize, pageNum, "", "", "", "", false, false)
+		if getErr != nil {
+			return nil, diag.Errorf("Failed to get page of edges: %v", getErr)
+		}
+
+		if edges.Entities == nil || len(*edges.Entities) == 0 {
+			break
+		}
+
+		for _, edge := range *edges.Entities {
+			if edge.State != nil && *edge.State != "deleted" {
+				resources[*edge.Id] = &ResourceMeta{Name: *edge.Name}
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+func edgeExporter() *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(getAllEdges),
+		RefAttrs: map[string]*RefAttrSettings{
+			"site_id":       {RefType: "genesyscloud_telephony_providers_edges_site"},
+			"edge_group_id": {RefType: "genesyscloud_telephony_providers_edges_edge_group"},
+		},
+		ExcludedAttributes: []string{
+			"state",
+			"status_code",
+			"online_status",
+			"software_version",
+			"serial_number",
+			"physical_interfaces",
+		},
+	}
+}
+
+func createEdge(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	edge := platformclientv2.Edge{
+		Name:               &name,
+		Description:        platformclientv2.String(d.Get("description").(string)),
+		EdgeDeploymentType: platformclientv2.String(d.Get("edge_deployment_type").(string)),
+		PairingId:          buildSdkEdgeOptionalString(d, "pairing_id"),
+		Proxy:              buildSdkEdgeOptionalString(d, "proxy"),
+		Site:               &platformclientv2.Site{Id: platformclientv2.String(d.Get("site_id").(string))},
+	}
+	if edgeGroupId, ok := d.GetOk("edge_group_id"); ok {
+		edge.EdgeGroup = &platformclientv2.Edgegroup{Id: platformclientv2.String(edgeGroupId.(string))}
+	}
+
+	log.Printf("Creating edge %s", name)
+	createdEdge, _, err := edgesAPI.PostTelephonyProvidersEdges(edge)
+	if err != nil {
+		return diag.Errorf("Failed to create edge %s: %s", name, err)
+	}
+
+	d.SetId(*createdEdge.Id)
+	log.Printf("Created edge %s", *createdEdge.Id)
+
+	if diagErr := scheduleEdgeSoftwareUpdate(d, edgesAPI); diagErr != nil {
+		return diagErr
+	}
+
+	return readEdge(ctx, d, meta)
+}
+
+func updateEdge(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	if d.HasChanges("name", "description", "site_id", "edge_group_id", "proxy") {
+		diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			// Start from the current edge so settings managed elsewhere, such as trunk base assignments, are kept
+			edge, resp, getErr := edgesAPI.GetTelephonyProvidersEdge(d.Id(), nil)
+			if getErr != nil {
+				return resp, diag.Errorf("Failed to read edge %s: %s", d.Id(), getErr)
+			}
+
+			edge.Name = &name
+			edge.Description = platformclientv2.String(d.Get("description").(string))
+			edge.Proxy = buildSdkEdgeOptionalString(d, "proxy")
+			edge.Site = &platformclientv2.Site{Id: platformclientv2.String(d.Get("site_id").(string))}
+			if edgeGroupId := d.Get("edge_group_id").(string); edgeGroupId == "" {
+				edge.EdgeGroup = nil
+			} else if edge.EdgeGroup == nil || edge.EdgeGroup.Id == nil || *edge.EdgeGroup.Id != edgeGroupId {
+				edge.EdgeGroup = &platformclientv2.Edgegroup{Id: &edgeGroupId}
+			}
+
+			log.Printf("Updating edge %s", name)
+			_, resp, putErr := edgesAPI.PutTelephonyProvidersEdge(d.Id(), *edge)
+			if putErr != nil {
+				return resp, diag.Errorf("Failed to update edge %s: %s", name, putErr)
+			}
+			return resp, nil
+		})
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	if d.HasChange("software_update") {
+		if diagErr := scheduleEdgeSoftwareUpdate(d, edgesAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Updated edge %s", d.Id())
+	return readEdge(ctx, d, meta)
+}
+
+func readEdge(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Reading edge %s", d.Id())
+	return withRetriesForRead(ctx, d, func() *resource.RetryError {
+		edge, resp, getErr := edgesAPI.GetTelephonyProvidersEdge(d.Id(), nil)
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to read edge %s: %s", d.Id(), getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read edge %s: %s", d.Id(), getErr))
+		}
+
+		physicalInterfaces, _, getErr := edgesAPI.GetTelephonyProvidersEdgePhysicalinterfaces(d.Id())
+		if getErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("Failed to read physical interfaces of edge %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceEdge())
+		d.Set("name", *edge.Name)
+		d.Set("description", edge.Description)
+		d.Set("proxy", edge.Proxy)
+		d.Set("state", edge.State)
+		d.Set("status_code", edge.StatusCode)
+		d.Set("online_status", edge.OnlineStatus)
+		d.Set("software_version", edge.SoftwareVersion)
+		d.Set("serial_number", edge.SerialNumber)
+		if edge.EdgeDeploymentType != nil {
+			d.Set("edge_deployment_type", *edge.EdgeDeploymentType)
+		}
+		if edge.PairingId != nil {
+			d.Set("pairing_id", *edge.PairingId)
+		}
+		if edge.Site != nil {
+			d.Set("site_id", *edge.Site.Id)
+		}
+		if edge.EdgeGroup != nil && edge.EdgeGroup.Id != nil {
+			d.Set("edge_group_id", *edge.EdgeGroup.Id)
+		} else {
+			d.Set("edge_group_id", nil)
+		}
+		d.Set("physical_interfaces", flattenEdgePhysicalInterfaces(physicalInterfaces.Entities))
+
+		log.Printf("Read edge %s %s", d.Id(), *edge.Name)
+		return cc.CheckState()
+	})
+}
+
+func deleteEdge(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Deleting edge %s", d.Id())
+	_, err := edgesAPI.DeleteTelephonyProvidersEdge(d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to delete edge %s: %s", d.Id(), err)
+	}
+
+	return withRetries(ctx, 60*time.Second, func() *resource.RetryError {
+		edge, resp, err := edgesAPI.GetTelephonyProvidersEdge(d.Id(), nil)
+		if err != nil {
+			if isStatus404(resp) {
+				log.Printf("Deleted edge %s", d.Id())
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error deleting edge %s: %s", d.Id(), err))
+		}
+
+		if edge.State != nil && *edge.State == "deleted" {
+			log.Printf("Deleted edge %s", d.Id())
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Edge %s still exists", d.Id()))
+	})
+}
+
+// scheduleEdgeSoftwareUpdate schedules the software update configured in the software_update block, if any
+func scheduleEdgeSoftwareUpdate(d *schema.ResourceData, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) diag.Diagnostics {
+	updates := d.Get("software_update").([]interface{})
+	if len(updates) == 0 || updates[0] == nil {
+		return nil
+	}
+	updateMap := updates[0].(map[string]interface{})
+
+	version, diagErr := getEdgeSoftwareVersion(d.Id(), updateMap["version"].(string), edgesAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	executeOnIdle := updateMap["execute_on_idle"].(bool)
+	update := platformclientv2.Domainedgesoftwareupdatedto{
+		Version:       version,
+		ExecuteOnIdle: &executeOnIdle,
+	}
+	if maxDownloadRate := updateMap["max_download_rate"].(int); maxDownloadRate > 0 {
+		update.MaxDownloadRate = &maxDownloadRate
+	}
+	if waitTime := updateMap["call_draining_wait_time_seconds"].(int); waitTime > 0 {
+		update.CallDrainingWaitTimeSeconds = &waitTime
+	}
+
+	var err error
+	if update.DownloadStartTime, err = parseEdgeUpdateTime(updateMap["download_start_time"].(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if update.ExecuteStartTime, err = parseEdgeUpdateTime(updateMap["execute_start_time"].(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if update.ExecuteStopTime, err = parseEdgeUpdateTime(updateMap["execute_stop_time"].(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if update.ExecuteStartTime != nil && update.ExecuteStopTime != nil && !update.ExecuteStopTime.After(*update.ExecuteStartTime) {
+		return diag.Errorf("software_update execute_stop_time must be after execute_start_time")
+	}
+
+	log.Printf("Scheduling software update %s of edge %s", *version.EdgeVersion, d.Id())
+	_, _, err = edgesAPI.PostTelephonyProvidersEdgeSoftwareupdate(d.Id(), update)
+	if err != nil {
+		return diag.Errorf("Failed to schedule software update of edge %s: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// getEdgeSoftwareVersion finds the requested software version among the versions available to the edge.
+// The latest release is returned if no version is requested.
+func getEdgeSoftwareVersion(edgeId, version string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) (*platformclientv2.Domainedgesoftwareversiondto, diag.Diagnostics) {
+	versions, _, err := edgesAPI.GetTelephonyProvidersEdgeSoftwareversions(edgeId)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get software versions of edge %s: %s", edgeId, err)
+	}
+
+	if versions.Entities != nil {
+		for _, v := range *versions.Entities {
+			if v.EdgeVersion == nil {
+				continue
+			}
+			if (version == "" && v.LatestRelease != nil && *v.LatestRelease) || *v.EdgeVersion == version {
+				return &v, nil
+			}
+		}
+	}
+
+	if version == "" {
+		return nil, diag.Errorf("No software release is available for edge %s", edgeId)
+	}
+	return nil, diag.Errorf("Software version %s is not available for edge %s", version, edgeId)
+}
+
+func parseEdgeUpdateTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse software update time %s: %s", value, err)
+	}
+	return &t, nil
+}
+
+func buildSdkEdgeOptionalString(d *schema.ResourceData, attr string) *string {
+	if value, ok := d.GetOk(attr); ok {
+		return platformclientv2.String(value.(string))
+	}
+	return nil
+}
+
+func flattenEdgePhysicalInterfaces(physicalInterfaces *[]platformclientv2.Domainphysicalinterface) []interface{} {
+	if physicalInterfaces == nil {
+		return nil
+	}
+
+	interfaces := make([]interface{}, 0, len(*physicalInterfaces))
+	for _, physicalInterface := range *physicalInterfaces {
+		interfaceMap := make(map[string]interface{})
+		if physicalInterface.Id != nil {
+			interfaceMap["id"] = *physicalInterface.Id
+		}
+		if physicalInterface.Name != nil {
+			interfaceMap["name"] = *physicalInterface.Name
+		}
+		if physicalInterface.FriendlyName != nil {
+			interfaceMap["friendly_name"] = *physicalInterface.FriendlyName
+		}
+		if physicalInterface.HardwareAddress != nil {
+			interfaceMap["hardware_address"] = *physicalInterface.HardwareAddress
+		}
+		if physicalInterface.PortLabel != nil {
+			interfaceMap["port_label"] = *physicalInterface.PortLabel
+		}
+		interfaces = append(interfaces, interfaceMap)
+	}
+	return interfaces
+}
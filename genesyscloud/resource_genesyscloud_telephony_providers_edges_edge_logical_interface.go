@@ -0,0 +1,609 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+var (
+	logicalInterfaceAddressResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:  "The type of address. Use dhcp to have the address assigned by DHCP.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ip", "dhcp"}, false),
+			},
+			"address": {
+				Description: "An IPv4 or IPv6 address in CIDR format, e.g. 10.0.0.5/24. Required when type is ip.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+
+	logicalInterfaceRouteResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Description:  "The IPv4 or IPv6 route prefix in CIDR notation.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"nexthop": {
+				Description:  "The IPv4 or IPv6 next hop IP address.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"metric": {
+				Description: "The metric of the route. Lower values have a higher priority.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+	}
+
+	logicalInterfaceCapabilitiesResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Description: "True if the IP version is enabled on the interface.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"dhcp": {
+				Description: "True if addresses of the IP version are assigned by DHCP.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"metric": {
+				Description: "The interface metric. Only used when auto_metric is false.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"auto_metric": {
+				Description: "True if the interface metric is assigned automatically.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"ping_enabled": {
+				Description: "True if the interface responds to ping.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+)
+
+func resourceEdgeLogicalInterface() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Edge Logical Interface. Configures the IP settings and trunk assignments of a physical interface of an edge. The ID of the resource is made up of the logical interface ID and the edge ID in the format <interface ID>,<edge ID>.",
+
+		CreateContext: createWithPooledClient(createEdgeLogicalInterface),
+		ReadContext:   readWithPooledClient(readEdgeLogicalInterface),
+		UpdateContext: updateWithPooledClient(updateEdgeLogicalInterface),
+		DeleteContext: deleteWithPooledClient(deleteEdgeLogicalInterface),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"edge_id": {
+				Description: "The edge of the logical interface. Changing the edge_id attribute will cause the logical interface to be dropped and recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"physical_adapter_id": {
+				Description: "The ID of the physical interface of the edge. See the physical_interfaces attribute of genesyscloud_telephony_providers_edges_edge. Changing the physical_adapter_id attribute will cause the logical interface to be dropped and recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the logical interface. This property is read only and populated by the edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"friendly_name": {
+				Description: "Friendly name of the logical interface.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"vlan_tag_id": {
+				Description:  "The VLAN tag of the logical interface. If not set, the interface is untagged.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"addresses": {
+				Description: "The IP addresses of the interface. The priority of DNS addresses is based on their order in the list.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        logicalInterfaceAddressResource,
+			},
+			"routes": {
+				Description: "The routes assigned to the interface.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        logicalInterfaceRouteResource,
+			},
+			"ipv4_capabilities": {
+				Description: "IPv4 settings of the interface.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        logicalInterfaceCapabilitiesResource,
+			},
+			"ipv6_capabilities": {
+				Description: "IPv6 settings of the interface.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        logicalInterfaceCapabilitiesResource,
+			},
+			"public_nat_address_ipv4": {
+				Description:  "Public IPv4 NAT address of the interface.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"public_nat_address_ipv6": {
+				Description:  "Public IPv6 NAT address of the interface.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv6Address,
+			},
+			"use_for_wan_interface": {
+				Description: "Use the interface for all communication with the internet.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"use_for_internal_edge_communication": {
+				Description: "Use the interface for internal edge-to-edge communication, using the trunk base settings assigned to the edge group.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"use_for_indirect_edge_communication": {
+				Description: "Use the public NAT address of the interface for site interconnects using the Indirect method.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"use_for_cloud_proxy_edge_communication": {
+				Description: "Use the interface for site interconnects using the Cloud Proxy method.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"inherit_phone_trunk_bases_ipv4": {
+				Description: "Inherit the IPv4 phone trunk base settings of the edge group.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"inherit_phone_trunk_bases_ipv6": {
+				Description: "Inherit the IPv6 phone trunk base settings of the edge group.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"external_trunk_base_settings_ids": {
+				Description: "IDs of trunk base settings of trunkType \"EXTERNAL\" used for external communication from the interface.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"phone_trunk_base_settings_ids": {
+				Description: "IDs of trunk base settings of trunkType \"PHONE\" used for phone communication from the interface. Ignored when the phone trunk base settings are inherited from the edge group.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"hardware_address": {
+				Description: "Hardware (MAC) address of the interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"current_state": {
+				Description: "The current state of the interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getAllEdgeLogicalInterfaces(_ context.Context, sdkConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(ResourceIDMetaMap)
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		edges, _, getErr := edgesAPI.GetTelephonyProvidersEdges(pageSize, pageNum, "", "", "", "", false, false)
+		if getErr != nil {
+			return nil, diag.Errorf("Failed to get page of edges: %v", getErr)
+		}
+
+		if edges.Entities == nil || len(*edges.Entities) == 0 {
+			break
+		}
+
+		for _, edge := range *edges.Entities {
+			if edge.State != nil && *edge.State == "deleted" {
+				continue
+			}
+
+			logicalInterfaces, _, getErr := edgesAPI.GetTelephonyProvidersEdgeLogicalinterfaces(*edge.Id, nil)
+			if getErr != nil {
+				return nil, diag.Errorf("Failed to get logical interfaces of edge %s: %v", *edge.Id, getErr)
+			}
+			if logicalInterfaces.Entities == nil {
+				continue
+			}
+			for _, logicalInterface := range *logicalInterfaces.Entities {
+				if logicalInterface.State != nil && *logicalInterface.State == "deleted" {
+					continue
+				}
+				id := buildEdgeLogicalInterfaceId(*logicalInterface.Id, *edge.Id)
+				resources[id] = &ResourceMeta{Name: *edge.Name + "_" + *logicalInterface.Name}
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+func edgeLogicalInterfaceExporter() *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(getAllEdgeLogicalInterfaces),
+		RefAttrs: map[string]*RefAttrSettings{
+			"edge_id":                          {RefType: "genesyscloud_telephony_providers_edges_edge"},
+			"external_trunk_base_settings_ids": {RefType: "genesyscloud_telephony_providers_edges_trunkbasesettings"},
+			"phone_trunk_base_settings_ids":    {RefType: "genesyscloud_telephony_providers_edges_trunkbasesettings"},
+		},
+		ExcludedAttributes: []string{
+			"name",
+			"hardware_address",
+			"current_state",
+		},
+	}
+}
+
+// The ID of a logical interface is made up of the interface ID and the edge ID so it can be imported
+func buildEdgeLogicalInterfaceId(interfaceId, edgeId string) string {
+	return fmt.Sprintf("%s,%s", interfaceId, edgeId)
+}
+
+func parseEdgeLogicalInterfaceId(id string) (string, string, error) {
+	ids := strings.Split(id, ",")
+	if len(ids) != 2 {
+		return "", "", fmt.Errorf("Invalid logical interface ID %s. Expected <interface ID>,<edge ID>", id)
+	}
+	return ids[0], ids[1], nil
+}
+
+func createEdgeLogicalInterface(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	edgeId := d.Get("edge_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	logicalInterface := buildSdkEdgeLogicalInterface(d)
+	logicalInterface.PhysicalAdapterId = platformclientv2.String(d.Get("physical_adapter_id").(string))
+
+	log.Printf("Creating logical interface on edge %s", edgeId)
+	createdInterface, _, err := edgesAPI.PostTelephonyProvidersEdgeLogicalinterfaces(edgeId, *logicalInterface)
+	if err != nil {
+		return diag.Errorf("Failed to create logical interface on edge %s: %s", edgeId, err)
+	}
+
+	d.SetId(buildEdgeLogicalInterfaceId(*createdInterface.Id, edgeId))
+	log.Printf("Created logical interface %s on edge %s", *createdInterface.Id, edgeId)
+
+	return readEdgeLogicalInterface(ctx, d, meta)
+}
+
+func updateEdgeLogicalInterface(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	interfaceId, edgeId, err := parseEdgeLogicalInterfaceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	logicalInterface := buildSdkEdgeLogicalInterface(d)
+	diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		currentInterface, resp, getErr := edgesAPI.GetTelephonyProvidersEdgeLogicalinterface(edgeId, interfaceId, nil)
+		if getErr != nil {
+			return resp, diag.Errorf("Failed to read logical interface %s: %s", interfaceId, getErr)
+		}
+		logicalInterface.Id = &interfaceId
+		logicalInterface.Name = currentInterface.Name
+		logicalInterface.Version = currentInterface.Version
+		logicalInterface.PhysicalAdapterId = currentInterface.PhysicalAdapterId
+
+		log.Printf("Updating logical interface %s on edge %s", interfaceId, edgeId)
+		_, resp, putErr := edgesAPI.PutTelephonyProvidersEdgeLogicalinterface(edgeId, interfaceId, *logicalInterface)
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update logical interface %s: %s", interfaceId, putErr)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated logical interface %s on edge %s", interfaceId, edgeId)
+	return readEdgeLogicalInterface(ctx, d, meta)
+}
+
+func readEdgeLogicalInterface(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	interfaceId, edgeId, err := parseEdgeLogicalInterfaceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Reading logical interface %s on edge %s", interfaceId, edgeId)
+	return withRetriesForRead(ctx, d, func() *resource.RetryError {
+		logicalInterface, resp, getErr := edgesAPI.GetTelephonyProvidersEdgeLogicalinterface(edgeId, interfaceId, nil)
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to read logical interface %s: %s", interfaceId, getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read logical interface %s: %s", interfaceId, getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceEdgeLogicalInterface())
+		d.Set("edge_id", edgeId)
+		d.Set("physical_adapter_id", logicalInterface.PhysicalAdapterId)
+		d.Set("name", logicalInterface.Name)
+		d.Set("friendly_name", logicalInterface.FriendlyName)
+		d.Set("vlan_tag_id", logicalInterface.VlanTagId)
+		d.Set("public_nat_address_ipv4", logicalInterface.PublicNatAddressIpV4)
+		d.Set("public_nat_address_ipv6", logicalInterface.PublicNatAddressIpV6)
+		d.Set("addresses", flattenLogicalInterfaceAddresses(logicalInterface.Addresses))
+		d.Set("routes", flattenLogicalInterfaceRoutes(logicalInterface.Routes))
+		d.Set("ipv4_capabilities", flattenLogicalInterfaceCapabilities(logicalInterface.Ipv4Capabilities))
+		d.Set("ipv6_capabilities", flattenLogicalInterfaceCapabilities(logicalInterface.Ipv6Capabilities))
+		d.Set("use_for_wan_interface", getBoolOrFalse(logicalInterface.UseForWanInterface))
+		d.Set("use_for_internal_edge_communication", getBoolOrFalse(logicalInterface.UseForInternalEdgeCommunication))
+		d.Set("use_for_indirect_edge_communication", getBoolOrFalse(logicalInterface.UseForIndirectEdgeCommunication))
+		d.Set("use_for_cloud_proxy_edge_communication", getBoolOrFalse(logicalInterface.UseForCloudProxyEdgeCommunication))
+		if logicalInterface.InheritPhoneTrunkBasesIPv4 != nil {
+			d.Set("inherit_phone_trunk_bases_ipv4", *logicalInterface.InheritPhoneTrunkBasesIPv4)
+		}
+		if logicalInterface.InheritPhoneTrunkBasesIPv6 != nil {
+			d.Set("inherit_phone_trunk_bases_ipv6", *logicalInterface.InheritPhoneTrunkBasesIPv6)
+		}
+		d.Set("external_trunk_base_settings_ids", flattenTrunkBaseAssignmentIds(logicalInterface.ExternalTrunkBaseAssignments))
+		d.Set("phone_trunk_base_settings_ids", flattenTrunkBaseAssignmentIds(logicalInterface.PhoneTrunkBaseAssignments))
+		d.Set("hardware_address", logicalInterface.HardwareAddress)
+		d.Set("current_state", logicalInterface.CurrentState)
+
+		log.Printf("Read logical interface %s on edge %s", interfaceId, edgeId)
+		return cc.CheckState()
+	})
+}
+
+func deleteEdgeLogicalInterface(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	interfaceId, edgeId, err := parseEdgeLogicalInterfaceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Deleting logical interface %s on edge %s", interfaceId, edgeId)
+	resp, err := edgesAPI.DeleteTelephonyProvidersEdgeLogicalinterface(edgeId, interfaceId)
+	if err != nil {
+		if isStatus404(resp) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete logical interface %s: %s", interfaceId, err)
+	}
+
+	return withRetries(ctx, 30*time.Second, func() *resource.RetryError {
+		logicalInterface, resp, err := edgesAPI.GetTelephonyProvidersEdgeLogicalinterface(edgeId, interfaceId, nil)
+		if err != nil {
+			if isStatus404(resp) {
+				log.Printf("Deleted logical interface %s", interfaceId)
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error deleting logical interface %s: %s", interfaceId, err))
+		}
+
+		if logicalInterface.State != nil && *logicalInterface.State == "deleted" {
+			log.Printf("Deleted logical interface %s", interfaceId)
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Logical interface %s still exists", interfaceId))
+	})
+}
+
+func buildSdkEdgeLogicalInterface(d *schema.ResourceData) *platformclientv2.Domainlogicalinterface {
+	logicalInterface := &platformclientv2.Domainlogicalinterface{
+		FriendlyName:                      buildSdkEdgeOptionalString(d, "friendly_name"),
+		PublicNatAddressIpV4:              buildSdkEdgeOptionalString(d, "public_nat_address_ipv4"),
+		PublicNatAddressIpV6:              buildSdkEdgeOptionalString(d, "public_nat_address_ipv6"),
+		Addresses:                         buildSdkLogicalInterfaceAddresses(d.Get("addresses").([]interface{})),
+		Routes:                            buildSdkLogicalInterfaceRoutes(d.Get("routes").([]interface{})),
+		Ipv4Capabilities:                  buildSdkLogicalInterfaceCapabilities(d.Get("ipv4_capabilities").([]interface{})),
+		Ipv6Capabilities:                  buildSdkLogicalInterfaceCapabilities(d.Get("ipv6_capabilities").([]interface{})),
+		UseForWanInterface:                platformclientv2.Bool(d.Get("use_for_wan_interface").(bool)),
+		UseForInternalEdgeCommunication:   platformclientv2.Bool(d.Get("use_for_internal_edge_communication").(bool)),
+		UseForIndirectEdgeCommunication:   platformclientv2.Bool(d.Get("use_for_indirect_edge_communication").(bool)),
+		UseForCloudProxyEdgeCommunication: platformclientv2.Bool(d.Get("use_for_cloud_proxy_edge_communication").(bool)),
+		InheritPhoneTrunkBasesIPv4:        platformclientv2.Bool(d.Get("inherit_phone_trunk_bases_ipv4").(bool)),
+		InheritPhoneTrunkBasesIPv6:        platformclientv2.Bool(d.Get("inherit_phone_trunk_bases_ipv6").(bool)),
+		ExternalTrunkBaseAssignments:      buildSdkTrunkBaseAssignments(d.Get("external_trunk_base_settings_ids").(*schema.Set)),
+		PhoneTrunkBaseAssignments:         buildSdkTrunkBaseAssignments(d.Get("phone_trunk_base_settings_ids").(*schema.Set)),
+	}
+	if vlanTagId, ok := d.GetOk("vlan_tag_id"); ok {
+		logicalInterface.VlanTagId = platformclientv2.Int(vlanTagId.(int))
+	}
+	return logicalInterface
+}
+
+func buildSdkLogicalInterfaceAddresses(addresses []interface{}) *[]platformclientv2.Domainnetworkaddress {
+	sdkAddresses := make([]platformclientv2.Domainnetworkaddress, 0, len(addresses))
+	for _, addressI := range addresses {
+		addressMap := addressI.(map[string]interface{})
+		sdkAddress := platformclientv2.Domainnetworkaddress{
+			VarType: platformclientv2.String(addressMap["type"].(string)),
+		}
+		if address := addressMap["address"].(string); address != "" {
+			sdkAddress.Address = &address
+		}
+		sdkAddresses = append(sdkAddresses, sdkAddress)
+	}
+	return &sdkAddresses
+}
+
+func buildSdkLogicalInterfaceRoutes(routes []interface{}) *[]platformclientv2.Domainnetworkroute {
+	sdkRoutes := make([]platformclientv2.Domainnetworkroute, 0, len(routes))
+	for _, routeI := range routes {
+		routeMap := routeI.(map[string]interface{})
+		sdkRoute := platformclientv2.Domainnetworkroute{
+			Prefix:  platformclientv2.String(routeMap["prefix"].(string)),
+			Nexthop: platformclientv2.String(routeMap["nexthop"].(string)),
+		}
+		if metric := routeMap["metric"].(int); metric != 0 {
+			sdkRoute.Metric = &metric
+		}
+		sdkRoutes = append(sdkRoutes, sdkRoute)
+	}
+	return &sdkRoutes
+}
+
+func buildSdkLogicalInterfaceCapabilities(capabilities []interface{}) *platformclientv2.Domaincapabilities {
+	if len(capabilities) == 0 || capabilities[0] == nil {
+		return nil
+	}
+	capabilitiesMap := capabilities[0].(map[string]interface{})
+	sdkCapabilities := &platformclientv2.Domaincapabilities{
+		Enabled:     platformclientv2.Bool(capabilitiesMap["enabled"].(bool)),
+		Dhcp:        platformclientv2.Bool(capabilitiesMap["dhcp"].(bool)),
+		AutoMetric:  platformclientv2.Bool(capabilitiesMap["auto_metric"].(bool)),
+		PingEnabled: platformclientv2.Bool(capabilitiesMap["ping_enabled"].(bool)),
+	}
+	if metric := capabilitiesMap["metric"].(int); metric != 0 {
+		sdkCapabilities.Metric = &metric
+	}
+	return sdkCapabilities
+}
+
+func buildSdkTrunkBaseAssignments(trunkBaseIds *schema.Set) *[]platformclientv2.Trunkbaseassignment {
+	assignments := make([]platformclientv2.Trunkbaseassignment, 0)
+	for _, trunkBaseId := range *setToStringList(trunkBaseIds) {
+		id := trunkBaseId
+		assignments = append(assignments, platformclientv2.Trunkbaseassignment{
+			TrunkBase: &platformclientv2.Trunkbase{Id: &id},
+		})
+	}
+	return &assignments
+}
+
+func flattenLogicalInterfaceAddresses(addresses *[]platformclientv2.Domainnetworkaddress) []interface{} {
+	if addresses == nil {
+		return nil
+	}
+	flattened := make([]interface{}, 0, len(*addresses))
+	for _, address := range *addresses {
+		if address.Persistent != nil && !*address.Persistent && (address.VarType == nil || *address.VarType != "dhcp") {
+			// Addresses assigned by DHCP are not part of the configuration
+			continue
+		}
+		addressMap := make(map[string]interface{})
+		if address.VarType != nil {
+			addressMap["type"] = *address.VarType
+		}
+		if address.Address != nil {
+			addressMap["address"] = *address.Address
+		}
+		flattened = append(flattened, addressMap)
+	}
+	return flattened
+}
+
+func flattenLogicalInterfaceRoutes(routes *[]platformclientv2.Domainnetworkroute) []interface{} {
+	if routes == nil {
+		return nil
+	}
+	flattened := make([]interface{}, 0, len(*routes))
+	for _, route := range *routes {
+		if route.Persistent != nil && !*route.Persistent {
+			// Routes assigned by DHCP are not part of the configuration
+			continue
+		}
+		routeMap := make(map[string]interface{})
+		if route.Prefix != nil {
+			routeMap["prefix"] = *route.Prefix
+		}
+		if route.Nexthop != nil {
+			routeMap["nexthop"] = *route.Nexthop
+		}
+		if route.Metric != nil {
+			routeMap["metric"] = *route.Metric
+		}
+		flattened = append(flattened, routeMap)
+	}
+	return flattened
+}
+
+func flattenLogicalInterfaceCapabilities(capabilities *platformclientv2.Domaincapabilities) []interface{} {
+	if capabilities == nil {
+		return nil
+	}
+	capabilitiesMap := map[string]interface{}{
+		"enabled":      getBoolOrFalse(capabilities.Enabled),
+		"dhcp":         getBoolOrFalse(capabilities.Dhcp),
+		"auto_metric":  getBoolOrFalse(capabilities.AutoMetric),
+		"ping_enabled": getBoolOrFalse(capabilities.PingEnabled),
+	}
+	if capabilities.Metric != nil {
+		capabilitiesMap["metric"] = *capabilities.Metric
+	}
+	return []interface{}{capabilitiesMap}
+}
+
+func flattenTrunkBaseAssignmentIds(assignments *[]platformclientv2.Trunkbaseassignment) *schema.Set {
+	ids := make([]interface{}, 0)
+	if assignments != nil {
+		for _, assignment := range *assignments {
+			if assignment.TrunkBase != nil && assignment.TrunkBase.Id != nil {
+				ids = append(ids, *assignment.TrunkBase.Id)
+			}
+		}
+	}
+	return schema.NewSet(schema.HashString, ids)
+}
+
+func getBoolOrFalse(value *bool) bool {
+	return value != nil && *value
+}
@@ -0,0 +1,141 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourceEdgeLogicalInterface(t *testing.T) {
+	t.Skip("Skipping because a paired edge device is needed to configure its interfaces")
+	var (
+		interfaceRes = "logicalInterface"
+		edgeId       = "edge-id"
+		adapterName  = "eth1"
+	)
+
+	physicalInterface := fmt.Sprintf(`data "genesyscloud_telephony_providers_edges_edge_physical_interface" "adapter" {
+		edge_id = "%s"
+		name = "%s"
+	}
+	`, edgeId, adapterName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with a static address
+				Config: physicalInterface + generateEdgeLogicalInterfaceResource(
+					interfaceRes,
+					strconv.Quote(edgeId),
+					"data.genesyscloud_telephony_providers_edges_edge_physical_interface.adapter.id",
+					`friendly_name = "Voice"`,
+					`vlan_tag_id = 100`,
+					generateLogicalInterfaceAddress("ip", "10.0.0.5/24"),
+					generateLogicalInterfaceRoute("0.0.0.0/0", "10.0.0.1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge_logical_interface."+interfaceRes, "edge_id", edgeId),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge_logical_interface."+interfaceRes, "friendly_name", "Voice"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge_logical_interface."+interfaceRes, "vlan_tag_id", "100"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge_logical_interface."+interfaceRes, "addresses.0.address", "10.0.0.5/24"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge_logical_interface."+interfaceRes, "routes.0.nexthop", "10.0.0.1"),
+				),
+			},
+			{
+				// Update to DHCP
+				Config: physicalInterface + generateEdgeLogicalInterfaceResource(
+					interfaceRes,
+					strconv.Quote(edgeId),
+					"data.genesyscloud_telephony_providers_edges_edge_physical_interface.adapter.id",
+					`friendly_name = "Voice DHCP"`,
+					`vlan_tag_id = 100`,
+					generateLogicalInterfaceAddress("dhcp", ""),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge_logical_interface."+interfaceRes, "friendly_name", "Voice DHCP"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge_logical_interface."+interfaceRes, "addresses.0.type", "dhcp"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge_logical_interface."+interfaceRes, "routes.#", "0"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_telephony_providers_edges_edge_logical_interface." + interfaceRes,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"current_state"},
+			},
+		},
+		CheckDestroy: testVerifyEdgeLogicalInterfacesDestroyed,
+	})
+}
+
+func testVerifyEdgeLogicalInterfacesDestroyed(state *terraform.State) error {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_telephony_providers_edges_edge_logical_interface" {
+			continue
+		}
+
+		interfaceId, edgeId, err := parseEdgeLogicalInterfaceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		logicalInterface, resp, err := edgesAPI.GetTelephonyProvidersEdgeLogicalinterface(edgeId, interfaceId, nil)
+		if logicalInterface != nil {
+			if logicalInterface.State != nil && *logicalInterface.State == "deleted" {
+				continue
+			}
+			return fmt.Errorf("Logical interface (%s) still exists", rs.Primary.ID)
+		} else if isStatus404(resp) {
+			// Logical interface not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All logical interfaces destroyed
+	return nil
+}
+
+func generateEdgeLogicalInterfaceResource(
+	interfaceRes,
+	edgeId,
+	physicalAdapterId string,
+	otherAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_edge_logical_interface" "%s" {
+		edge_id = %s
+		physical_adapter_id = %s
+		%s
+	}
+	`, interfaceRes, edgeId, physicalAdapterId, strings.Join(otherAttrs, "\n"))
+}
+
+func generateLogicalInterfaceAddress(addressType, address string) string {
+	if address == "" {
+		return fmt.Sprintf(`addresses {
+			type = "%s"
+		}
+		`, addressType)
+	}
+	return fmt.Sprintf(`addresses {
+		type = "%s"
+		address = "%s"
+	}
+	`, addressType, address)
+}
+
+func generateLogicalInterfaceRoute(prefix, nexthop string) string {
+	return fmt.Sprintf(`routes {
+		prefix = "%s"
+		nexthop = "%s"
+	}
+	`, prefix, nexthop)
+}
@@ -0,0 +1,151 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourceEdge(t *testing.T) {
+	t.Skip("Skipping because an unpaired edge device is needed to register an edge")
+	var (
+		edgeRes      = "edge"
+		name1        = "test edge " + uuid.NewString()
+		name2        = "test edge " + uuid.NewString()
+		description1 = "TestAccResourceEdge description 1"
+		description2 = "TestAccResourceEdge description 2"
+		pairingId    = "00000-00000-00000-00000-00000"
+
+		siteRes     = "site"
+		locationRes = "location"
+	)
+
+	emergencyNumber := "+13173114122"
+	if err := authorizeSdk(); err != nil {
+		t.Fatal(err)
+	}
+	if err := deleteLocationWithNumber(emergencyNumber); err != nil {
+		t.Fatal(err)
+	}
+
+	locationConfig := generateLocationResource(
+		locationRes,
+		"Terraform location"+uuid.NewString(),
+		"HQ1",
+		[]string{},
+		generateLocationEmergencyNum(
+			emergencyNumber,
+			nullValue, // Default number type
+		), generateLocationAddress(
+			"0176 Interactive Way",
+			"Indianapolis",
+			"IN",
+			"US",
+			"46279",
+		))
+
+	siteConfig := generateSiteResourceWithCustomAttrs(
+		siteRes,
+		"tf site "+uuid.NewString(),
+		"test site description",
+		"genesyscloud_location."+locationRes+".id",
+		"Premises",
+		false,
+		`["us-east-1"]`,
+		nullValue,
+		nullValue,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: locationConfig + siteConfig + generateEdgeResource(
+					edgeRes,
+					name1,
+					description1,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					fmt.Sprintf(`pairing_id = "%s"`, pairingId),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge."+edgeRes, "name", name1),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge."+edgeRes, "description", description1),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge."+edgeRes, "edge_deployment_type", "HARDWARE"),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_edge."+edgeRes, "site_id", "genesyscloud_telephony_providers_edges_site."+siteRes, "id"),
+					resource.TestCheckResourceAttrSet("genesyscloud_telephony_providers_edges_edge."+edgeRes, "state"),
+				),
+			},
+			{
+				// Update
+				Config: locationConfig + siteConfig + generateEdgeResource(
+					edgeRes,
+					name2,
+					description2,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					fmt.Sprintf(`pairing_id = "%s"`, pairingId),
+					`proxy = "proxy.example.com:8080"`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge."+edgeRes, "name", name2),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge."+edgeRes, "description", description2),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_edge."+edgeRes, "proxy", "proxy.example.com:8080"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_telephony_providers_edges_edge." + edgeRes,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"software_update", "online_status", "status_code"},
+			},
+		},
+		CheckDestroy: testVerifyEdgesDestroyed,
+	})
+}
+
+func testVerifyEdgesDestroyed(state *terraform.State) error {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_telephony_providers_edges_edge" {
+			continue
+		}
+
+		edge, resp, err := edgesAPI.GetTelephonyProvidersEdge(rs.Primary.ID, nil)
+		if edge != nil {
+			if edge.State != nil && *edge.State == "deleted" {
+				continue
+			}
+			return fmt.Errorf("Edge (%s) still exists", rs.Primary.ID)
+		} else if isStatus404(resp) {
+			// Edge not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All edges destroyed
+	return nil
+}
+
+func generateEdgeResource(
+	edgeRes,
+	name,
+	description,
+	siteId string,
+	otherAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_edge" "%s" {
+		name = "%s"
+		description = "%s"
+		site_id = %s
+		%s
+	}
+	`, edgeRes, name, description, siteId, strings.Join(otherAttrs, "\n"))
+}
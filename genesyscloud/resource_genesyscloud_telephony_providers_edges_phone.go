@@ -204,13 +204,7 @@ func createPhone(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 
 	if isStandalone {
-		createPhone.Properties = &map[string]interface{}{
-			"phone_standalone": &map[string]interface{}{
-				"value": &map[string]interface{}{
-					"instance": true,
-				},
-			},
-		}
+		createPhone.Properties = buildSdkPhoneStandaloneProperties()
 	}
 
 	if webRtcUserId != "" {
@@ -359,13 +353,7 @@ func updatePhone(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 
 	if isStandalone {
-		updatePhoneBody.Properties = &map[string]interface{}{
-			"phone_standalone": &map[string]interface{}{
-				"value": &map[string]interface{}{
-					"instance": true,
-				},
-			},
-		}
+		updatePhoneBody.Properties = buildSdkPhoneStandaloneProperties()
 	}
 
 	if webRtcUserId != "" {
@@ -457,6 +445,9 @@ func flattenPhoneLines(lines *[]platformclientv2.Line) []string {
 	for i := 0; i < len(*lines); i++ {
 		line := (*lines)[i]
 		did := ""
+		if line.Properties == nil {
+			continue
+		}
 		if k := (*line.Properties)["station_identity_address"]; k != nil {
 			didI := k.(map[string]interface{})["value"].(map[string]interface{})["instance"]
 			if didI != nil {
@@ -548,14 +539,17 @@ func phoneExporter() *ResourceExporter {
 }
 
 func buildSdkLines(d *schema.ResourceData, lineBaseSettings *platformclientv2.Domainentityref) (linesPtr *[]platformclientv2.Line, isStandAlone bool) {
+	lineAddresses, _ := d.GetOk("line_addresses")
+	return buildSdkPhoneLines(InterfaceListToStrings(lineAddresses.([]interface{})), lineBaseSettings)
+}
+
+// buildSdkPhoneLines builds one line per line address. If there are no line addresses, the phone is not standalone.
+func buildSdkPhoneLines(lineStringList []string, lineBaseSettings *platformclientv2.Domainentityref) (linesPtr *[]platformclientv2.Line, isStandAlone bool) {
 	lines := []platformclientv2.Line{}
 	isStandAlone = false
 
-	lineAddresses, ok := d.GetOk("line_addresses")
-	lineStringList := InterfaceListToStrings(lineAddresses.([]interface{}))
-
 	// If line_addresses is not provided, phone is not standalone
-	if !ok || len(lineStringList) == 0 {
+	if len(lineStringList) == 0 {
 		lineName := "line_" + *lineBaseSettings.Id
 		lines = append(lines, platformclientv2.Line{
 			Name:             &lineName,
@@ -588,6 +582,16 @@ func buildSdkLines(d *schema.ResourceData, lineBaseSettings *platformclientv2.Do
 	return
 }
 
+func buildSdkPhoneStandaloneProperties() *map[string]interface{} {
+	return &map[string]interface{}{
+		"phone_standalone": &map[string]interface{}{
+			"value": &map[string]interface{}{
+				"instance": true,
+			},
+		},
+	}
+}
+
 func buildSdkCapabilities(d *schema.ResourceData) *platformclientv2.Phonecapabilities {
 	if capabilities := d.Get("capabilities").([]interface{}); capabilities != nil {
 		sdkPhoneCapabilities := platformclientv2.Phonecapabilities{}
@@ -0,0 +1,716 @@
+package genesyscloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+// Columns of the phones CSV file. Only name is required in every row.
+const (
+	bulkPhoneColumnName              = "name"
+	bulkPhoneColumnSiteId            = "site_id"
+	bulkPhoneColumnPhoneBaseSettings = "phone_base_settings_id"
+	bulkPhoneColumnLineAddresses     = "line_addresses"
+	bulkPhoneColumnWebRtcUserId      = "web_rtc_user_id"
+)
+
+var bulkPhoneColumns = []string{
+	bulkPhoneColumnName,
+	bulkPhoneColumnSiteId,
+	bulkPhoneColumnPhoneBaseSettings,
+	bulkPhoneColumnLineAddresses,
+	bulkPhoneColumnWebRtcUserId,
+}
+
+func resourcePhonesBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Phones Bulk. Creates, updates and deletes phones from a CSV file with the header columns " +
+			"name, site_id, phone_base_settings_id, line_addresses and web_rtc_user_id. Only name is required; site_id and phone_base_settings_id fall back to the " +
+			"attributes of this resource when empty and multiple line addresses are separated by semicolons. Phones are identified by name. " +
+			"Rows are processed in batches and a row that fails is reported as a warning and in the `phones` attribute without failing the other rows. Failed rows are retried on the next apply. " +
+			"Phones are read by listing the phones of their sites, and phones changed outside of Terraform are updated from the file on the next apply.",
+
+		CreateContext: createWithPooledClient(createPhonesBulk),
+		ReadContext:   readWithPooledClient(readPhonesBulk),
+		UpdateContext: updateWithPooledClient(updatePhonesBulk),
+		DeleteContext: deleteWithPooledClient(deletePhonesBulk),
+		SchemaVersion: 1,
+		CustomizeDiff: customizePhonesBulkDiff,
+		Schema: map[string]*schema.Schema{
+			"csv_filepath": {
+				Description:  "Path to the CSV file of phones.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the CSV file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"site_id": {
+				Description: "Site ID of phones with an empty site_id column.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"phone_base_settings_id": {
+				Description: "Phone Base Settings ID of phones with an empty phone_base_settings_id column.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"batch_size": {
+				Description:  "Number of rows processed concurrently in a batch.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"max_requests_per_second": {
+				Description:  "Maximum number of phone requests sent per second across all batches. Requests that are still rate limited by the API are retried.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"phones": {
+				Description: "Result of each row of the CSV file.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"row": {
+							Description: "Row number in the CSV file, starting at 1 for the first row after the header.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the phone.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"phone_id": {
+							Description: "ID of the phone. Empty if the row failed.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"site_id": {
+							Description: "Site ID of the phone.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"row_hash": {
+							Description: "Hash of the row values the phone was last created or updated from.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"error": {
+							Description: "Error of the row. Empty if the row succeeded.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type bulkPhoneRow struct {
+	row                 int
+	name                string
+	siteId              string
+	phoneBaseSettingsId string
+	lineAddresses       []string
+	webRtcUserId        string
+}
+
+type bulkPhoneResult struct {
+	row     int
+	name    string
+	phoneId string
+	siteId  string
+	rowHash string
+	err     error
+}
+
+// hash returns a hash of the values a phone is built from, so unchanged rows are not updated again
+func (r bulkPhoneRow) hash() string {
+	values := []string{r.name, r.siteId, r.phoneBaseSettingsId, strings.Join(r.lineAddresses, ";"), r.webRtcUserId}
+	hash := sha256.Sum256([]byte(strings.Join(values, ",")))
+	return hex.EncodeToString(hash[:])
+}
+
+// bulkPhoneRowFromPhone returns the row values of an existing phone, so its hash can be compared with the hash of the row it was last provisioned from
+func bulkPhoneRowFromPhone(phone platformclientv2.Phone) bulkPhoneRow {
+	row := bulkPhoneRow{
+		name:          valueOrEmpty(phone.Name),
+		lineAddresses: flattenPhoneLines(phone.Lines),
+	}
+	if phone.Site != nil {
+		row.siteId = valueOrEmpty(phone.Site.Id)
+	}
+	if phone.PhoneBaseSettings != nil {
+		row.phoneBaseSettingsId = valueOrEmpty(phone.PhoneBaseSettings.Id)
+	}
+	if phone.WebRtcUser != nil {
+		row.webRtcUserId = valueOrEmpty(phone.WebRtcUser.Id)
+	}
+	return row
+}
+
+// customizePhonesBulkDiff validates the CSV file at plan time and plans an update of the phones when a row
+// was added, removed, changed or failed in the last apply
+func customizePhonesBulkDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("csv_filepath") || !diff.NewValueKnown("site_id") || !diff.NewValueKnown("phone_base_settings_id") {
+		// The rows may reference values that are known after apply
+		return nil
+	}
+
+	rows, err := readBulkPhoneRows(diff.Get("csv_filepath").(string), diff.Get("site_id").(string), diff.Get("phone_base_settings_id").(string))
+	if err != nil {
+		return err
+	}
+	if diff.Id() == "" {
+		return nil
+	}
+
+	currentPhones := make(map[string]string)
+	for _, phone := range diff.Get("phones").([]interface{}) {
+		phoneMap := phone.(map[string]interface{})
+		if phoneMap["phone_id"].(string) != "" {
+			currentPhones[phoneMap["name"].(string)] = phoneMap["row_hash"].(string)
+		}
+	}
+
+	hasChanges := len(rows) != len(currentPhones)
+	for _, row := range rows {
+		if rowHash, ok := currentPhones[row.name]; !ok || rowHash != row.hash() {
+			hasChanges = true
+			break
+		}
+	}
+	if hasChanges {
+		return diff.SetNewComputed("phones")
+	}
+	return nil
+}
+
+func createPhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+	return updatePhonesBulk(ctx, d, meta)
+}
+
+func updatePhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filePath := d.Get("csv_filepath").(string)
+	rows, err := readBulkPhoneRows(filePath, d.Get("site_id").(string), d.Get("phone_base_settings_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	// The phones are planned as computed when a row changes, so the phones of the last apply are read from the old value
+	oldPhones, _ := d.GetChange("phones")
+	currentPhones := currentBulkPhones(oldPhones.([]interface{}))
+
+	throttle := newBulkPhoneThrottle(d.Get("max_requests_per_second").(int))
+	defer throttle.Stop()
+	baseSettings := newBulkPhoneBaseSettingsCache(edgesAPI)
+
+	log.Printf("Provisioning %d phones from %s", len(rows), filePath)
+	results := make([]bulkPhoneResult, len(rows))
+	runBulkPhoneBatches(len(rows), d.Get("batch_size").(int), func(i int) {
+		row := rows[i]
+		result := bulkPhoneResult{row: row.row, name: row.name, siteId: row.siteId, rowHash: row.hash()}
+		current, exists := currentPhones[row.name]
+		if exists {
+			result.phoneId = current.phoneId
+		}
+		if !exists || current.rowHash != result.rowHash {
+			result.phoneId, result.err = createOrUpdateBulkPhone(ctx, row, result.phoneId, baseSettings, throttle, sdkConfig)
+		}
+		results[i] = result
+	})
+
+	// Delete phones of rows that were removed from the file
+	rowNames := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		rowNames[row.name] = true
+	}
+	removedPhones := make([]bulkPhoneResult, 0)
+	for name, phone := range currentPhones {
+		if !rowNames[name] {
+			removedPhones = append(removedPhones, phone)
+		}
+	}
+	var diagErr diag.Diagnostics
+	for _, result := range deleteBulkPhones(removedPhones, d.Get("batch_size").(int), throttle, edgesAPI) {
+		if result.err != nil {
+			// Keep the phone in state so the delete is retried
+			results = append(results, bulkPhoneResult{name: result.name, phoneId: result.phoneId, siteId: result.siteId})
+			diagErr = append(diagErr, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to delete phone %s", result.name),
+				Detail:   result.err.Error(),
+			})
+		}
+	}
+
+	d.Set("phones", flattenBulkPhoneResults(results))
+	diagErr = append(diagErr, bulkPhoneResultDiagnostics(results)...)
+	log.Printf("Provisioned phones from %s", filePath)
+	return append(diagErr, readPhonesBulk(ctx, d, meta)...)
+}
+
+// currentBulkPhones returns the provisioned phones of the last apply by name
+func currentBulkPhones(phones []interface{}) map[string]bulkPhoneResult {
+	currentPhones := make(map[string]bulkPhoneResult)
+	for _, phone := range phones {
+		phoneMap := phone.(map[string]interface{})
+		if phoneId := phoneMap["phone_id"].(string); phoneId != "" {
+			currentPhones[phoneMap["name"].(string)] = bulkPhoneResult{
+				name:    phoneMap["name"].(string),
+				phoneId: phoneId,
+				siteId:  phoneMap["site_id"].(string),
+				rowHash: phoneMap["row_hash"].(string),
+			}
+		}
+	}
+	return currentPhones
+}
+
+func readPhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	throttle := newBulkPhoneThrottle(d.Get("max_requests_per_second").(int))
+	defer throttle.Stop()
+
+	log.Printf("Reading phones bulk %s", d.Id())
+	statePhones := d.Get("phones").([]interface{})
+
+	// List the phones of each site instead of reading every phone
+	livePhones := make(map[string]platformclientv2.Phone)
+	var unlistedPhoneIds []string
+	listedSites := make(map[string]bool)
+	for _, phone := range statePhones {
+		phoneMap := phone.(map[string]interface{})
+		phoneId := phoneMap["phone_id"].(string)
+		siteId, _ := phoneMap["site_id"].(string)
+		if phoneId == "" {
+			continue
+		}
+		if siteId == "" {
+			// Phones provisioned before the site was stored are read one by one
+			unlistedPhoneIds = append(unlistedPhoneIds, phoneId)
+			continue
+		}
+		if listedSites[siteId] {
+			continue
+		}
+		listedSites[siteId] = true
+		if err := listBulkPhonesOfSite(siteId, livePhones, throttle, edgesAPI); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var mutex sync.Mutex
+	var readErr error
+	runBulkPhoneBatches(len(unlistedPhoneIds), d.Get("batch_size").(int), func(i int) {
+		phone, err := getBulkPhone(unlistedPhoneIds[i], throttle, edgesAPI)
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err != nil {
+			readErr = err
+		} else if phone != nil {
+			livePhones[*phone.Id] = *phone
+		}
+	})
+	if readErr != nil {
+		return diag.FromErr(readErr)
+	}
+
+	phones := make([]interface{}, 0)
+	for _, phone := range statePhones {
+		phoneMap := phone.(map[string]interface{})
+		phoneId := phoneMap["phone_id"].(string)
+		if phoneId == "" {
+			phones = append(phones, phoneMap)
+			continue
+		}
+
+		livePhone, ok := livePhones[phoneId]
+		if !ok || (livePhone.State != nil && *livePhone.State == "deleted") {
+			// Recreated on the next apply
+			continue
+		}
+		liveRow := bulkPhoneRowFromPhone(livePhone)
+		if liveRow.hash() != phoneMap["row_hash"].(string) {
+			// The phone was changed outside of Terraform. Update it from its row on the next apply.
+			phoneMap["row_hash"] = ""
+		}
+		phoneMap["site_id"] = liveRow.siteId
+		phones = append(phones, phoneMap)
+	}
+	d.Set("phones", phones)
+
+	log.Printf("Read phones bulk %s", d.Id())
+	return nil
+}
+
+// listBulkPhonesOfSite adds the phones of a site to phones by ID
+func listBulkPhonesOfSite(siteId string, phones map[string]platformclientv2.Phone, throttle *bulkPhoneThrottle, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) error {
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		var sitePhones *platformclientv2.Phoneentitylisting
+		throttle.Wait()
+		diagErr := retryWhen(isStatus429, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			listing, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesPhones(pageNum, pageSize, "", "", siteId, "", "", "", "", "", "", "", "", "", "", []string{"lines"}, nil)
+			if getErr != nil {
+				return resp, diag.Errorf("Failed to get page of phones for site %s: %s", siteId, getErr)
+			}
+			sitePhones = listing
+			return resp, nil
+		})
+		if diagErr != nil {
+			return fmt.Errorf("%s", diagErr[0].Summary)
+		}
+
+		if sitePhones.Entities == nil || len(*sitePhones.Entities) == 0 {
+			return nil
+		}
+		for _, phone := range *sitePhones.Entities {
+			phones[*phone.Id] = phone
+		}
+	}
+}
+
+// getBulkPhone reads a phone and returns nil if it does not exist
+func getBulkPhone(phoneId string, throttle *bulkPhoneThrottle, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) (*platformclientv2.Phone, error) {
+	var phone *platformclientv2.Phone
+	throttle.Wait()
+	diagErr := retryWhen(isStatus429, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		currentPhone, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesPhone(phoneId)
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resp, nil
+			}
+			return resp, diag.Errorf("Failed to read phone %s: %s", phoneId, getErr)
+		}
+		phone = currentPhone
+		return resp, nil
+	})
+	if diagErr != nil {
+		return nil, fmt.Errorf("%s", diagErr[0].Summary)
+	}
+	return phone, nil
+}
+
+func deletePhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	phones := make([]bulkPhoneResult, 0)
+	for _, phone := range d.Get("phones").([]interface{}) {
+		phoneMap := phone.(map[string]interface{})
+		if phoneId := phoneMap["phone_id"].(string); phoneId != "" {
+			phones = append(phones, bulkPhoneResult{name: phoneMap["name"].(string), phoneId: phoneId})
+		}
+	}
+
+	throttle := newBulkPhoneThrottle(d.Get("max_requests_per_second").(int))
+	defer throttle.Stop()
+
+	log.Printf("Deleting %d phones", len(phones))
+	var diagErr diag.Diagnostics
+	for _, result := range deleteBulkPhones(phones, d.Get("batch_size").(int), throttle, edgesAPI) {
+		if result.err != nil {
+			diagErr = append(diagErr, diag.Errorf("Failed to delete phone %s: %s", result.name, result.err)...)
+		}
+	}
+	if diagErr != nil {
+		return diagErr
+	}
+
+	// Give the stations of the phones time to disassociate
+	time.Sleep(5 * time.Second)
+	log.Printf("Deleted %d phones", len(phones))
+	return nil
+}
+
+// readBulkPhoneRows reads and validates the rows of the phones CSV file
+func readBulkPhoneRows(filePath, defaultSiteId, defaultPhoneBaseSettingsId string) ([]bulkPhoneRow, error) {
+	reader, file, err := downloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read header of phones file %s: %s", filePath, err)
+	}
+	columns := make(map[string]int)
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		if !StringInSlice(column, bulkPhoneColumns) {
+			return nil, fmt.Errorf("Unknown column %s in phones file %s. Valid columns are %s", column, filePath, strings.Join(bulkPhoneColumns, ", "))
+		}
+		columns[column] = i
+	}
+	if _, ok := columns[bulkPhoneColumnName]; !ok {
+		return nil, fmt.Errorf("Phones file %s has no %s column", filePath, bulkPhoneColumnName)
+	}
+
+	value := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	rows := make([]bulkPhoneRow, 0)
+	names := make(map[string]int)
+	for rowNum := 1; ; rowNum++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read row %d of phones file %s: %s", rowNum, filePath, err)
+		}
+
+		row := bulkPhoneRow{
+			row:                 rowNum,
+			name:                value(record, bulkPhoneColumnName),
+			siteId:              value(record, bulkPhoneColumnSiteId),
+			phoneBaseSettingsId: value(record, bulkPhoneColumnPhoneBaseSettings),
+			webRtcUserId:        value(record, bulkPhoneColumnWebRtcUserId),
+		}
+		if row.siteId == "" {
+			row.siteId = defaultSiteId
+		}
+		if row.phoneBaseSettingsId == "" {
+			row.phoneBaseSettingsId = defaultPhoneBaseSettingsId
+		}
+		if lineAddresses := value(record, bulkPhoneColumnLineAddresses); lineAddresses != "" {
+			for _, lineAddress := range strings.Split(lineAddresses, ";") {
+				lineAddress = strings.TrimSpace(lineAddress)
+				if diagErr := validatePhoneNumber(lineAddress, nil); diagErr != nil {
+					return nil, fmt.Errorf("Invalid line address in row %d of phones file %s: %s", rowNum, filePath, diagErr[0].Summary)
+				}
+				row.lineAddresses = append(row.lineAddresses, lineAddress)
+			}
+		}
+
+		if row.name == "" {
+			return nil, fmt.Errorf("Row %d of phones file %s has no %s", rowNum, filePath, bulkPhoneColumnName)
+		}
+		if previousRow, ok := names[row.name]; ok {
+			return nil, fmt.Errorf("Rows %d and %d of phones file %s have the same name %s", previousRow, rowNum, filePath, row.name)
+		}
+		names[row.name] = rowNum
+		if row.siteId == "" {
+			return nil, fmt.Errorf("Row %d of phones file %s has no %s and the site_id attribute is not set", rowNum, filePath, bulkPhoneColumnSiteId)
+		}
+		if row.phoneBaseSettingsId == "" {
+			return nil, fmt.Errorf("Row %d of phones file %s has no %s and the phone_base_settings_id attribute is not set", rowNum, filePath, bulkPhoneColumnPhoneBaseSettings)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// runBulkPhoneBatches calls process for each index in batches of concurrent calls
+func runBulkPhoneBatches(count int, batchSize int, process func(i int)) {
+	for start := 0; start < count; start += batchSize {
+		end := start + batchSize
+		if end > count {
+			end = count
+		}
+
+		var wg sync.WaitGroup
+		for i := start; i < end; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				process(i)
+			}(i)
+		}
+		wg.Wait()
+		log.Printf("Processed %d of %d phones", end, count)
+	}
+}
+
+// bulkPhoneThrottle limits the rate of phone requests shared by all rows of a batch
+type bulkPhoneThrottle struct {
+	ticker *time.Ticker
+}
+
+func newBulkPhoneThrottle(requestsPerSecond int) *bulkPhoneThrottle {
+	return &bulkPhoneThrottle{ticker: time.NewTicker(time.Second / time.Duration(requestsPerSecond))}
+}
+
+func (t *bulkPhoneThrottle) Wait() {
+	<-t.ticker.C
+}
+
+func (t *bulkPhoneThrottle) Stop() {
+	t.ticker.Stop()
+}
+
+// bulkPhoneBaseSettingsCache looks up the line base settings and phone meta base of each phone base settings once
+type bulkPhoneBaseSettingsCache struct {
+	edgesAPI *platformclientv2.TelephonyProvidersEdgeApi
+	mutex    sync.Mutex
+	settings map[string]*platformclientv2.Phonebase
+}
+
+func newBulkPhoneBaseSettingsCache(edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) *bulkPhoneBaseSettingsCache {
+	return &bulkPhoneBaseSettingsCache{
+		edgesAPI: edgesAPI,
+		settings: make(map[string]*platformclientv2.Phonebase),
+	}
+}
+
+func (c *bulkPhoneBaseSettingsCache) get(phoneBaseSettingsId string) (*platformclientv2.Phonebase, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if phoneBase, ok := c.settings[phoneBaseSettingsId]; ok {
+		return phoneBase, nil
+	}
+	phoneBase, _, err := c.edgesAPI.GetTelephonyProvidersEdgesPhonebasesetting(phoneBaseSettingsId)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get phone base settings %s: %s", phoneBaseSettingsId, err)
+	}
+	if phoneBase.Lines == nil || len(*phoneBase.Lines) == 0 || phoneBase.PhoneMetaBase == nil {
+		return nil, fmt.Errorf("Phone base settings %s has no line or phone meta base", phoneBaseSettingsId)
+	}
+	c.settings[phoneBaseSettingsId] = phoneBase
+	return phoneBase, nil
+}
+
+func createOrUpdateBulkPhone(ctx context.Context, row bulkPhoneRow, phoneId string, baseSettings *bulkPhoneBaseSettingsCache, throttle *bulkPhoneThrottle, sdkConfig *platformclientv2.Configuration) (string, error) {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	phoneBase, err := baseSettings.get(row.phoneBaseSettingsId)
+	if err != nil {
+		return phoneId, err
+	}
+
+	lineBaseSettings := &platformclientv2.Domainentityref{Id: (*phoneBase.Lines)[0].Id}
+	lines, isStandalone := buildSdkPhoneLines(row.lineAddresses, lineBaseSettings)
+	phone := platformclientv2.Phone{
+		Name:              &row.name,
+		State:             platformclientv2.String("active"),
+		Site:              &platformclientv2.Domainentityref{Id: &row.siteId},
+		PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: &row.phoneBaseSettingsId},
+		LineBaseSettings:  lineBaseSettings,
+		PhoneMetaBase:     &platformclientv2.Domainentityref{Id: phoneBase.PhoneMetaBase.Id},
+		Lines:             lines,
+	}
+	if isStandalone {
+		phone.Properties = buildSdkPhoneStandaloneProperties()
+	}
+	if row.webRtcUserId != "" {
+		phone.WebRtcUser = &platformclientv2.Domainentityref{Id: &row.webRtcUserId}
+	}
+
+	throttle.Wait()
+	if phoneId == "" {
+		log.Printf("Creating phone %s", row.name)
+		createdPhone, _, err := edgesAPI.PostTelephonyProvidersEdgesPhones(phone)
+		if err != nil {
+			return "", fmt.Errorf("Failed to create phone %s: %s", row.name, err)
+		}
+		phoneId = *createdPhone.Id
+	} else {
+		log.Printf("Updating phone %s", row.name)
+		if _, _, err := edgesAPI.PutTelephonyProvidersEdgesPhone(phoneId, phone); err != nil {
+			return phoneId, fmt.Errorf("Failed to update phone %s: %s", row.name, err)
+		}
+	}
+
+	if row.webRtcUserId != "" {
+		throttle.Wait()
+		if diagErr := assignUserToWebRtcPhone(ctx, sdkConfig, row.webRtcUserId); diagErr != nil {
+			return phoneId, fmt.Errorf("Failed to assign user %s to phone %s: %s", row.webRtcUserId, row.name, diagErr[0].Summary)
+		}
+	}
+	return phoneId, nil
+}
+
+// deleteBulkPhones deletes the phones in batches and returns the phones with the delete error of each
+func deleteBulkPhones(phones []bulkPhoneResult, batchSize int, throttle *bulkPhoneThrottle, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) []bulkPhoneResult {
+	results := make([]bulkPhoneResult, len(phones))
+	runBulkPhoneBatches(len(phones), batchSize, func(i int) {
+		results[i] = phones[i]
+		throttle.Wait()
+		log.Printf("Deleting phone %s", phones[i].name)
+		resp, err := edgesAPI.DeleteTelephonyProvidersEdgesPhone(phones[i].phoneId)
+		if err != nil && !isStatus404(resp) {
+			results[i].err = err
+		}
+	})
+	return results
+}
+
+func flattenBulkPhoneResults(results []bulkPhoneResult) []interface{} {
+	phones := make([]interface{}, 0, len(results))
+	for _, result := range results {
+		phone := map[string]interface{}{
+			"row":      result.row,
+			"name":     result.name,
+			"phone_id": result.phoneId,
+			"site_id":  result.siteId,
+			"row_hash": result.rowHash,
+			"error":    "",
+		}
+		if result.err != nil {
+			phone["error"] = result.err.Error()
+			if result.phoneId != "" {
+				// Update the phone again on the next apply
+				phone["row_hash"] = ""
+			}
+		}
+		phones = append(phones, phone)
+	}
+	return phones
+}
+
+func bulkPhoneResultDiagnostics(results []bulkPhoneResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, result := range results {
+		if result.err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to provision phone %s in row %d", result.name, result.row),
+				Detail:   result.err.Error(),
+			})
+		}
+	}
+	return diags
+}
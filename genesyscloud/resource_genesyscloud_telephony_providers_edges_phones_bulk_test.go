@@ -0,0 +1,301 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourcePhonesBulk(t *testing.T) {
+	var (
+		phonesBulkRes = "phonesBulk"
+		phoneName1    = "test-phone_" + uuid.NewString()
+		phoneName2    = "test-phone_" + uuid.NewString()
+		phoneName3    = "test-phone_" + uuid.NewString()
+		csvFilePath   = filepath.Join(t.TempDir(), "phones.csv")
+
+		phoneBaseSettingsRes  = "phoneBaseSettings"
+		phoneBaseSettingsName = "phoneBaseSettings " + uuid.NewString()
+
+		phoneId1 string
+		phoneId2 string
+	)
+
+	config := generateOrganizationMe() + generatePhoneBaseSettingsResourceWithCustomAttrs(
+		phoneBaseSettingsRes,
+		phoneBaseSettingsName,
+		"phoneBaseSettings description",
+		"generic_sip.json",
+	) + generatePhonesBulkResource(
+		phonesBulkRes,
+		csvFilePath,
+		"data.genesyscloud_organizations_me.me.default_site_id",
+		"genesyscloud_telephony_providers_edges_phonebasesettings."+phoneBaseSettingsRes+".id",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create two phones
+				PreConfig: func() {
+					writePhonesBulkFile(t, csvFilePath, "name,line_addresses", phoneName1+",", phoneName2+",")
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.0.name", phoneName1),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.0.error", ""),
+					resource.TestCheckResourceAttrSet("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.0.phone_id"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.1.name", phoneName2),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.1.error", ""),
+					resource.TestCheckResourceAttrSet("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.1.phone_id"),
+					testPhonesBulkPhoneId("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.0.phone_id", &phoneId1),
+					testPhonesBulkPhoneId("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.1.phone_id", &phoneId2),
+				),
+			},
+			{
+				// Update a line address, replace the second phone and add a third phone
+				PreConfig: func() {
+					writePhonesBulkFile(t, csvFilePath, "name,line_addresses", phoneName1+",+13175550161", phoneName3+",", "\""+phoneName2+"-new\",")
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.#", "3"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.0.name", phoneName1),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.1.name", phoneName3),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.2.name", phoneName2+"-new"),
+					testPhonesBulkLineAddress("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.0.phone_id", "+13175550161"),
+					// The changed phone is updated in place and the phone of the removed row is deleted
+					resource.TestCheckResourceAttrPtr("genesyscloud_telephony_providers_edges_phones_bulk."+phonesBulkRes, "phones.0.phone_id", &phoneId1),
+					testPhonesBulkNameCount(phoneName1, 1),
+					testPhonesBulkPhoneDeleted(&phoneId2),
+				),
+			},
+		},
+		CheckDestroy: testVerifyPhonesBulkDestroyed,
+	})
+}
+
+func TestPhonesBulkRowHashFromPhone(t *testing.T) {
+	csvFilePath := filepath.Join(t.TempDir(), "phones.csv")
+	writePhonesBulkFile(t, csvFilePath,
+		"name,line_addresses,web_rtc_user_id",
+		"phone1,+13175550001;+13175550002,user1",
+		"phone2,,",
+	)
+	rows, err := readBulkPhoneRows(csvFilePath, "site1", "pbs1")
+	if err != nil {
+		t.Fatalf("Failed to read phones file: %s", err)
+	}
+
+	lineWithAddress := func(address string) platformclientv2.Line {
+		return platformclientv2.Line{
+			Properties: &map[string]interface{}{
+				"station_identity_address": map[string]interface{}{
+					"value": map[string]interface{}{"instance": address},
+				},
+			},
+		}
+	}
+	phone1 := platformclientv2.Phone{
+		Name:              platformclientv2.String("phone1"),
+		Site:              &platformclientv2.Domainentityref{Id: platformclientv2.String("site1")},
+		PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: platformclientv2.String("pbs1")},
+		WebRtcUser:        &platformclientv2.Domainentityref{Id: platformclientv2.String("user1")},
+		Lines:             &[]platformclientv2.Line{lineWithAddress("+13175550001"), lineWithAddress("+13175550002")},
+	}
+	phone2 := platformclientv2.Phone{
+		Name:              platformclientv2.String("phone2"),
+		Site:              &platformclientv2.Domainentityref{Id: platformclientv2.String("site1")},
+		PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: platformclientv2.String("pbs1")},
+		Lines:             &[]platformclientv2.Line{{}},
+	}
+
+	if hash := bulkPhoneRowFromPhone(phone1).hash(); hash != rows[0].hash() {
+		t.Errorf("Hash of phone1 %s does not match its row hash %s", hash, rows[0].hash())
+	}
+	if hash := bulkPhoneRowFromPhone(phone2).hash(); hash != rows[1].hash() {
+		t.Errorf("Hash of phone2 %s does not match its row hash %s", hash, rows[1].hash())
+	}
+
+	// A phone changed outside of Terraform no longer matches its row
+	phone1.WebRtcUser = &platformclientv2.Domainentityref{Id: platformclientv2.String("user2")}
+	if bulkPhoneRowFromPhone(phone1).hash() == rows[0].hash() {
+		t.Errorf("Hash of changed phone1 matches its row hash")
+	}
+}
+
+func TestPhonesBulkCurrentPhonesOfPlannedUpdate(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "bulk1",
+		Attributes: map[string]string{
+			"id":                "bulk1",
+			"csv_filepath":      "phones.csv",
+			"phones.#":          "2",
+			"phones.0.row":      "1",
+			"phones.0.name":     "phone1",
+			"phones.0.phone_id": "id1",
+			"phones.0.site_id":  "site1",
+			"phones.0.row_hash": "hash1",
+			"phones.0.error":    "",
+			"phones.1.row":      "2",
+			"phones.1.name":     "phone2",
+			"phones.1.phone_id": "id2",
+			"phones.1.site_id":  "site1",
+			"phones.1.row_hash": "hash2",
+			"phones.1.error":    "",
+		},
+	}
+	// A changed row plans the phones as computed
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"phones.#": {Old: "2", NewComputed: true},
+		},
+	}
+	d, err := schema.InternalMap(resourcePhonesBulk().Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("Failed to build resource data: %s", err)
+	}
+
+	oldPhones, _ := d.GetChange("phones")
+	currentPhones := currentBulkPhones(oldPhones.([]interface{}))
+	if len(currentPhones) != 2 {
+		t.Fatalf("Expected 2 current phones, got %d", len(currentPhones))
+	}
+	if phone := currentPhones["phone1"]; phone.phoneId != "id1" || phone.siteId != "site1" || phone.rowHash != "hash1" {
+		t.Errorf("Unexpected current phone1 %+v", phone)
+	}
+	if phone := currentPhones["phone2"]; phone.phoneId != "id2" || phone.rowHash != "hash2" {
+		t.Errorf("Unexpected current phone2 %+v", phone)
+	}
+}
+
+func writePhonesBulkFile(t *testing.T, filePath string, lines ...string) {
+	if err := os.WriteFile(filePath, []byte(strings.Join(lines, "\n")), 0644); err != nil {
+		t.Fatalf("Failed to write phones file %s: %s", filePath, err)
+	}
+}
+
+func testPhonesBulkPhoneId(resourceName, phoneIdAttr string, phoneId *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resourceState, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state", resourceName)
+		}
+		*phoneId = resourceState.Primary.Attributes[phoneIdAttr]
+		return nil
+	}
+}
+
+func testPhonesBulkNameCount(phoneName string, expectedCount int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+		phones, _, err := edgesAPI.GetTelephonyProvidersEdgesPhones(1, 100, "", "", "", "", "", "", "", "", "", "", phoneName, "", "", nil, nil)
+		if err != nil {
+			return fmt.Errorf("Failed to get phones named %s: %s", phoneName, err)
+		}
+		count := 0
+		if phones.Entities != nil {
+			for _, phone := range *phones.Entities {
+				if phone.Name != nil && *phone.Name == phoneName && (phone.State == nil || *phone.State != "deleted") {
+					count++
+				}
+			}
+		}
+		if count != expectedCount {
+			return fmt.Errorf("Found %d phones named %s, expected %d", count, phoneName, expectedCount)
+		}
+		return nil
+	}
+}
+
+func testPhonesBulkPhoneDeleted(phoneId *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+		phone, resp, err := edgesAPI.GetTelephonyProvidersEdgesPhone(*phoneId)
+		if phone != nil {
+			if phone.State != nil && *phone.State == "deleted" {
+				return nil
+			}
+			return fmt.Errorf("Phone (%s) still exists", *phoneId)
+		} else if isStatus404(resp) {
+			return nil
+		}
+		return fmt.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func testPhonesBulkLineAddress(resourceName, phoneIdAttr, lineAddress string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resourceState, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state", resourceName)
+		}
+		phoneId := resourceState.Primary.Attributes[phoneIdAttr]
+
+		edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+		phone, _, err := edgesAPI.GetTelephonyProvidersEdgesPhone(phoneId)
+		if err != nil {
+			return fmt.Errorf("Failed to get phone %s: %s", phoneId, err)
+		}
+		lineAddresses := flattenPhoneLines(phone.Lines)
+		if len(lineAddresses) != 1 || lineAddresses[0] != lineAddress {
+			return fmt.Errorf("Phone %s has line addresses %v, expected %s", phoneId, lineAddresses, lineAddress)
+		}
+		return nil
+	}
+}
+
+func testVerifyPhonesBulkDestroyed(state *terraform.State) error {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_telephony_providers_edges_phones_bulk" {
+			continue
+		}
+
+		phoneCount, _ := strconv.Atoi(rs.Primary.Attributes["phones.#"])
+		for i := 0; i < phoneCount; i++ {
+			phoneId := rs.Primary.Attributes[fmt.Sprintf("phones.%d.phone_id", i)]
+			if phoneId == "" {
+				continue
+			}
+
+			phone, resp, err := edgesAPI.GetTelephonyProvidersEdgesPhone(phoneId)
+			if phone != nil {
+				if phone.State != nil && *phone.State == "deleted" {
+					continue
+				}
+				return fmt.Errorf("Phone (%s) still exists", phoneId)
+			} else if isStatus404(resp) {
+				// Phone not found as expected
+				continue
+			} else {
+				// Unexpected error
+				return fmt.Errorf("Unexpected error: %s", err)
+			}
+		}
+	}
+	// Success. All phones destroyed
+	return nil
+}
+
+func generatePhonesBulkResource(resourceID, csvFilePath, siteId, phoneBaseSettingsId string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_phones_bulk" "%s" {
+		csv_filepath = "%s"
+		file_content_hash = filesha256("%s")
+		site_id = %s
+		phone_base_settings_id = %s
+	}
+	`, resourceID, csvFilePath, csvFilePath, siteId, phoneBaseSettingsId)
+}
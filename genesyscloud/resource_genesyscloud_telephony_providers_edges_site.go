@@ -28,6 +28,7 @@ func resourceSite() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeSiteDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "The name of the entity.",
@@ -111,68 +112,7 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Description: "The name of the entity.",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"match_type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"digitLength", "e164NumberList", "interCountryCode", "intraCountryCode", "numberList", "regex"}, false),
-						},
-						"normalized_format": {
-							Description: "Use regular expression capture groups to build the normalized number",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"match_format": {
-							Description: "Use regular expression capture groups to build the normalized number",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"numbers": {
-							Description: "Numbers must be 2-9 digits long. Numbers within ranges must be the same length. (e.g. 888, 888-999, 55555-77777, 800).",
-							Type:        schema.TypeList,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"start": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"end": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"digit_length": {
-							Description: "Allowed values are between 1-20 digits.",
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"start": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"end": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"classification": {
-							Description: "Used to classify this number plan",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-					},
+					Schema: siteNumberPlanSchema(),
 				},
 			},
 			"outbound_routes": {
@@ -181,45 +121,21 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Description: "The name of the entity.",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"description": {
-							Description: "The resource's description.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"classification_types": {
-							Description: "Used to classify this outbound route.",
-							Type:        schema.TypeList,
-							Required:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-						},
-						"enabled": {
-							Description: "Enable or disable the outbound route",
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-						},
-						"distribution": {
-							Description:  "Valid values: SEQUENTIAL, RANDOM.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							Default:      "SEQUENTIAL",
-							ValidateFunc: validation.StringInSlice([]string{"SEQUENTIAL", "RANDOM"}, false),
-						},
-						"external_trunk_base_ids": {
-							Description: "Trunk base settings of trunkType \"EXTERNAL\". This base must also be set on an edge logical interface for correct routing. The order of the IDs determines the distribution if \"distribution\" is set to \"SEQUENTIAL\"",
-							Type:        schema.TypeList,
-							Optional:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-						},
-					},
+					Schema: siteOutboundRouteSchema(),
 				},
 			},
+			"manage_number_plans": {
+				Description: "Whether the site manages its number plans. Set to false when the number plans of the site are managed with genesyscloud_telephony_providers_edges_site_number_plan resources. The number_plans attribute cannot be set when false.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"manage_outbound_routes": {
+				Description: "Whether the site manages its outbound routes. Set to false when the outbound routes of the site are managed with genesyscloud_telephony_providers_edges_site_outbound_route resources. The outbound_routes attribute cannot be set when false.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 			"primary_sites": {
 				Description: `Used for primary phone edge assignment on physical edges only.  List of primary sites the phones can be assigned to. If no primary_sites are defined, the site id for this site will be used as the primary site id.`,
 				Optional:    true,
@@ -288,11 +204,17 @@ func siteExporter() *ResourceExporter {
 	return &ResourceExporter{
 		GetResourcesFunc: getAllWithPooledClient(getSites),
 		RefAttrs: map[string]*RefAttrSettings{
-			"location_id": {RefType: "genesyscloud_location"},
-			"outbound_routes.external_trunk_base_ids": {RefType: "genesyscloud_telephony_providers_edges_trunkbasesettings"},
+			"location_id":     {RefType: "genesyscloud_location"},
 			"primary_sites":   {RefType: "genesyscloud_telephony_providers_edges_site"},
 			"secondary_sites": {RefType: "genesyscloud_telephony_providers_edges_site"},
 		},
+		// Number plans and outbound routes are exported as their own resources, so the exported site must not manage them
+		ExcludedAttributes: []string{"number_plans", "outbound_routes"},
+		AllowZeroValues:    []string{"manage_number_plans", "manage_outbound_routes"},
+		CustomAttributeResolver: map[string]*RefAttrCustomResolver{
+			"manage_number_plans":    {ResolverFunc: SiteNumberPlansResolver},
+			"manage_outbound_routes": {ResolverFunc: SiteOutboundRoutesResolver},
+		},
 	}
 }
 
@@ -466,6 +388,14 @@ func readSite(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 			d.Set("secondary_sites", sdkDomainEntityRefArrToList(*currentSite.SecondarySites))
 		}
 
+		// Imported sites manage their number plans and outbound routes
+		if _, exists := d.GetOkExists("manage_number_plans"); !exists {
+			d.Set("manage_number_plans", true)
+		}
+		if _, exists := d.GetOkExists("manage_outbound_routes"); !exists {
+			d.Set("manage_outbound_routes", true)
+		}
+
 		if retryErr := readSiteNumberPlans(d, edgesAPI); retryErr != nil {
 			return retryErr
 		}
@@ -690,62 +620,14 @@ func updatePrimarySecondarySites(d *schema.ResourceData, siteId string, edgesAPI
 }
 
 func updateSiteNumberPlans(d *schema.ResourceData, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) diag.Diagnostics {
+	if !d.Get("manage_number_plans").(bool) {
+		return nil
+	}
 	if d.HasChange("number_plans") {
 		if nps := d.Get("number_plans").([]interface{}); nps != nil {
 			numberPlansFromTf := make([]platformclientv2.Numberplan, 0)
 			for _, np := range nps {
-				npMap := np.(map[string]interface{})
-				numberPlanFromTf := platformclientv2.Numberplan{}
-
-				if name := npMap["name"].(string); name != "" {
-					numberPlanFromTf.Name = &name
-				}
-
-				if matchType := npMap["match_type"].(string); matchType != "" {
-					numberPlanFromTf.MatchType = &matchType
-				}
-
-				if matchFormat := npMap["match_format"].(string); matchFormat != "" {
-					numberPlanFromTf.Match = &matchFormat
-				}
-
-				if normalizedFormat := npMap["normalized_format"].(string); normalizedFormat != "" {
-					numberPlanFromTf.NormalizedFormat = &normalizedFormat
-				}
-
-				if classification := npMap["classification"].(string); classification != "" {
-					numberPlanFromTf.Classification = &classification
-				}
-
-				if numbers, ok := npMap["numbers"].([]interface{}); ok && len(numbers) > 0 {
-					sdkNumbers := make([]platformclientv2.Number, 0)
-					for _, number := range numbers {
-						numberMap := number.(map[string]interface{})
-						sdkNumber := platformclientv2.Number{}
-						if start, ok := numberMap["start"].(string); ok {
-							sdkNumber.Start = &start
-						}
-						if end, ok := numberMap["end"].(string); ok {
-							sdkNumber.End = &end
-						}
-						sdkNumbers = append(sdkNumbers, sdkNumber)
-					}
-					numberPlanFromTf.Numbers = &sdkNumbers
-				}
-
-				if digitLength, ok := npMap["digit_length"].([]interface{}); ok && len(digitLength) > 0 {
-					sdkDigitlengthMap := digitLength[0].(map[string]interface{})
-					sdkDigitlength := platformclientv2.Digitlength{}
-					if start, ok := sdkDigitlengthMap["start"].(string); ok {
-						sdkDigitlength.Start = &start
-					}
-					if end, ok := sdkDigitlengthMap["end"].(string); ok {
-						sdkDigitlength.End = &end
-					}
-					numberPlanFromTf.DigitLength = &sdkDigitlength
-				}
-
-				numberPlansFromTf = append(numberPlansFromTf, numberPlanFromTf)
+				numberPlansFromTf = append(numberPlansFromTf, buildSdkNumberPlan(np.(map[string]interface{})))
 			}
 
 			// The default plans won't be assigned yet if there isn't a wait
@@ -804,42 +686,14 @@ func updateSiteNumberPlans(d *schema.ResourceData, edgesAPI *platformclientv2.Te
 }
 
 func updateSiteOutboundRoutes(d *schema.ResourceData, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) diag.Diagnostics {
+	if !d.Get("manage_outbound_routes").(bool) {
+		return nil
+	}
 	if d.HasChange("outbound_routes") {
 		if ors := d.Get("outbound_routes").([]interface{}); ors != nil {
 			outboundRoutesFromTf := make([]platformclientv2.Outboundroutebase, 0)
 			for _, or := range ors {
-				orMap := or.(map[string]interface{})
-				outboundRouteFromTf := platformclientv2.Outboundroutebase{}
-
-				if name := orMap["name"].(string); name != "" {
-					outboundRouteFromTf.Name = &name
-				}
-				if description := orMap["description"].(string); description != "" {
-					outboundRouteFromTf.Description = &description
-				}
-				if classificationTypes, ok := orMap["classification_types"].([]interface{}); ok && len(classificationTypes) > 0 {
-					cts := make([]string, 0)
-					for _, classificationType := range classificationTypes {
-						cts = append(cts, classificationType.(string))
-					}
-					outboundRouteFromTf.ClassificationTypes = &cts
-				}
-				if enabled, ok := orMap["enabled"].(bool); ok {
-					outboundRouteFromTf.Enabled = &enabled
-				}
-				if distribution := orMap["distribution"].(string); distribution != "" {
-					outboundRouteFromTf.Distribution = &distribution
-				}
-				if externalTrunkBaseIds, ok := orMap["external_trunk_base_ids"].([]interface{}); ok && len(externalTrunkBaseIds) > 0 {
-					ids := make([]platformclientv2.Domainentityref, 0)
-					for _, externalTrunkBaseId := range externalTrunkBaseIds {
-						externalTrunkBaseIdStr := externalTrunkBaseId.(string)
-						ids = append(ids, platformclientv2.Domainentityref{Id: &externalTrunkBaseIdStr})
-					}
-					outboundRouteFromTf.ExternalTrunkBases = &ids
-				}
-
-				outboundRoutesFromTf = append(outboundRoutesFromTf, outboundRouteFromTf)
+				outboundRoutesFromTf = append(outboundRoutesFromTf, buildSdkOutboundRoute(or.(map[string]interface{})))
 			}
 
 			// The default outbound routes won't be assigned yet if there isn't a wait
@@ -900,6 +754,21 @@ func updateSiteOutboundRoutes(d *schema.ResourceData, edgesAPI *platformclientv2
 	return nil
 }
 
+// customizeSiteDiff rejects number plans and outbound routes on a site that leaves them to their own resources
+func customizeSiteDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	if !diff.Get("manage_number_plans").(bool) && !rawConfig.GetAttr("number_plans").IsNull() {
+		return fmt.Errorf("number_plans cannot be set when manage_number_plans is false")
+	}
+	if !diff.Get("manage_outbound_routes").(bool) && !rawConfig.GetAttr("outbound_routes").IsNull() {
+		return fmt.Errorf("outbound_routes cannot be set when manage_outbound_routes is false")
+	}
+	return nil
+}
+
 func isDefaultPlan(name string) bool {
 	defaultPlans := []string{"Emergency", "Extension", "National", "International", "Network", "Suicide Prevent"}
 	for _, defaultPlan := range defaultPlans {
@@ -911,6 +780,11 @@ func isDefaultPlan(name string) bool {
 }
 
 func readSiteNumberPlans(d *schema.ResourceData, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) *resource.RetryError {
+	if !d.Get("manage_number_plans").(bool) {
+		d.Set("number_plans", nil)
+		return nil
+	}
+
 	numberPlans, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(d.Id())
 	if getErr != nil {
 		if isStatus404(resp) {
@@ -927,49 +801,7 @@ func readSiteNumberPlans(d *schema.ResourceData, edgesAPI *platformclientv2.Tele
 				continue
 			}
 
-			dNumberPlan := make(map[string]interface{})
-			dNumberPlan["name"] = *numberPlan.Name
-
-			if numberPlan.Match != nil {
-				dNumberPlan["match_format"] = *numberPlan.Match
-			}
-			if numberPlan.NormalizedFormat != nil {
-				dNumberPlan["normalized_format"] = *numberPlan.NormalizedFormat
-			}
-			if numberPlan.Classification != nil {
-				dNumberPlan["classification"] = *numberPlan.Classification
-			}
-			if numberPlan.MatchType != nil {
-				dNumberPlan["match_type"] = *numberPlan.MatchType
-			}
-
-			if numberPlan.Numbers != nil {
-				numbers := make([]interface{}, 0)
-				for _, number := range *numberPlan.Numbers {
-					numberMap := make(map[string]interface{})
-					if number.Start != nil {
-						numberMap["start"] = *number.Start
-					}
-					if number.End != nil {
-						numberMap["end"] = *number.End
-					}
-					numbers = append(numbers, numberMap)
-				}
-				dNumberPlan["numbers"] = numbers
-			}
-			if numberPlan.DigitLength != nil {
-				digitLength := make([]interface{}, 0)
-				digitLengthMap := make(map[string]interface{})
-				if numberPlan.DigitLength.Start != nil {
-					digitLengthMap["start"] = *numberPlan.DigitLength.Start
-				}
-				if numberPlan.DigitLength.End != nil {
-					digitLengthMap["end"] = *numberPlan.DigitLength.End
-				}
-				digitLength = append(digitLength, digitLengthMap)
-				dNumberPlan["digit_length"] = digitLength
-			}
-
+			dNumberPlan := flattenSdkNumberPlan(numberPlan)
 			dNumberPlans = append(dNumberPlans, dNumberPlan)
 		}
 		d.Set("number_plans", dNumberPlans)
@@ -981,6 +813,11 @@ func readSiteNumberPlans(d *schema.ResourceData, edgesAPI *platformclientv2.Tele
 }
 
 func readSiteOutboundRoutes(d *schema.ResourceData, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) *resource.RetryError {
+	if !d.Get("manage_outbound_routes").(bool) {
+		d.Set("outbound_routes", nil)
+		return nil
+	}
+
 	outboundRoutes := make([]platformclientv2.Outboundroutebase, 0)
 	for pageNum := 1; ; pageNum++ {
 		const pageSize = 100
@@ -998,33 +835,7 @@ func readSiteOutboundRoutes(d *schema.ResourceData, edgesAPI *platformclientv2.T
 
 	if len(outboundRoutes) > 0 {
 		for _, outboundRoute := range outboundRoutes {
-			dOutboundRoute := make(map[string]interface{})
-			dOutboundRoute["name"] = *outboundRoute.Name
-
-			if outboundRoute.Description != nil {
-				dOutboundRoute["description"] = *outboundRoute.Description
-			}
-
-			if outboundRoute.ClassificationTypes != nil {
-				dOutboundRoute["classification_types"] = *outboundRoute.ClassificationTypes
-			}
-
-			if outboundRoute.Enabled != nil {
-				dOutboundRoute["enabled"] = *outboundRoute.Enabled
-			}
-
-			if outboundRoute.Distribution != nil {
-				dOutboundRoute["distribution"] = *outboundRoute.Distribution
-			}
-
-			if len(*outboundRoute.ExternalTrunkBases) > 0 {
-				externalTrunkBaseIds := make([]string, 0)
-				for _, externalTrunkBase := range *outboundRoute.ExternalTrunkBases {
-					externalTrunkBaseIds = append(externalTrunkBaseIds, *externalTrunkBase.Id)
-				}
-				dOutboundRoute["external_trunk_base_ids"] = externalTrunkBaseIds
-			}
-
+			dOutboundRoute := flattenSdkOutboundRoute(outboundRoute)
 			dOutboundRoutes = append(dOutboundRoutes, dOutboundRoute)
 		}
 		d.Set("outbound_routes", dOutboundRoutes)
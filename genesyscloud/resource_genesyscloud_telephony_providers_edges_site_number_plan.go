@@ -0,0 +1,433 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+// siteNumberPlanSchema is shared by the number_plans of a site and the genesyscloud_telephony_providers_edges_site_number_plan resource
+func siteNumberPlanSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Description: "The name of the entity.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"match_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"digitLength", "e164NumberList", "interCountryCode", "intraCountryCode", "numberList", "regex"}, false),
+		},
+		"normalized_format": {
+			Description: "Use regular expression capture groups to build the normalized number",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"match_format": {
+			Description: "Use regular expression capture groups to build the normalized number",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"numbers": {
+			Description: "Numbers must be 2-9 digits long. Numbers within ranges must be the same length. (e.g. 888, 888-999, 55555-77777, 800).",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"end": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"digit_length": {
+			Description: "Allowed values are between 1-20 digits.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"end": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"classification": {
+			Description: "Used to classify this number plan",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+}
+
+func resourceSiteNumberPlan() *schema.Resource {
+	numberPlanSchema := siteNumberPlanSchema()
+	numberPlanSchema["name"].Description = "The name of the number plan. Number plans are identified by name within a site. Changing the name attribute will cause the number plan to be dropped and recreated."
+	numberPlanSchema["name"].ForceNew = true
+	numberPlanSchema["site_id"] = &schema.Schema{
+		Description: "The site of the number plan. The site must have manage_number_plans set to false. Changing the site_id attribute will cause the number plan to be dropped and recreated.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	}
+
+	return &schema.Resource{
+		Description: "Genesys Cloud Site Number Plan. Manages a single number plan of a site independently of the site resource. The ID of the resource is made up of the site ID and the number plan name in the format <site ID>,<name>.",
+
+		CreateContext: createWithPooledClient(createSiteNumberPlan),
+		ReadContext:   readWithPooledClient(readSiteNumberPlan),
+		UpdateContext: updateWithPooledClient(updateSiteNumberPlan),
+		DeleteContext: deleteWithPooledClient(deleteSiteNumberPlan),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema:        numberPlanSchema,
+	}
+}
+
+func getAllSiteNumberPlans(ctx context.Context, sdkConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(ResourceIDMetaMap)
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	sites, diagErr := getSites(ctx, sdkConfig)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	for siteId, siteMeta := range sites {
+		numberPlans, _, err := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(siteId)
+		if err != nil {
+			return nil, diag.Errorf("Failed to get number plans for site %s: %s", siteId, err)
+		}
+		for _, numberPlan := range numberPlans {
+			if numberPlan.Name == nil || isDefaultPlan(*numberPlan.Name) {
+				continue
+			}
+			resources[buildSiteChildId(siteId, *numberPlan.Name)] = &ResourceMeta{Name: siteMeta.Name + "_" + *numberPlan.Name}
+		}
+	}
+
+	return resources, nil
+}
+
+func siteNumberPlanExporter() *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(getAllSiteNumberPlans),
+		RefAttrs: map[string]*RefAttrSettings{
+			"site_id": {RefType: "genesyscloud_telephony_providers_edges_site"},
+		},
+	}
+}
+
+// Number plans are replaced as a list for the whole site. Updates of plans on the same site are serialized
+// so concurrent changes to different plans do not overwrite each other.
+var siteNumberPlansMutexes sync.Map
+
+func lockSiteNumberPlans(siteId string) func() {
+	mutex, _ := siteNumberPlansMutexes.LoadOrStore(siteId, &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock()
+	return mutex.(*sync.Mutex).Unlock
+}
+
+// The ID of number plans and outbound routes managed outside of the site resource is made up of the site ID and the name
+func buildSiteChildId(siteId, name string) string {
+	return fmt.Sprintf("%s,%s", siteId, name)
+}
+
+func parseSiteChildId(id string) (string, string, error) {
+	ids := strings.SplitN(id, ",", 2)
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		return "", "", fmt.Errorf("Invalid ID %s. Expected <site ID>,<name>", id)
+	}
+	return ids[0], ids[1], nil
+}
+
+func createSiteNumberPlan(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+	name := d.Get("name").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Creating number plan %s for site %s", name, siteId)
+	diagErr := putSiteNumberPlan(siteId, name, func(numberPlans []platformclientv2.Numberplan) ([]platformclientv2.Numberplan, diag.Diagnostics) {
+		if _, ok := nameInPlans(name, numberPlans); ok {
+			return nil, diag.Errorf("Number plan %s already exists for site %s. Import it to manage it with Terraform", name, siteId)
+		}
+		return append(numberPlans, buildSdkNumberPlan(siteNumberPlanResourceDataToMap(d))), nil
+	}, edgesAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(buildSiteChildId(siteId, name))
+	log.Printf("Created number plan %s for site %s", name, siteId)
+	return readSiteNumberPlan(ctx, d, meta)
+}
+
+func updateSiteNumberPlan(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId, name, err := parseSiteChildId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Updating number plan %s for site %s", name, siteId)
+	diagErr := putSiteNumberPlan(siteId, name, func(numberPlans []platformclientv2.Numberplan) ([]platformclientv2.Numberplan, diag.Diagnostics) {
+		numberPlanFromTf := buildSdkNumberPlan(siteNumberPlanResourceDataToMap(d))
+		for i, plan := range numberPlans {
+			if plan.Name != nil && *plan.Name == name {
+				numberPlans[i].Classification = numberPlanFromTf.Classification
+				numberPlans[i].Numbers = numberPlanFromTf.Numbers
+				numberPlans[i].DigitLength = numberPlanFromTf.DigitLength
+				numberPlans[i].Match = numberPlanFromTf.Match
+				numberPlans[i].MatchType = numberPlanFromTf.MatchType
+				numberPlans[i].NormalizedFormat = numberPlanFromTf.NormalizedFormat
+				return numberPlans, nil
+			}
+		}
+		return nil, diag.Errorf("Number plan %s no longer exists for site %s", name, siteId)
+	}, edgesAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated number plan %s for site %s", name, siteId)
+	return readSiteNumberPlan(ctx, d, meta)
+}
+
+func readSiteNumberPlan(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId, name, err := parseSiteChildId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Reading number plan %s for site %s", name, siteId)
+	return withRetriesForRead(ctx, d, func() *resource.RetryError {
+		numberPlans, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(siteId)
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to read number plans for site %s: %s", siteId, getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read number plans for site %s: %s", siteId, getErr))
+		}
+
+		numberPlan, ok := nameInPlans(name, numberPlans)
+		if !ok {
+			// The number plan was deleted outside of Terraform
+			log.Printf("Number plan %s for site %s not found", name, siteId)
+			d.SetId("")
+			return nil
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceSiteNumberPlan())
+		d.Set("site_id", siteId)
+		for attr, value := range flattenSdkNumberPlan(*numberPlan) {
+			d.Set(attr, value)
+		}
+		if numberPlan.Numbers == nil {
+			d.Set("numbers", nil)
+		}
+		if numberPlan.DigitLength == nil {
+			d.Set("digit_length", nil)
+		}
+
+		log.Printf("Read number plan %s for site %s", name, siteId)
+		return cc.CheckState()
+	})
+}
+
+func deleteSiteNumberPlan(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId, name, err := parseSiteChildId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Deleting number plan %s for site %s", name, siteId)
+	diagErr := putSiteNumberPlan(siteId, name, func(numberPlans []platformclientv2.Numberplan) ([]platformclientv2.Numberplan, diag.Diagnostics) {
+		remainingPlans := make([]platformclientv2.Numberplan, 0, len(numberPlans))
+		for _, plan := range numberPlans {
+			if plan.Name == nil || *plan.Name != name {
+				remainingPlans = append(remainingPlans, plan)
+			}
+		}
+		return remainingPlans, nil
+	}, edgesAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Deleted number plan %s for site %s", name, siteId)
+	return nil
+}
+
+// putSiteNumberPlan reads the current number plans of the site, applies the change and writes the plans back
+func putSiteNumberPlan(siteId, name string, change func([]platformclientv2.Numberplan) ([]platformclientv2.Numberplan, diag.Diagnostics), edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) diag.Diagnostics {
+	unlock := lockSiteNumberPlans(siteId)
+	defer unlock()
+
+	return retryWhen(isStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		numberPlans, resp, err := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(siteId)
+		if err != nil {
+			return resp, diag.Errorf("Failed to get number plans for site %s: %s", siteId, err)
+		}
+
+		updatedNumberPlans, diagErr := change(numberPlans)
+		if diagErr != nil {
+			return nil, diagErr
+		}
+
+		_, resp, err = edgesAPI.PutTelephonyProvidersEdgesSiteNumberplans(siteId, updatedNumberPlans)
+		if err != nil {
+			return resp, diag.Errorf("Failed to update number plan %s for site %s: %s", name, siteId, err)
+		}
+		// Wait for the update before reading
+		time.Sleep(5 * time.Second)
+		return resp, nil
+	})
+}
+
+func siteNumberPlanResourceDataToMap(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":              d.Get("name"),
+		"match_type":        d.Get("match_type"),
+		"match_format":      d.Get("match_format"),
+		"normalized_format": d.Get("normalized_format"),
+		"classification":    d.Get("classification"),
+		"numbers":           d.Get("numbers"),
+		"digit_length":      d.Get("digit_length"),
+	}
+}
+
+func buildSdkNumberPlan(npMap map[string]interface{}) platformclientv2.Numberplan {
+	numberPlan := platformclientv2.Numberplan{}
+
+	if name := npMap["name"].(string); name != "" {
+		numberPlan.Name = &name
+	}
+
+	if matchType := npMap["match_type"].(string); matchType != "" {
+		numberPlan.MatchType = &matchType
+	}
+
+	if matchFormat := npMap["match_format"].(string); matchFormat != "" {
+		numberPlan.Match = &matchFormat
+	}
+
+	if normalizedFormat := npMap["normalized_format"].(string); normalizedFormat != "" {
+		numberPlan.NormalizedFormat = &normalizedFormat
+	}
+
+	if classification := npMap["classification"].(string); classification != "" {
+		numberPlan.Classification = &classification
+	}
+
+	if numbers, ok := npMap["numbers"].([]interface{}); ok && len(numbers) > 0 {
+		sdkNumbers := make([]platformclientv2.Number, 0)
+		for _, number := range numbers {
+			numberMap := number.(map[string]interface{})
+			sdkNumber := platformclientv2.Number{}
+			if start, ok := numberMap["start"].(string); ok {
+				sdkNumber.Start = &start
+			}
+			if end, ok := numberMap["end"].(string); ok {
+				sdkNumber.End = &end
+			}
+			sdkNumbers = append(sdkNumbers, sdkNumber)
+		}
+		numberPlan.Numbers = &sdkNumbers
+	}
+
+	if digitLength, ok := npMap["digit_length"].([]interface{}); ok && len(digitLength) > 0 {
+		sdkDigitlengthMap := digitLength[0].(map[string]interface{})
+		sdkDigitlength := platformclientv2.Digitlength{}
+		if start, ok := sdkDigitlengthMap["start"].(string); ok {
+			sdkDigitlength.Start = &start
+		}
+		if end, ok := sdkDigitlengthMap["end"].(string); ok {
+			sdkDigitlength.End = &end
+		}
+		numberPlan.DigitLength = &sdkDigitlength
+	}
+
+	return numberPlan
+}
+
+func flattenSdkNumberPlan(numberPlan platformclientv2.Numberplan) map[string]interface{} {
+	dNumberPlan := make(map[string]interface{})
+	dNumberPlan["name"] = *numberPlan.Name
+
+	if numberPlan.Match != nil {
+		dNumberPlan["match_format"] = *numberPlan.Match
+	}
+	if numberPlan.NormalizedFormat != nil {
+		dNumberPlan["normalized_format"] = *numberPlan.NormalizedFormat
+	}
+	if numberPlan.Classification != nil {
+		dNumberPlan["classification"] = *numberPlan.Classification
+	}
+	if numberPlan.MatchType != nil {
+		dNumberPlan["match_type"] = *numberPlan.MatchType
+	}
+
+	if numberPlan.Numbers != nil {
+		numbers := make([]interface{}, 0)
+		for _, number := range *numberPlan.Numbers {
+			numberMap := make(map[string]interface{})
+			if number.Start != nil {
+				numberMap["start"] = *number.Start
+			}
+			if number.End != nil {
+				numberMap["end"] = *number.End
+			}
+			numbers = append(numbers, numberMap)
+		}
+		dNumberPlan["numbers"] = numbers
+	}
+	if numberPlan.DigitLength != nil {
+		digitLength := make([]interface{}, 0)
+		digitLengthMap := make(map[string]interface{})
+		if numberPlan.DigitLength.Start != nil {
+			digitLengthMap["start"] = *numberPlan.DigitLength.Start
+		}
+		if numberPlan.DigitLength.End != nil {
+			digitLengthMap["end"] = *numberPlan.DigitLength.End
+		}
+		digitLength = append(digitLength, digitLengthMap)
+		dNumberPlan["digit_length"] = digitLength
+	}
+
+	return dNumberPlan
+}
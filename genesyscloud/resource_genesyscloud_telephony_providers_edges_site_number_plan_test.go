@@ -0,0 +1,167 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourceSiteNumberPlan(t *testing.T) {
+	t.Parallel()
+	var (
+		// site
+		siteRes     = "site"
+		siteName    = "site " + uuid.NewString()
+		description = "TestAccResourceSiteNumberPlan description"
+		mediaModel  = "Cloud"
+
+		// number plan
+		numberPlanRes = "number-plan"
+		planName      = "numberList plan"
+
+		// location
+		locationRes = "test-location1"
+	)
+
+	err := authorizeSdk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emergencyNumber := "+13173124745"
+	err = deleteLocationWithNumber(emergencyNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	location := generateLocationResource(
+		locationRes,
+		"Terraform location"+uuid.NewString(),
+		"HQ1",
+		[]string{},
+		generateLocationEmergencyNum(
+			emergencyNumber,
+			nullValue, // Default number type
+		), generateLocationAddress(
+			"7601 Interactive Way",
+			"Indianapolis",
+			"IN",
+			"US",
+			"46278",
+		))
+
+	site := generateSiteResourceWithCustomAttrs(
+		siteRes,
+		siteName,
+		description,
+		"genesyscloud_location."+locationRes+".id",
+		mediaModel,
+		false,
+		"[\"us-west-2\"]",
+		strconv.Quote("+19205551212"),
+		strconv.Quote("Wilco plumbing"),
+		"manage_number_plans = false")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateSiteNumberPlanResource(
+					numberPlanRes,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					planName,
+					"numberList classification",
+					"numberList",
+					generateSiteNumberPlansNumber("114", "115"),
+				) + site + location,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "site_id", "genesyscloud_telephony_providers_edges_site."+siteRes, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "name", planName),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "classification", "numberList classification"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "match_type", "numberList"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "numbers.0.start", "114"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "numbers.0.end", "115"),
+					resource.TestCheckNoResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "number_plans.0.name"),
+				),
+			},
+			// Update the classification and numbers
+			{
+				Config: generateSiteNumberPlanResource(
+					numberPlanRes,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					planName,
+					"numberList classification updated",
+					"numberList",
+					generateSiteNumberPlansNumber("114", "116"),
+					generateSiteNumberPlansNumber("118", "119"),
+				) + site + location,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "classification", "numberList classification updated"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "numbers.0.start", "114"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "numbers.0.end", "116"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "numbers.1.start", "118"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "numbers.1.end", "119"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_telephony_providers_edges_site_number_plan." + numberPlanRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifySiteNumberPlansDestroyed,
+	})
+}
+
+func testVerifySiteNumberPlansDestroyed(state *terraform.State) error {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_telephony_providers_edges_site_number_plan" {
+			continue
+		}
+
+		siteId, name, err := parseSiteChildId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		numberPlans, resp, err := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(siteId)
+		if err != nil {
+			if isStatus404(resp) {
+				// site not found as expected
+				continue
+			}
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+		if _, ok := nameInPlans(name, numberPlans); ok {
+			return fmt.Errorf("number plan (%s) still exists", rs.Primary.ID)
+		}
+	}
+	// Success. All number plans destroyed
+	return nil
+}
+
+func generateSiteNumberPlanResource(
+	numberPlanRes,
+	siteId,
+	name,
+	classification,
+	matchType string,
+	otherAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_site_number_plan" "%s" {
+		site_id = %s
+		name = "%s"
+		classification = "%s"
+		match_type = "%s"
+		%s
+	}
+	`, numberPlanRes, siteId, name, classification, matchType, strings.Join(otherAttrs, "\n"))
+}
@@ -0,0 +1,362 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+// siteOutboundRouteSchema is shared by the outbound_routes of a site and the genesyscloud_telephony_providers_edges_site_outbound_route resource
+func siteOutboundRouteSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Description: "The name of the entity.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"description": {
+			Description: "The resource's description.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"classification_types": {
+			Description: "Used to classify this outbound route.",
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"enabled": {
+			Description: "Enable or disable the outbound route",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"distribution": {
+			Description:  "Valid values: SEQUENTIAL, RANDOM.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "SEQUENTIAL",
+			ValidateFunc: validation.StringInSlice([]string{"SEQUENTIAL", "RANDOM"}, false),
+		},
+		"external_trunk_base_ids": {
+			Description: "Trunk base settings of trunkType \"EXTERNAL\". This base must also be set on an edge logical interface for correct routing. The order of the IDs determines the distribution if \"distribution\" is set to \"SEQUENTIAL\"",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func resourceSiteOutboundRoute() *schema.Resource {
+	outboundRouteSchema := siteOutboundRouteSchema()
+	outboundRouteSchema["name"].Description = "The name of the outbound route. Outbound routes are identified by name within a site. Changing the name attribute will cause the outbound route to be dropped and recreated."
+	outboundRouteSchema["name"].ForceNew = true
+	outboundRouteSchema["site_id"] = &schema.Schema{
+		Description: "The site of the outbound route. The site must have manage_outbound_routes set to false. Changing the site_id attribute will cause the outbound route to be dropped and recreated.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	}
+
+	return &schema.Resource{
+		Description: "Genesys Cloud Site Outbound Route. Manages a single outbound route of a site independently of the site resource. The ID of the resource is made up of the site ID and the outbound route name in the format <site ID>,<name>.",
+
+		CreateContext: createWithPooledClient(createSiteOutboundRoute),
+		ReadContext:   readWithPooledClient(readSiteOutboundRoute),
+		UpdateContext: updateWithPooledClient(updateSiteOutboundRoute),
+		DeleteContext: deleteWithPooledClient(deleteSiteOutboundRoute),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema:        outboundRouteSchema,
+	}
+}
+
+func getAllSiteOutboundRoutes(ctx context.Context, sdkConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(ResourceIDMetaMap)
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	sites, diagErr := getSites(ctx, sdkConfig)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	for siteId, siteMeta := range sites {
+		outboundRoutes, err := getAllOutboundRoutesForSite(siteId, "", edgesAPI)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		for _, outboundRoute := range outboundRoutes {
+			resources[buildSiteChildId(siteId, *outboundRoute.Name)] = &ResourceMeta{Name: siteMeta.Name + "_" + *outboundRoute.Name}
+		}
+	}
+
+	return resources, nil
+}
+
+func siteOutboundRouteExporter() *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(getAllSiteOutboundRoutes),
+		RefAttrs: map[string]*RefAttrSettings{
+			"site_id":                 {RefType: "genesyscloud_telephony_providers_edges_site"},
+			"external_trunk_base_ids": {RefType: "genesyscloud_telephony_providers_edges_trunkbasesettings"},
+		},
+	}
+}
+
+func createSiteOutboundRoute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+	name := d.Get("name").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	existingRoute, err := getSiteOutboundRouteByName(siteId, name, edgesAPI)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if existingRoute != nil {
+		return diag.Errorf("Outbound route %s already exists for site %s. Import it to manage it with Terraform", name, siteId)
+	}
+
+	log.Printf("Creating outbound route %s for site %s", name, siteId)
+	_, _, err = edgesAPI.PostTelephonyProvidersEdgesSiteOutboundroutes(siteId, buildSdkOutboundRoute(siteOutboundRouteResourceDataToMap(d)))
+	if err != nil {
+		return diag.Errorf("Failed to add outbound route %s to site %s: %s", name, siteId, err)
+	}
+
+	d.SetId(buildSiteChildId(siteId, name))
+	log.Printf("Created outbound route %s for site %s", name, siteId)
+	return readSiteOutboundRoute(ctx, d, meta)
+}
+
+func updateSiteOutboundRoute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId, name, err := parseSiteChildId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Updating outbound route %s for site %s", name, siteId)
+	diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		outboundRoute, err := getSiteOutboundRouteByName(siteId, name, edgesAPI)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if outboundRoute == nil {
+			return nil, diag.Errorf("Outbound route %s no longer exists for site %s", name, siteId)
+		}
+
+		outboundRouteFromTf := buildSdkOutboundRoute(siteOutboundRouteResourceDataToMap(d))
+		outboundRoute.Description = outboundRouteFromTf.Description
+		outboundRoute.ClassificationTypes = outboundRouteFromTf.ClassificationTypes
+		outboundRoute.Enabled = outboundRouteFromTf.Enabled
+		outboundRoute.Distribution = outboundRouteFromTf.Distribution
+		outboundRoute.ExternalTrunkBases = outboundRouteFromTf.ExternalTrunkBases
+
+		_, resp, err := edgesAPI.PutTelephonyProvidersEdgesSiteOutboundroute(siteId, *outboundRoute.Id, *outboundRoute)
+		if err != nil {
+			return resp, diag.Errorf("Failed to update outbound route %s for site %s: %s", name, siteId, err)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated outbound route %s for site %s", name, siteId)
+	return readSiteOutboundRoute(ctx, d, meta)
+}
+
+func readSiteOutboundRoute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId, name, err := parseSiteChildId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Reading outbound route %s for site %s", name, siteId)
+	return withRetriesForRead(ctx, d, func() *resource.RetryError {
+		outboundRoute, err := getSiteOutboundRouteByName(siteId, name, edgesAPI)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if outboundRoute == nil {
+			// The outbound route was deleted outside of Terraform
+			log.Printf("Outbound route %s for site %s not found", name, siteId)
+			d.SetId("")
+			return nil
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceSiteOutboundRoute())
+		d.Set("site_id", siteId)
+		d.Set("external_trunk_base_ids", nil)
+		for attr, value := range flattenSdkOutboundRoute(*outboundRoute) {
+			d.Set(attr, value)
+		}
+
+		log.Printf("Read outbound route %s for site %s", name, siteId)
+		return cc.CheckState()
+	})
+}
+
+func deleteSiteOutboundRoute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId, name, err := parseSiteChildId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	outboundRoute, err := getSiteOutboundRouteByName(siteId, name, edgesAPI)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if outboundRoute == nil {
+		log.Printf("Outbound route %s already deleted for site %s", name, siteId)
+		return nil
+	}
+
+	log.Printf("Deleting outbound route %s for site %s", name, siteId)
+	resp, err := edgesAPI.DeleteTelephonyProvidersEdgesSiteOutboundroute(siteId, *outboundRoute.Id)
+	if err != nil {
+		if isStatus404(resp) {
+			log.Printf("Outbound route %s already deleted for site %s", name, siteId)
+			return nil
+		}
+		return diag.Errorf("Failed to delete outbound route %s from site %s: %s", name, siteId, err)
+	}
+
+	return withRetries(ctx, 30*time.Second, func() *resource.RetryError {
+		outboundRoute, err := getSiteOutboundRouteByName(siteId, name, edgesAPI)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error deleting outbound route %s from site %s: %s", name, siteId, err))
+		}
+		if outboundRoute == nil {
+			log.Printf("Deleted outbound route %s from site %s", name, siteId)
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("Outbound route %s still exists for site %s", name, siteId))
+	})
+}
+
+// getAllOutboundRoutesForSite returns the outbound routes of the site. A deleted site has no outbound routes.
+func getAllOutboundRoutesForSite(siteId, name string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) ([]platformclientv2.Outboundroutebase, error) {
+	outboundRoutes := make([]platformclientv2.Outboundroutebase, 0)
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		outboundRouteEntityListing, resp, err := edgesAPI.GetTelephonyProvidersEdgesSiteOutboundroutes(siteId, pageSize, pageNum, name, "", "")
+		if err != nil {
+			if isStatus404(resp) {
+				return outboundRoutes, nil
+			}
+			return nil, fmt.Errorf("Failed to get outbound routes for site %s: %s", siteId, err)
+		}
+		if outboundRouteEntityListing.Entities == nil || len(*outboundRouteEntityListing.Entities) == 0 {
+			break
+		}
+		outboundRoutes = append(outboundRoutes, *outboundRouteEntityListing.Entities...)
+	}
+	return outboundRoutes, nil
+}
+
+func getSiteOutboundRouteByName(siteId, name string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) (*platformclientv2.Outboundroutebase, error) {
+	// The name filter is not an exact match
+	outboundRoutes, err := getAllOutboundRoutesForSite(siteId, name, edgesAPI)
+	if err != nil {
+		return nil, err
+	}
+	outboundRoute, _ := nameInOutboundRoutes(name, outboundRoutes)
+	return outboundRoute, nil
+}
+
+func siteOutboundRouteResourceDataToMap(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                    d.Get("name"),
+		"description":             d.Get("description"),
+		"classification_types":    d.Get("classification_types"),
+		"enabled":                 d.Get("enabled"),
+		"distribution":            d.Get("distribution"),
+		"external_trunk_base_ids": d.Get("external_trunk_base_ids"),
+	}
+}
+
+func buildSdkOutboundRoute(orMap map[string]interface{}) platformclientv2.Outboundroutebase {
+	outboundRoute := platformclientv2.Outboundroutebase{}
+
+	if name := orMap["name"].(string); name != "" {
+		outboundRoute.Name = &name
+	}
+	if description := orMap["description"].(string); description != "" {
+		outboundRoute.Description = &description
+	}
+	if classificationTypes, ok := orMap["classification_types"].([]interface{}); ok && len(classificationTypes) > 0 {
+		cts := make([]string, 0)
+		for _, classificationType := range classificationTypes {
+			cts = append(cts, classificationType.(string))
+		}
+		outboundRoute.ClassificationTypes = &cts
+	}
+	if enabled, ok := orMap["enabled"].(bool); ok {
+		outboundRoute.Enabled = &enabled
+	}
+	if distribution := orMap["distribution"].(string); distribution != "" {
+		outboundRoute.Distribution = &distribution
+	}
+	if externalTrunkBaseIds, ok := orMap["external_trunk_base_ids"].([]interface{}); ok && len(externalTrunkBaseIds) > 0 {
+		ids := make([]platformclientv2.Domainentityref, 0)
+		for _, externalTrunkBaseId := range externalTrunkBaseIds {
+			externalTrunkBaseIdStr := externalTrunkBaseId.(string)
+			ids = append(ids, platformclientv2.Domainentityref{Id: &externalTrunkBaseIdStr})
+		}
+		outboundRoute.ExternalTrunkBases = &ids
+	}
+
+	return outboundRoute
+}
+
+func flattenSdkOutboundRoute(outboundRoute platformclientv2.Outboundroutebase) map[string]interface{} {
+	dOutboundRoute := make(map[string]interface{})
+	dOutboundRoute["name"] = *outboundRoute.Name
+
+	if outboundRoute.Description != nil {
+		dOutboundRoute["description"] = *outboundRoute.Description
+	}
+
+	if outboundRoute.ClassificationTypes != nil {
+		dOutboundRoute["classification_types"] = *outboundRoute.ClassificationTypes
+	}
+
+	if outboundRoute.Enabled != nil {
+		dOutboundRoute["enabled"] = *outboundRoute.Enabled
+	}
+
+	if outboundRoute.Distribution != nil {
+		dOutboundRoute["distribution"] = *outboundRoute.Distribution
+	}
+
+	if outboundRoute.ExternalTrunkBases != nil && len(*outboundRoute.ExternalTrunkBases) > 0 {
+		externalTrunkBaseIds := make([]string, 0)
+		for _, externalTrunkBase := range *outboundRoute.ExternalTrunkBases {
+			externalTrunkBaseIds = append(externalTrunkBaseIds, *externalTrunkBase.Id)
+		}
+		dOutboundRoute["external_trunk_base_ids"] = externalTrunkBaseIds
+	}
+
+	return dOutboundRoute
+}
@@ -0,0 +1,189 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+func TestAccResourceSiteOutboundRoute(t *testing.T) {
+	t.Parallel()
+	var (
+		// site
+		siteRes     = "site"
+		siteName    = "site " + uuid.NewString()
+		description = "TestAccResourceSiteOutboundRoute description"
+		mediaModel  = "Cloud"
+
+		// outbound route
+		outboundRouteRes = "outbound-route"
+		routeName        = "outboundRoute " + uuid.NewString()
+
+		// location
+		locationRes = "test-location1"
+	)
+
+	err := authorizeSdk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emergencyNumber := "+13173124746"
+	err = deleteLocationWithNumber(emergencyNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	location := generateLocationResource(
+		locationRes,
+		"Terraform location"+uuid.NewString(),
+		"HQ1",
+		[]string{},
+		generateLocationEmergencyNum(
+			emergencyNumber,
+			nullValue, // Default number type
+		), generateLocationAddress(
+			"7601 Interactive Way",
+			"Indianapolis",
+			"IN",
+			"US",
+			"46278",
+		))
+
+	site := generateSiteResourceWithCustomAttrs(
+		siteRes,
+		siteName,
+		description,
+		"genesyscloud_location."+locationRes+".id",
+		mediaModel,
+		false,
+		"[\"us-west-2\"]",
+		strconv.Quote("+19205551212"),
+		strconv.Quote("Wilco plumbing"),
+		"manage_outbound_routes = false")
+
+	trunkBaseSettings1 := generateTrunkBaseSettingsResourceWithCustomAttrs(
+		"trunkBaseSettings1",
+		"test trunk base settings "+uuid.NewString(),
+		"test description",
+		"external_sip.json",
+		"EXTERNAL",
+		false)
+
+	trunkBaseSettings2 := generateTrunkBaseSettingsResourceWithCustomAttrs(
+		"trunkBaseSettings2",
+		"test trunk base settings "+uuid.NewString(),
+		"test description",
+		"external_sip.json",
+		"EXTERNAL",
+		false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateSiteOutboundRouteResource(
+					outboundRouteRes,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					routeName,
+					"outboundRoute description",
+					strconv.Quote("International"),
+					"genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings1.id",
+					"RANDOM",
+					false,
+				) + site + trunkBaseSettings1 + location,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "site_id", "genesyscloud_telephony_providers_edges_site."+siteRes, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "name", routeName),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "description", "outboundRoute description"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "classification_types.0", "International"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "distribution", "RANDOM"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "enabled", falseValue),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "external_trunk_base_ids.0", "genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings1", "id"),
+					resource.TestCheckNoResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "outbound_routes.0.name"),
+				),
+			},
+			// Update the description, classification types, trunk base ids, distribution and enabled value
+			{
+				Config: generateSiteOutboundRouteResource(
+					outboundRouteRes,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					routeName,
+					"outboundRoute description updated",
+					strings.Join([]string{strconv.Quote("Network"), strconv.Quote("International")}, ","),
+					strings.Join([]string{"genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings1.id", "genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings2.id"}, ","),
+					"SEQUENTIAL",
+					true,
+				) + site + trunkBaseSettings1 + trunkBaseSettings2 + location,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "description", "outboundRoute description updated"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "classification_types.0", "Network"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "classification_types.1", "International"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "distribution", "SEQUENTIAL"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "enabled", trueValue),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "external_trunk_base_ids.0", "genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings1", "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "external_trunk_base_ids.1", "genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings2", "id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_telephony_providers_edges_site_outbound_route." + outboundRouteRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifySiteOutboundRoutesDestroyed,
+	})
+}
+
+func testVerifySiteOutboundRoutesDestroyed(state *terraform.State) error {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_telephony_providers_edges_site_outbound_route" {
+			continue
+		}
+
+		siteId, name, err := parseSiteChildId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		outboundRoute, err := getSiteOutboundRouteByName(siteId, name, edgesAPI)
+		if err != nil {
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+		if outboundRoute != nil {
+			return fmt.Errorf("outbound route (%s) still exists", rs.Primary.ID)
+		}
+	}
+	// Success. All outbound routes destroyed
+	return nil
+}
+
+func generateSiteOutboundRouteResource(
+	outboundRouteRes,
+	siteId,
+	name,
+	description,
+	classificationTypes,
+	externalTrunkBaseIds,
+	distribution string,
+	enabled bool) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_site_outbound_route" "%s" {
+		site_id = %s
+		name = "%s"
+		description = "%s"
+		classification_types = [%s]
+		external_trunk_base_ids = [%s]
+		distribution = "%s"
+		enabled = %v
+	}
+	`, outboundRouteRes, siteId, name, description, classificationTypes, externalTrunkBaseIds, distribution, enabled)
+}
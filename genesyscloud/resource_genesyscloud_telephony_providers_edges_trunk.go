@@ -16,7 +16,7 @@ import (
 
 func resourceTrunk() *schema.Resource {
 	return &schema.Resource{
-		Description: "Genesys Cloud Trunk. Created by assigning a trunk base settings to an edge or edge group",
+		Description: "Genesys Cloud Trunk. Created by assigning a trunk base settings to an edge or edge group. Changing the edge, edge group or trunk base settings moves the assignment, and destroying the resource removes it.",
 
 		CreateContext: createWithPooledClient(createTrunk),
 		ReadContext:   readWithPooledClient(readTrunk),
@@ -33,13 +33,13 @@ func resourceTrunk() *schema.Resource {
 				Optional:    true,
 			},
 			"edge_group_id": {
-				Description: "The edge group associated with this trunk. Either this or \"edge_id\" must be set",
+				Description: "The edge group associated with this trunk. Either this or \"edge_id\" must be set. Only read from the trunk when set or on import.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
 			},
 			"edge_id": {
-				Description: "The edge associated with this trunk. Either this or \"edge_group_id\" must be set",
+				Description: "The edge associated with this trunk. Either this or \"edge_group_id\" must be set. Only read from the trunk when set or on import.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
@@ -50,82 +50,194 @@ func resourceTrunk() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"state": {
+				Description: "The state of the trunk.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"trunk_type": {
+				Description: "The type of this trunk base (EXTERNAL | PHONE | EDGE).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"in_service": {
+				Description: "True if this trunk is in-service.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"enabled": {
+				Description: "True if the trunk base settings assigned to this trunk are enabled.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"connected": {
+				Description: "True if the trunk is connected.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"connected_state_time": {
+				Description: "The time the connected state of the trunk last changed, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"options_status": {
+				Description: "The SIP OPTIONS ping status of each proxy configured on the trunk.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"proxy_address": {
+							Description: "The proxy address (IP or FQDN) of the SIP OPTIONS ping.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"option_state": {
+							Description: "True if the last SIP OPTIONS ping to the proxy succeeded.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"option_state_time": {
+							Description: "The time the option state last changed, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"error_code": {
+							Description: "The error code of the last failed SIP OPTIONS ping.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"error_text": {
+							Description: "The error text of the last failed SIP OPTIONS ping.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func createTrunk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	trunkBaseSettingsId := d.Get("trunk_base_settings_id").(string)
+	edgeId := d.Get("edge_id").(string)
+	edgeGroupId := d.Get("edge_group_id").(string)
 
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
 
-	trunkBase, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesTrunkbasesetting(trunkBaseSettingsId, true)
+	if diagErr := assignTrunkBaseSettings(edgesAPI, trunkBaseSettingsId, edgeId, edgeGroupId); diagErr != nil {
+		return diagErr
+	}
+
+	trunk, err := getTrunkByTrunkBaseId(trunkBaseSettingsId, edgeId, edgeGroupId, "", meta)
+	if err != nil {
+		return diag.Errorf("Failed to get trunk by trunk base id %s: %s", trunkBaseSettingsId, err)
+	}
+
+	d.SetId(*trunk.Id)
+
+	log.Printf("Created trunk %s", *trunk.Id)
+
+	return readTrunk(ctx, d, meta)
+}
+
+// assignTrunkBaseSettings assigns the trunk base settings to the edge if edgeId is set, otherwise to the edge group.
+// Genesys Cloud creates the trunk once the trunk base settings are assigned.
+func assignTrunkBaseSettings(edgesAPI *platformclientv2.TelephonyProvidersEdgeApi, trunkBaseSettingsId, edgeId, edgeGroupId string) diag.Diagnostics {
+	trunkBase, _, getErr := edgesAPI.GetTelephonyProvidersEdgesTrunkbasesetting(trunkBaseSettingsId, true)
 	if getErr != nil {
-		if isStatus404(resp) {
-			return nil
-		}
-		return diag.Errorf("Failed to read trunk base settings %s: %s", d.Id(), getErr)
+		return diag.Errorf("Failed to read trunk base settings %s: %s", trunkBaseSettingsId, getErr)
+	}
+	assignment := &platformclientv2.Trunkbaseassignment{
+		TrunkBase: trunkBase,
 	}
 
-	// Assign to edge if edge_id is set
-	if edgeIdI, ok := d.GetOk("edge_id"); ok {
-		edgeId := edgeIdI.(string)
-		edge, resp, getErr := edgesAPI.GetTelephonyProvidersEdge(edgeId, nil)
+	if edgeId != "" {
+		edge, _, getErr := edgesAPI.GetTelephonyProvidersEdge(edgeId, nil)
 		if getErr != nil {
-			if isStatus404(resp) {
-				return nil
-			}
 			return diag.Errorf("Failed to read edge %s: %s", edgeId, getErr)
 		}
 
 		if edge.EdgeGroup == nil {
 			edge.EdgeGroup = &platformclientv2.Edgegroup{}
 		}
-		edge.EdgeGroup.EdgeTrunkBaseAssignment = &platformclientv2.Trunkbaseassignment{
-			TrunkBase: trunkBase,
-		}
+		edge.EdgeGroup.EdgeTrunkBaseAssignment = assignment
 
-		log.Printf("Assigning trunk base settings to edge %s", edgeId)
+		log.Printf("Assigning trunk base settings %s to edge %s", trunkBaseSettingsId, edgeId)
 		_, _, err := edgesAPI.PutTelephonyProvidersEdge(edgeId, *edge)
 		if err != nil {
 			return diag.Errorf("Failed to assign trunk base settings to edge %s: %s", edgeId, err)
 		}
-	} else if edgeGroupIdI, ok := d.GetOk("edge_group_id"); ok {
-		edgeGroupId := edgeGroupIdI.(string)
-		edgeGroup, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesEdgegroup(edgeGroupId, nil)
+		return nil
+	}
+
+	if edgeGroupId != "" {
+		edgeGroup, _, getErr := edgesAPI.GetTelephonyProvidersEdgesEdgegroup(edgeGroupId, nil)
 		if getErr != nil {
-			if isStatus404(resp) {
-				return diag.Errorf("Failed to get edge group %s: %s", edgeGroupId, getErr)
-			}
 			return diag.Errorf("Failed to read edge group %s: %s", edgeGroupId, getErr)
 		}
-		edgeGroup.EdgeTrunkBaseAssignment = &platformclientv2.Trunkbaseassignment{
-			TrunkBase: trunkBase,
-		}
+		edgeGroup.EdgeTrunkBaseAssignment = assignment
 
-		log.Printf("Assigning trunk base settings to edge group %s", edgeGroupId)
+		log.Printf("Assigning trunk base settings %s to edge group %s", trunkBaseSettingsId, edgeGroupId)
 		_, _, err := edgesAPI.PutTelephonyProvidersEdgesEdgegroup(edgeGroupId, *edgeGroup)
 		if err != nil {
 			return diag.Errorf("Failed to assign trunk base settings to edge group %s: %s", edgeGroupId, err)
 		}
-	} else {
-		return diag.Errorf("edge_id or edge_group_id were not set. One must be set in order to assign the trunk base settings")
+		return nil
 	}
 
-	trunk, err := getTrunkByTrunkBaseId(trunkBaseSettingsId, meta)
-	if err != nil {
-		return diag.Errorf("Failed to get trunk by trunk base id %s: %s", trunkBaseSettingsId, err)
+	return diag.Errorf("edge_id or edge_group_id were not set. One must be set in order to assign the trunk base settings")
+}
+
+// unassignTrunkBaseSettings removes the trunk base settings assignment from the edge and edge group.
+// An assignment is left alone if the edge or edge group has since been assigned different trunk base settings.
+func unassignTrunkBaseSettings(edgesAPI *platformclientv2.TelephonyProvidersEdgeApi, trunkBaseSettingsId, edgeId, edgeGroupId string) diag.Diagnostics {
+	if edgeId != "" {
+		edge, resp, getErr := edgesAPI.GetTelephonyProvidersEdge(edgeId, nil)
+		if getErr != nil && !isStatus404(resp) {
+			return diag.Errorf("Failed to read edge %s: %s", edgeId, getErr)
+		}
+		if getErr == nil && edge.EdgeGroup != nil && isTrunkBaseAssigned(edge.EdgeGroup.EdgeTrunkBaseAssignment, trunkBaseSettingsId) {
+			edge.EdgeGroup.EdgeTrunkBaseAssignment = nil
+
+			log.Printf("Removing trunk base settings %s from edge %s", trunkBaseSettingsId, edgeId)
+			_, _, err := edgesAPI.PutTelephonyProvidersEdge(edgeId, *edge)
+			if err != nil {
+				return diag.Errorf("Failed to remove trunk base settings from edge %s: %s", edgeId, err)
+			}
+		}
 	}
 
-	d.SetId(*trunk.Id)
+	if edgeGroupId != "" {
+		edgeGroup, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesEdgegroup(edgeGroupId, nil)
+		if getErr != nil {
+			if isStatus404(resp) {
+				return nil
+			}
+			return diag.Errorf("Failed to read edge group %s: %s", edgeGroupId, getErr)
+		}
+		if !isTrunkBaseAssigned(edgeGroup.EdgeTrunkBaseAssignment, trunkBaseSettingsId) {
+			return nil
+		}
+		edgeGroup.EdgeTrunkBaseAssignment = nil
 
-	log.Printf("Created trunk %s", *trunk.Id)
+		log.Printf("Removing trunk base settings %s from edge group %s", trunkBaseSettingsId, edgeGroupId)
+		_, _, err := edgesAPI.PutTelephonyProvidersEdgesEdgegroup(edgeGroupId, *edgeGroup)
+		if err != nil {
+			return diag.Errorf("Failed to remove trunk base settings from edge group %s: %s", edgeGroupId, err)
+		}
+	}
+	return nil
+}
 
-	return readTrunk(ctx, d, meta)
+func isTrunkBaseAssigned(assignment *platformclientv2.Trunkbaseassignment, trunkBaseSettingsId string) bool {
+	return assignment != nil && assignment.TrunkBase != nil && assignment.TrunkBase.Id != nil && *assignment.TrunkBase.Id == trunkBaseSettingsId
 }
 
-func getTrunkByTrunkBaseId(trunkBaseId string, meta interface{}) (*platformclientv2.Trunk, error) {
+// getTrunkByTrunkBaseId returns the trunk created for the trunk base settings on the edge or edge group.
+// previousTrunkId is skipped as it may still be listed while it is deleted after the trunk base settings were moved.
+func getTrunkByTrunkBaseId(trunkBaseId, edgeId, edgeGroupId, previousTrunkId string, meta interface{}) (*platformclientv2.Trunk, error) {
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
 
@@ -133,7 +245,7 @@ func getTrunkByTrunkBaseId(trunkBaseId string, meta interface{}) (*platformclien
 	// It should return the trunk as the first object. Paginating to be safe
 	for pageNum := 1; ; pageNum++ {
 		const pageSize = 100
-		trunks, _, getErr := edgesAPI.GetTelephonyProvidersEdgesTrunks(pageNum, pageSize, "", "", "", "", "")
+		trunks, _, getErr := edgesAPI.GetTelephonyProvidersEdgesTrunks(pageNum, pageSize, "", "", edgeId, trunkBaseId, "")
 		if getErr != nil {
 			return nil, fmt.Errorf("Failed to get page of trunks: %v", getErr)
 		}
@@ -143,7 +255,16 @@ func getTrunkByTrunkBaseId(trunkBaseId string, meta interface{}) (*platformclien
 		}
 
 		for _, trunk := range *trunks.Entities {
-			if *trunk.TrunkBase.Id == trunkBaseId {
+			if trunk.State != nil && *trunk.State == "deleted" {
+				continue
+			}
+			if previousTrunkId != "" && trunk.Id != nil && *trunk.Id == previousTrunkId {
+				continue
+			}
+			if edgeId == "" && edgeGroupId != "" && (trunk.EdgeGroup == nil || trunk.EdgeGroup.Id == nil || *trunk.EdgeGroup.Id != edgeGroupId) {
+				continue
+			}
+			if trunk.TrunkBase != nil && *trunk.TrunkBase.Id == trunkBaseId {
 				return &trunk, nil
 			}
 		}
@@ -153,7 +274,46 @@ func getTrunkByTrunkBaseId(trunkBaseId string, meta interface{}) (*platformclien
 }
 
 func updateTrunk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return createTrunk(ctx, d, meta)
+	if !d.HasChanges("trunk_base_settings_id", "edge_id", "edge_group_id") {
+		return readTrunk(ctx, d, meta)
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	oldTrunkBaseSettingsId, newTrunkBaseSettingsId := d.GetChange("trunk_base_settings_id")
+	oldEdgeId, newEdgeId := d.GetChange("edge_id")
+	oldEdgeGroupId, newEdgeGroupId := d.GetChange("edge_group_id")
+
+	// Only the edge or edge group set in the configuration is kept in state, so it is the only one unassigned on delete
+	rawConfig := d.GetRawConfig()
+	if rawConfig.GetAttr("edge_id").IsNull() {
+		newEdgeId = ""
+		d.Set("edge_id", "")
+	}
+	if rawConfig.GetAttr("edge_group_id").IsNull() {
+		newEdgeGroupId = ""
+		d.Set("edge_group_id", "")
+	}
+
+	log.Printf("Moving trunk %s", d.Id())
+	if diagErr := unassignTrunkBaseSettings(edgesAPI, oldTrunkBaseSettingsId.(string), oldEdgeId.(string), oldEdgeGroupId.(string)); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := assignTrunkBaseSettings(edgesAPI, newTrunkBaseSettingsId.(string), newEdgeId.(string), newEdgeGroupId.(string)); diagErr != nil {
+		return diagErr
+	}
+
+	// A new trunk is created for the new assignment
+	trunk, err := getTrunkByTrunkBaseId(newTrunkBaseSettingsId.(string), newEdgeId.(string), newEdgeGroupId.(string), d.Id(), meta)
+	if err != nil {
+		return diag.Errorf("Failed to get trunk by trunk base id %s: %s", newTrunkBaseSettingsId, err)
+	}
+	d.SetId(*trunk.Id)
+
+	log.Printf("Updated trunk %s", *trunk.Id)
+
+	return readTrunk(ctx, d, meta)
 }
 
 func readTrunk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -170,27 +330,111 @@ func readTrunk(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			return resource.NonRetryableError(fmt.Errorf("Failed to read trunk %s: %s", d.Id(), getErr))
 		}
 
+		if trunk.State != nil && *trunk.State == "deleted" {
+			// The trunk base settings were unassigned outside of Terraform
+			d.SetId("")
+			return nil
+		}
+
 		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceTrunk())
 		d.Set("name", *trunk.Name)
 		if trunk.TrunkBase != nil {
 			d.Set("trunk_base_settings_id", *trunk.TrunkBase.Id)
 		}
-		if trunk.EdgeGroup != nil {
+		// Only the edge or edge group the trunk base settings were assigned to is kept. Both are read on import.
+		edgeId := d.Get("edge_id").(string)
+		edgeGroupId := d.Get("edge_group_id").(string)
+		if trunk.EdgeGroup != nil && (edgeGroupId != "" || edgeId == "") {
 			d.Set("edge_group_id", *trunk.EdgeGroup.Id)
 		}
-		if trunk.Edge != nil {
+		if trunk.Edge != nil && (edgeId != "" || edgeGroupId == "") {
 			d.Set("edge_id", *trunk.Edge.Id)
 		}
 
+		d.Set("state", trunk.State)
+		d.Set("trunk_type", trunk.TrunkType)
+		d.Set("in_service", trunk.InService)
+		d.Set("enabled", trunk.Enabled)
+		if trunk.ConnectedStatus != nil {
+			d.Set("connected", trunk.ConnectedStatus.Connected)
+			if trunk.ConnectedStatus.ConnectedStateTime != nil {
+				d.Set("connected_state_time", trunk.ConnectedStatus.ConnectedStateTime.Format(time.RFC3339))
+			} else {
+				d.Set("connected_state_time", nil)
+			}
+		} else {
+			d.Set("connected", nil)
+			d.Set("connected_state_time", nil)
+		}
+		d.Set("options_status", flattenTrunkOptionsStatus(trunk.OptionsStatus))
+
 		log.Printf("Read trunk %s %s", d.Id(), *trunk.Name)
 
 		return cc.CheckState()
 	})
 }
 
-func deleteTrunk(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	// Does not delete the trunk. This resource will just no longer manage trunks.
-	return nil
+func flattenTrunkOptionsStatus(optionsStatus *[]platformclientv2.Trunkmetricsoptions) []interface{} {
+	if optionsStatus == nil {
+		return nil
+	}
+
+	statuses := make([]interface{}, 0, len(*optionsStatus))
+	for _, option := range *optionsStatus {
+		status := make(map[string]interface{})
+		if option.ProxyAddress != nil {
+			status["proxy_address"] = *option.ProxyAddress
+		}
+		if option.OptionState != nil {
+			status["option_state"] = *option.OptionState
+		}
+		if option.OptionStateTime != nil {
+			status["option_state_time"] = option.OptionStateTime.Format(time.RFC3339)
+		}
+		if option.ErrorInfo != nil {
+			if option.ErrorInfo.Code != nil {
+				status["error_code"] = *option.ErrorInfo.Code
+			}
+			if option.ErrorInfo.Text != nil {
+				status["error_text"] = *option.ErrorInfo.Text
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func deleteTrunk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	trunkBaseSettingsId := d.Get("trunk_base_settings_id").(string)
+	edgeId := d.Get("edge_id").(string)
+	edgeGroupId := d.Get("edge_group_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	// Genesys Cloud deletes the trunk once the trunk base settings are no longer assigned
+	log.Printf("Deleting trunk %s", d.Id())
+	if diagErr := unassignTrunkBaseSettings(edgesAPI, trunkBaseSettingsId, edgeId, edgeGroupId); diagErr != nil {
+		return diagErr
+	}
+
+	return withRetries(ctx, 60*time.Second, func() *resource.RetryError {
+		trunk, resp, err := edgesAPI.GetTelephonyProvidersEdgesTrunk(d.Id())
+		if err != nil {
+			if isStatus404(resp) {
+				log.Printf("Deleted trunk %s", d.Id())
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error deleting trunk %s: %s", d.Id(), err))
+		}
+
+		if trunk.State != nil && *trunk.State == "deleted" {
+			log.Printf("Deleted trunk %s", d.Id())
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Trunk %s still exists", d.Id()))
+	})
 }
 
 func trunkExporter() *ResourceExporter {
@@ -199,9 +443,16 @@ func trunkExporter() *ResourceExporter {
 		RefAttrs: map[string]*RefAttrSettings{
 			"trunk_base_settings_id": {RefType: "genesyscloud_telephony_providers_edges_trunkbasesettings"},
 			"edge_group_id":          {RefType: "genesyscloud_telephony_providers_edges_edge_group"},
+			"edge_id":                {RefType: "genesyscloud_telephony_providers_edges_edge"},
 		},
-		UnResolvableAttributes: map[string]*schema.Schema{
-			"edge_id": resourceTrunk().Schema["edge_id"],
+		ExcludedAttributes: []string{
+			"state",
+			"trunk_type",
+			"in_service",
+			"enabled",
+			"connected",
+			"connected_state_time",
+			"options_status",
 		},
 	}
 }
@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
 )
 
 func TestAccResourceTrunk(t *testing.T) {
@@ -62,6 +64,12 @@ func TestAccResourceTrunk(t *testing.T) {
 					"genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsRes+".id",
 					"genesyscloud_telephony_providers_edges_edge_group."+edgeGroupRes1+".id",
 				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_trunk."+trunkRes, "edge_group_id", "genesyscloud_telephony_providers_edges_edge_group."+edgeGroupRes1, "id"),
+					resource.TestCheckResourceAttrSet("genesyscloud_telephony_providers_edges_trunk."+trunkRes, "name"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_trunk."+trunkRes, "state", "active"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_trunk."+trunkRes, "trunk_type", "PHONE"),
+				),
 			},
 			//Create a new edge group and assign the trunk base settings to a new edge group to update the trunk
 			{
@@ -80,11 +88,56 @@ func TestAccResourceTrunk(t *testing.T) {
 					"genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsRes+".id",
 					"genesyscloud_telephony_providers_edges_edge_group."+edgeGroupRes2+".id",
 				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_trunk."+trunkRes, "edge_group_id", "genesyscloud_telephony_providers_edges_edge_group."+edgeGroupRes2, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_trunk."+trunkRes, "edge_id", ""),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_trunk."+trunkRes, "state", "active"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_telephony_providers_edges_trunk." + trunkRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"connected",
+					"connected_state_time",
+					"options_status",
+					// Both the edge and edge group of the trunk are read on import
+					"edge_id",
+				},
 			},
 		},
+		CheckDestroy: testVerifyTrunksDestroyed,
 	})
 }
 
+func testVerifyTrunksDestroyed(state *terraform.State) error {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_telephony_providers_edges_trunk" {
+			continue
+		}
+
+		trunk, resp, err := edgesAPI.GetTelephonyProvidersEdgesTrunk(rs.Primary.ID)
+		if trunk != nil {
+			if trunk.State != nil && *trunk.State == "deleted" {
+				// Trunk deleted once the trunk base settings were unassigned
+				continue
+			}
+			return fmt.Errorf("Trunk (%s) still exists", rs.Primary.ID)
+		} else if isStatus404(resp) {
+			// Trunk not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	//Success. Trunk destroyed
+	return nil
+}
+
 func generateTrunk(
 	trunkRes,
 	trunkBaseSettingsId,
@@ -21,6 +21,16 @@ import (
 var (
 	contactTypeEmail = "EMAIL"
 
+	// Expansions required to read all attributes of a user
+	userExpands = []string{
+		"skills",
+		"languages",
+		"locations",
+		"profileSkills",
+		"certifications",
+		"employerInfo",
+	}
+
 	phoneNumberResource = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"number": {
@@ -130,25 +140,18 @@ func getAllUsers(ctx context.Context, sdkConfig *platformclientv2.Configuration)
 	go func() {
 		defer wg.Done()
 		// get all inactive users
-		for pageNum := 1; ; pageNum++ {
-			const pageSize = 100
-			users, _, getErr := usersAPI.GetUsers(pageSize, pageNum, nil, nil, "", nil, "", "inactive")
-			if getErr != nil {
-				select {
-				case <-ctx.Done():
-				case errorChan <- getErr:
-				}
-				cancel()
-				return
-			}
-
-			if users.Entities == nil || len(*users.Entities) == 0 {
-				break
+		users, getErr := getAllSdkUsers(usersAPI, "inactive")
+		if getErr != nil {
+			select {
+			case <-ctx.Done():
+			case errorChan <- getErr:
 			}
+			cancel()
+			return
+		}
 
-			for _, user := range *users.Entities {
-				resources[*user.Id] = &ResourceMeta{Name: *user.Email}
-			}
+		for _, user := range users {
+			resources[*user.Id] = &ResourceMeta{Name: *user.Email}
 		}
 	}()
 
@@ -156,25 +159,18 @@ func getAllUsers(ctx context.Context, sdkConfig *platformclientv2.Configuration)
 	go func() {
 		defer wg.Done()
 		// get all active users
-		for pageNum := 1; ; pageNum++ {
-			const pageSize = 100
-			users, _, getErr := usersAPI.GetUsers(pageSize, pageNum, nil, nil, "", nil, "", "active")
-			if getErr != nil {
-				select {
-				case <-ctx.Done():
-				case errorChan <- getErr:
-				}
-				cancel()
-				return
-			}
-
-			if users.Entities == nil || len(*users.Entities) == 0 {
-				break
+		users, getErr := getAllSdkUsers(usersAPI, "active")
+		if getErr != nil {
+			select {
+			case <-ctx.Done():
+			case errorChan <- getErr:
 			}
+			cancel()
+			return
+		}
 
-			for _, user := range *users.Entities {
-				resources[*user.Id] = &ResourceMeta{Name: *user.Email}
-			}
+		for _, user := range users {
+			resources[*user.Id] = &ResourceMeta{Name: *user.Email}
 		}
 	}()
 
@@ -192,6 +188,26 @@ func getAllUsers(ctx context.Context, sdkConfig *platformclientv2.Configuration)
 	}
 }
 
+// getAllSdkUsers pages through every user in the org with the given state (active | inactive | any)
+func getAllSdkUsers(usersAPI *platformclientv2.UsersApi, state string) ([]platformclientv2.User, error) {
+	var allUsers []platformclientv2.User
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		users, _, getErr := usersAPI.GetUsers(pageSize, pageNum, nil, nil, "", nil, "", state)
+		if getErr != nil {
+			return nil, getErr
+		}
+
+		if users.Entities == nil || len(*users.Entities) == 0 {
+			break
+		}
+
+		allUsers = append(allUsers, *users.Entities...)
+	}
+
+	return allUsers, nil
+}
+
 func userExporter() *ResourceExporter {
 	return &ResourceExporter{
 		GetResourcesFunc: getAllWithPooledClient(getAllUsers),
@@ -541,15 +557,7 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 
 	log.Printf("Reading user %s", d.Id())
 	return withRetriesForRead(ctx, d, func() *resource.RetryError {
-		currentUser, resp, getErr := usersAPI.GetUser(d.Id(), []string{
-			// Expands
-			"skills",
-			"languages",
-			"locations",
-			"profileSkills",
-			"certifications",
-			"employerInfo",
-		}, "", "")
+		currentUser, resp, getErr := usersAPI.GetUser(d.Id(), userExpands, "", "")
 
 		if getErr != nil {
 			if isStatus404(resp) {
@@ -559,52 +567,56 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		}
 
 		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceUser())
+		if diagErr := flattenUser(d, currentUser, usersAPI); diagErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
 
-		// Required attributes
-		d.Set("name", *currentUser.Name)
-		d.Set("email", *currentUser.Email)
-		d.Set("division_id", *currentUser.Division.Id)
-		d.Set("state", *currentUser.State)
+		log.Printf("Read user %s %s", d.Id(), *currentUser.Email)
+		return cc.CheckState()
+	})
+}
 
-		if currentUser.Department != nil {
-			d.Set("department", *currentUser.Department)
-		} else {
-			d.Set("department", nil)
-		}
+// flattenUser sets all user attributes in the resource data. Used by both the resource and data source.
+func flattenUser(d *schema.ResourceData, currentUser *platformclientv2.User, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+	// Required attributes
+	d.Set("name", *currentUser.Name)
+	d.Set("email", *currentUser.Email)
+	d.Set("division_id", *currentUser.Division.Id)
+	d.Set("state", *currentUser.State)
 
-		if currentUser.Title != nil {
-			d.Set("title", *currentUser.Title)
-		} else {
-			d.Set("title", nil)
-		}
+	if currentUser.Department != nil {
+		d.Set("department", *currentUser.Department)
+	} else {
+		d.Set("department", nil)
+	}
 
-		if currentUser.Manager != nil {
-			d.Set("manager", *(*currentUser.Manager).Id)
-		} else {
-			d.Set("manager", nil)
-		}
+	if currentUser.Title != nil {
+		d.Set("title", *currentUser.Title)
+	} else {
+		d.Set("title", nil)
+	}
 
-		if currentUser.AcdAutoAnswer != nil {
-			d.Set("acd_auto_answer", *currentUser.AcdAutoAnswer)
-		} else {
-			d.Set("acd_auto_answer", nil)
-		}
+	if currentUser.Manager != nil {
+		d.Set("manager", *(*currentUser.Manager).Id)
+	} else {
+		d.Set("manager", nil)
+	}
 
-		d.Set("addresses", flattenUserAddresses(d, currentUser.Addresses))
-		d.Set("routing_skills", flattenUserSkills(currentUser.Skills))
-		d.Set("routing_languages", flattenUserLanguages(currentUser.Languages))
-		d.Set("locations", flattenUserLocations(currentUser.Locations))
-		d.Set("profile_skills", flattenUserProfileSkills(currentUser.ProfileSkills))
-		d.Set("certifications", flattenUserCertifications(currentUser.Certifications))
-		d.Set("employer_info", flattenUserEmployerInfo(currentUser.EmployerInfo))
+	if currentUser.AcdAutoAnswer != nil {
+		d.Set("acd_auto_answer", *currentUser.AcdAutoAnswer)
+	} else {
+		d.Set("acd_auto_answer", nil)
+	}
 
-		if diagErr := readUserRoutingUtilization(d, usersAPI); diagErr != nil {
-			return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
-		}
+	d.Set("addresses", flattenUserAddresses(d, currentUser.Addresses))
+	d.Set("routing_skills", flattenUserSkills(currentUser.Skills))
+	d.Set("routing_languages", flattenUserLanguages(currentUser.Languages))
+	d.Set("locations", flattenUserLocations(currentUser.Locations))
+	d.Set("profile_skills", flattenUserProfileSkills(currentUser.ProfileSkills))
+	d.Set("certifications", flattenUserCertifications(currentUser.Certifications))
+	d.Set("employer_info", flattenUserEmployerInfo(currentUser.EmployerInfo))
 
-		log.Printf("Read user %s %s", d.Id(), *currentUser.Email)
-		return cc.CheckState()
-	})
+	return readUserRoutingUtilization(d, usersAPI)
 }
 
 func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
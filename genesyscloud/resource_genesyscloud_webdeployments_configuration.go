@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
@@ -339,11 +341,17 @@ func resourceWebDeploymentConfiguration() *schema.Resource {
 				DiffSuppressFunc: validateConfigurationStatusChange,
 			},
 			"version": {
-				Description: "The version of the configuration.",
+				Description: "The version of the configuration. This is the latest published version, even when changes are kept in the draft.",
 				Type:        schema.TypeString,
 				Computed:    true,
 				Optional:    true,
 			},
+			"publish": {
+				Description: "Publish changes to the configuration as a new version. When false, changes are saved to the draft only and deployments keep using the published versions.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 			"messenger": {
 				Description: "Settings concerning messenger",
 				Type:        schema.TypeList,
@@ -371,7 +379,7 @@ func resourceWebDeploymentConfiguration() *schema.Resource {
 }
 
 func customizeConfigurationDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	if len(diff.GetChangedKeysPrefix("")) > 0 {
+	if len(diff.GetChangedKeysPrefix("")) > 0 && diff.Get("publish").(bool) {
 		// When any change is made to the configuration we automatically publish a new version, so mark the version as updated
 		// so dependent deployments will update appropriately to reference the newest version
 		diff.SetNewComputed("version")
@@ -687,7 +695,19 @@ func createWebDeploymentConfiguration(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("Web deployment configuration %s did not become active and could not be published", name)
 	}
 
-	diagErr = withRetries(ctx, 30*time.Second, func() *resource.RetryError {
+	if d.Get("publish").(bool) {
+		diagErr = publishWebDeploymentConfigurationDraft(ctx, d, api, name)
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Created web deployment configuration %s %s", name, d.Id())
+	return readWebDeploymentConfiguration(ctx, d, meta)
+}
+
+func publishWebDeploymentConfigurationDraft(ctx context.Context, d *schema.ResourceData, api *platformclientv2.WebDeploymentsApi, name string) diag.Diagnostics {
+	return withRetries(ctx, 30*time.Second, func() *resource.RetryError {
 		configuration, resp, err := api.PostWebdeploymentsConfigurationVersionsDraftPublish(d.Id())
 		if err != nil {
 			if isStatus400(resp) {
@@ -697,21 +717,43 @@ func createWebDeploymentConfiguration(ctx context.Context, d *schema.ResourceDat
 		}
 		d.Set("version", configuration.Version)
 		d.Set("status", configuration.Status)
-		log.Printf("Created web deployment configuration %s %s", name, *configuration.Id)
-
+		log.Printf("Published version %s of web deployment configuration %s", *configuration.Version, name)
 		return nil
 	})
-	if diagErr != nil {
-		return diagErr
+}
+
+// getPublishedConfigurationVersions returns the published versions of a configuration, newest first
+func getPublishedConfigurationVersions(api *platformclientv2.WebDeploymentsApi, configurationId string) ([]platformclientv2.Webdeploymentconfigurationversion, *platformclientv2.APIResponse, error) {
+	versions, resp, getErr := api.GetWebdeploymentsConfigurationVersions(configurationId)
+	if getErr != nil {
+		return nil, resp, getErr
 	}
 
-	return readWebDeploymentConfiguration(ctx, d, meta)
+	var published []platformclientv2.Webdeploymentconfigurationversion
+	if versions.Entities != nil {
+		for _, v := range *versions.Entities {
+			if v.Version == nil || strings.EqualFold(*v.Version, "draft") {
+				continue
+			}
+			if _, err := strconv.Atoi(*v.Version); err != nil {
+				log.Printf("Failed to convert version %s to an integer", *v.Version)
+				continue
+			}
+			published = append(published, v)
+		}
+	}
+	sort.SliceStable(published, func(i, j int) bool {
+		versionI, _ := strconv.Atoi(*published[i].Version)
+		versionJ, _ := strconv.Atoi(*published[j].Version)
+		return versionI > versionJ
+	})
+	return published, resp, nil
 }
 
 func determineLatestVersion(ctx context.Context, api *platformclientv2.WebDeploymentsApi, configurationId string) string {
 	version := ""
 	_ = withRetries(ctx, 30*time.Second, func() *resource.RetryError {
-		versions, resp, getErr := api.GetWebdeploymentsConfigurationVersions(configurationId)
+		versions, resp, getErr := getPublishedConfigurationVersions(api, configurationId)
 		if getErr != nil {
 			if isStatus404(resp) {
 				return resource.RetryableError(fmt.Errorf("Failed to determine latest version %s", getErr))
@@ -721,27 +763,12 @@ func determineLatestVersion(ctx context.Context, api *platformclientv2.WebDeploy
 			return resource.NonRetryableError(fmt.Errorf("Failed to determine latest version %s", getErr))
 		}
 
-		maxVersion := 0
-		for _, v := range *versions.Entities {
-			if *v.Version == "DRAFT" {
-				continue
-			}
-			APIVersion, err := strconv.Atoi(*v.Version)
-			if err != nil {
-				log.Printf("Failed to convert version %s to an integer", *v.Version)
-			} else {
-				if APIVersion > maxVersion {
-					maxVersion = APIVersion
-				}
-			}
-		}
-
-		if maxVersion == 0 {
+		if len(versions) == 0 {
 			version = "draft"
+			return nil
 		}
 
-		version = strconv.Itoa(maxVersion)
-
+		version = *versions[0].Version
 		return nil
 	})
 
@@ -753,12 +780,21 @@ func readWebDeploymentConfiguration(ctx context.Context, d *schema.ResourceData,
 	api := platformclientv2.NewWebDeploymentsApiWithConfig(sdkConfig)
 
 	version := d.Get("version").(string)
+	publish := d.Get("publish").(bool)
+	if _, exists := d.GetOkExists("publish"); !exists {
+		// Imported configurations are read from their latest published version
+		publish = true
+	}
 	log.Printf("Reading web deployment configuration %s", d.Id())
 	return withRetriesForRead(ctx, d, func() *resource.RetryError {
-		if version == "" {
-			version = determineLatestVersion(ctx, api, d.Id())
+		readVersion := version
+		if !publish {
+			// Unpublished changes are kept in the draft
+			readVersion = "draft"
+		} else if readVersion == "" {
+			readVersion = determineLatestVersion(ctx, api, d.Id())
 		}
-		configuration, resp, getErr := api.GetWebdeploymentsConfigurationVersion(d.Id(), version)
+		configuration, resp, getErr := api.GetWebdeploymentsConfigurationVersion(d.Id(), readVersion)
 		if getErr != nil {
 			if isStatus404(resp) {
 				return resource.RetryableError(fmt.Errorf("Failed to read web deployment configuration %s: %s", d.Id(), getErr))
@@ -780,9 +816,16 @@ func readWebDeploymentConfiguration(ctx context.Context, d *schema.ResourceData,
 		if configuration.Status != nil {
 			d.Set("status", *configuration.Status)
 		}
-		if configuration.Version != nil {
+		if !publish {
+			if latestVersion := determineLatestVersion(ctx, api, d.Id()); latestVersion != "draft" {
+				d.Set("version", latestVersion)
+			} else {
+				d.Set("version", nil)
+			}
+		} else if configuration.Version != nil {
 			d.Set("version", *configuration.Version)
 		}
+		d.Set("publish", publish)
 		if configuration.Messenger != nil {
 			d.Set("messenger", flattenMessengerSettings(configuration.Messenger))
 		}
@@ -825,20 +868,11 @@ func updateWebDeploymentConfiguration(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("Web deployment configuration %s did not become active and could not be published", name)
 	}
 
-	diagErr = withRetries(ctx, 30*time.Second, func() *resource.RetryError {
-		configuration, resp, err := api.PostWebdeploymentsConfigurationVersionsDraftPublish(d.Id())
-		if err != nil {
-			if isStatus400(resp) {
-				return resource.RetryableError(fmt.Errorf("Error publishing web deployment configuration %s: %s", name, err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error publishing web deployment configuration %s: %s", name, err))
+	if d.Get("publish").(bool) {
+		diagErr = publishWebDeploymentConfigurationDraft(ctx, d, api, name)
+		if diagErr != nil {
+			return diagErr
 		}
-		d.Set("version", configuration.Version)
-		d.Set("status", configuration.Status)
-		return nil
-	})
-	if diagErr != nil {
-		return diagErr
 	}
 
 	log.Printf("Finished updating web deployment configuration %s", name)
@@ -5,10 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -40,6 +44,7 @@ func webDeploymentExporter() *ResourceExporter {
 			"flow_id":          {RefType: "genesyscloud_flow"},
 			"configuration.id": {RefType: "genesyscloud_webdeployments_configuration"},
 		},
+		ExcludedAttributes: []string{"snippet", "deployment_key", "environment", "pinned_configuration_version"},
 	}
 }
 
@@ -52,7 +57,7 @@ func resourceWebDeployment() *schema.Resource {
 		UpdateContext: updateWithPooledClient(updateWebDeployment),
 		DeleteContext: deleteWithPooledClient(deleteWebDeployment),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWebDeployment,
 		},
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -73,10 +78,11 @@ func resourceWebDeployment() *schema.Resource {
 				Default:     false,
 			},
 			"allowed_domains": {
-				Description: "The list of domains that are approved to use this deployment; the list will be added to CORS headers for ease of web use.",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description:      "The list of domains that are approved to use this deployment; the list will be added to CORS headers for ease of web use. Entries may start with a `*.` wildcard label and include a port. Any `http://` or `https://` scheme and trailing slash are removed.",
+				Type:             schema.TypeList,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressEquivalentAllowedDomains,
 			},
 			"flow_id": {
 				Description: "A reference to the inboundshortmessage flow used by this deployment.",
@@ -97,6 +103,26 @@ func resourceWebDeployment() *schema.Resource {
 				}, false),
 				DiffSuppressFunc: validateDeploymentStatusChange,
 			},
+			"snippet": {
+				Description: "The JavaScript snippet used to load the deployment on a web page.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"deployment_key": {
+				Description: "The deployment key used by the snippet to identify this deployment.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"environment": {
+				Description: "The Genesys Cloud environment used by the snippet, e.g. `prod` or `prod-euw1`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"pinned_configuration_version": {
+				Description: "The configuration version set in `configuration`. Empty when the deployment uses the latest published version.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"configuration": {
 				Description: "The published configuration version used by this deployment",
 				Type:        schema.TypeList,
@@ -105,10 +131,12 @@ func resourceWebDeployment() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Description: "The ID of the web deployment configuration.",
+							Type:        schema.TypeString,
+							Required:    true,
 						},
 						"version": {
+							Description:      "The published version of the configuration. Set a specific version to pin the deployment to it, or a previous version to roll the deployment back. When not set, the latest published version is used when the deployment is created or updated.",
 							Type:             schema.TypeString,
 							Optional:         true,
 							Computed:         true,
@@ -118,7 +146,111 @@ func resourceWebDeployment() *schema.Resource {
 				},
 			},
 		},
+		CustomizeDiff: customizeWebDeploymentDiff,
+	}
+}
+
+// customizeWebDeploymentDiff checks the allowed domains, plans an update when the configuration version is pinned or unpinned,
+// and checks that a pinned configuration version is published before the deployment is changed to use it
+func customizeWebDeploymentDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validAllowedDomainsSettings(diff); err != nil {
+		return err
+	}
+	// The version keeps its computed value when it is removed from the config, so pinning is tracked separately
+	pinnedVersion, known := configuredConfigurationVersion(diff.GetRawConfig())
+	if !known {
+		if err := diff.SetNewComputed("pinned_configuration_version"); err != nil {
+			return err
+		}
+	} else if pinnedVersion != diff.Get("pinned_configuration_version").(string) {
+		if err := diff.SetNew("pinned_configuration_version", pinnedVersion); err != nil {
+			return err
+		}
+	}
+	if diff.Id() != "" && !diff.HasChange("configuration") {
+		return nil
+	}
+	if !diff.NewValueKnown("configuration.0.id") || !known {
+		// The configuration may be published in the same apply
+		return nil
+	}
+
+	configId := diff.Get("configuration.0.id").(string)
+	configVersion := pinnedVersion
+	if configId == "" || configVersion == "" {
+		return nil
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	api := platformclientv2.NewWebDeploymentsApiWithConfig(sdkConfig)
+
+	versions, resp, err := getPublishedConfigurationVersions(api, configId)
+	if err != nil {
+		if isStatus404(resp) {
+			return nil
+		}
+		return fmt.Errorf("Failed to read versions of web deployment configuration %s: %s", configId, err)
+	}
+
+	publishedVersions := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if *v.Version == configVersion {
+			return nil
+		}
+		publishedVersions = append(publishedVersions, *v.Version)
+	}
+	if len(publishedVersions) == 0 {
+		return fmt.Errorf("Web deployment configuration %s has no published versions", configId)
+	}
+	return fmt.Errorf("Version %s is not a published version of web deployment configuration %s. Published versions: %s", configVersion, configId, strings.Join(publishedVersions, ", "))
+}
+
+// configuredConfigurationVersion returns the configuration version set in the config, or an empty string when it is not set.
+// The second return value is false when the version is not known yet.
+func configuredConfigurationVersion(rawConfig cty.Value) (string, bool) {
+	if rawConfig.IsNull() {
+		return "", true
+	}
+	configuration := rawConfig.GetAttr("configuration")
+	if !configuration.IsKnown() {
+		return "", false
+	}
+	if configuration.IsNull() || configuration.LengthInt() == 0 {
+		return "", true
+	}
+	version := configuration.Index(cty.NumberIntVal(0)).GetAttr("version")
+	if !version.IsKnown() {
+		return "", false
+	}
+	if version.IsNull() {
+		return "", true
+	}
+	return version.AsString(), true
+}
+
+// importWebDeployment imports a deployment pinned to its current configuration version, as in exported configurations
+func importWebDeployment(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	api := platformclientv2.NewWebDeploymentsApiWithConfig(sdkConfig)
+
+	deployment, _, err := api.GetWebdeploymentsDeployment(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read web deployment %s: %s", d.Id(), err)
 	}
+	if deployment.Configuration != nil && deployment.Configuration.Version != nil {
+		d.Set("pinned_configuration_version", *deployment.Configuration.Version)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// setDeployedConfiguration sets the configuration version sent to the API, so the read that follows does not
+// report the latest published version as inconsistent with the planned version
+func setDeployedConfiguration(d *schema.ResourceData, configId, configVersion, pinnedVersion string) {
+	d.Set("pinned_configuration_version", pinnedVersion)
+	d.Set("configuration", []interface{}{map[string]interface{}{
+		"id":      configId,
+		"version": configVersion,
+	}})
 }
 
 func alwaysDifferent(k, old, new string, d *schema.ResourceData) bool {
@@ -135,12 +267,7 @@ func createWebDeployment(ctx context.Context, d *schema.ResourceData, meta inter
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	allowAllDomains := d.Get("allow_all_domains").(bool)
-	allowedDomains := InterfaceListToStrings(d.Get("allowed_domains").([]interface{}))
-
-	err := validAllowedDomainsSettings(d)
-	if err != nil {
-		return diag.Errorf("Failed to create web deployment %s: %s", name, err)
-	}
+	allowedDomains := normalizeAllowedDomains(InterfaceListToStrings(d.Get("allowed_domains").([]interface{})))
 
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	api := platformclientv2.NewWebDeploymentsApiWithConfig(sdkConfig)
@@ -148,7 +275,11 @@ func createWebDeployment(ctx context.Context, d *schema.ResourceData, meta inter
 	log.Printf("Creating web deployment %s", name)
 
 	configId := d.Get("configuration.0.id").(string)
-	configVersion := d.Get("configuration.0.version").(string)
+	pinnedVersion, _ := configuredConfigurationVersion(d.GetRawConfig())
+	configVersion := pinnedVersion
+	if configVersion == "" {
+		configVersion = determineLatestVersion(ctx, api, configId)
+	}
 
 	flow := buildSdkDomainEntityRef(d, "flow_id")
 
@@ -194,6 +325,7 @@ func createWebDeployment(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("Web deployment %s did not become active and could not be created", name)
 	}
 
+	setDeployedConfiguration(d, configId, configVersion, pinnedVersion)
 	return readWebDeployment(ctx, d, meta)
 }
 
@@ -247,6 +379,15 @@ func readWebDeployment(ctx context.Context, d *schema.ResourceData, meta interfa
 		if deployment.Status != nil {
 			d.Set("status", *deployment.Status)
 		}
+		if deployment.Snippet != nil {
+			d.Set("snippet", *deployment.Snippet)
+			d.Set("deployment_key", getSnippetSetting(*deployment.Snippet, "deploymentId", d.Id()))
+			d.Set("environment", getSnippetSetting(*deployment.Snippet, "environment", ""))
+		} else {
+			d.Set("snippet", nil)
+			d.Set("deployment_key", d.Id())
+			d.Set("environment", nil)
+		}
 
 		log.Printf("Read web deployment %s %s", d.Id(), *deployment.Name)
 		return cc.CheckState()
@@ -264,21 +405,20 @@ func updateWebDeployment(ctx context.Context, d *schema.ResourceData, meta inter
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	allowAllDomains := d.Get("allow_all_domains").(bool)
-	allowedDomains := InterfaceListToStrings(d.Get("allowed_domains").([]interface{}))
+	allowedDomains := normalizeAllowedDomains(InterfaceListToStrings(d.Get("allowed_domains").([]interface{})))
 	status := d.Get("status").(string)
 
-	err := validAllowedDomainsSettings(d)
-	if err != nil {
-		return diag.Errorf("Failed to update web deployment %s: %s", name, err)
-	}
-
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	api := platformclientv2.NewWebDeploymentsApiWithConfig(sdkConfig)
 
 	log.Printf("Updating web deployment %s", name)
 
 	configId := d.Get("configuration.0.id").(string)
-	configVersion := d.Get("configuration.0.version").(string)
+	pinnedVersion, _ := configuredConfigurationVersion(d.GetRawConfig())
+	configVersion := pinnedVersion
+	if configVersion == "" {
+		configVersion = determineLatestVersion(ctx, api, configId)
+	}
 
 	flow := buildSdkDomainEntityRef(d, "flow_id")
 
@@ -322,6 +462,7 @@ func updateWebDeployment(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	log.Printf("Finished updating web deployment %s", name)
+	setDeployedConfiguration(d, configId, configVersion, pinnedVersion)
 	return readWebDeployment(ctx, d, meta)
 }
 
@@ -352,9 +493,21 @@ func deleteWebDeployment(ctx context.Context, d *schema.ResourceData, meta inter
 	})
 }
 
-func validAllowedDomainsSettings(d *schema.ResourceData) error {
+// getSnippetSetting returns the value of a setting passed to the Genesys bootstrap script in a deployment snippet
+func getSnippetSetting(snippet, setting, defaultValue string) string {
+	settingRegex := regexp.MustCompile(regexp.QuoteMeta(setting) + `['"]?\s*:\s*['"]([^'"]+)['"]`)
+	if match := settingRegex.FindStringSubmatch(snippet); match != nil {
+		return match[1]
+	}
+	return defaultValue
+}
+
+func validAllowedDomainsSettings(d *schema.ResourceDiff) error {
 	allowAllDomains := d.Get("allow_all_domains").(bool)
-	_, allowedDomainsSet := d.GetOk("allowed_domains")
+	if !d.NewValueKnown("allowed_domains") {
+		return nil
+	}
+	allowedDomains, allowedDomainsSet := d.GetOk("allowed_domains")
 
 	if allowAllDomains && allowedDomainsSet {
 		return errors.New("Allowed domains cannot be specified when all domains are allowed")
@@ -364,5 +517,97 @@ func validAllowedDomainsSettings(d *schema.ResourceData) error {
 		return errors.New("Either allowed domains must be specified or all domains must be allowed")
 	}
 
+	if allowedDomainsSet {
+		return validateAllowedDomains(InterfaceListToStrings(allowedDomains.([]interface{})))
+	}
+	return nil
+}
+
+var allowedDomainLabelRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// validateAllowedDomains returns an error describing every invalid or duplicate allowed domain
+func validateAllowedDomains(domains []string) error {
+	var problems []string
+	seen := make(map[string]string)
+	for i, domain := range domains {
+		if domain == "" {
+			// Unknown values are read as empty strings
+			continue
+		}
+		if err := validateAllowedDomain(domain); err != nil {
+			problems = append(problems, fmt.Sprintf("allowed_domains.%d: %s", i, err))
+			continue
+		}
+		normalized := normalizeAllowedDomain(domain)
+		if previous, ok := seen[normalized]; ok {
+			problems = append(problems, fmt.Sprintf("allowed_domains.%d: '%s' is a duplicate of '%s'", i, domain, previous))
+			continue
+		}
+		seen[normalized] = domain
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("Invalid allowed domains:\n%s", strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// validateAllowedDomain checks a single allowed domain. The scheme and trailing slash are ignored.
+func validateAllowedDomain(domain string) error {
+	normalized := normalizeAllowedDomain(domain)
+	if normalized == "" {
+		return fmt.Errorf("'%s' is empty", domain)
+	}
+	if strings.ContainsAny(normalized, "/?#") {
+		return fmt.Errorf("'%s' must be a domain without a path, query or fragment", domain)
+	}
+
+	host := normalized
+	if hostname, port, found := strings.Cut(normalized, ":"); found {
+		portNum, err := strconv.Atoi(port)
+		if err != nil || portNum < 1 || portNum > 65535 {
+			return fmt.Errorf("'%s' has an invalid port", domain)
+		}
+		host = hostname
+	}
+
+	labels := strings.Split(host, ".")
+	for i, label := range labels {
+		if label == "*" {
+			if i != 0 || len(labels) < 2 {
+				return fmt.Errorf("'%s' may only use a wildcard as the leftmost label of a domain, e.g. *.example.com", domain)
+			}
+			continue
+		}
+		if !allowedDomainLabelRegex.MatchString(label) {
+			if strings.Contains(label, "*") {
+				return fmt.Errorf("'%s' may only use a wildcard as the leftmost label of a domain, e.g. *.example.com", domain)
+			}
+			return fmt.Errorf("'%s' is not a valid domain", domain)
+		}
+	}
 	return nil
 }
+
+// normalizeAllowedDomain lowercases a domain and strips its scheme and trailing slash
+func normalizeAllowedDomain(domain string) string {
+	normalized := strings.ToLower(strings.TrimSpace(domain))
+	for _, scheme := range []string{"https://", "http://"} {
+		normalized = strings.TrimPrefix(normalized, scheme)
+	}
+	return strings.TrimSuffix(normalized, "/")
+}
+
+func normalizeAllowedDomains(domains []string) []string {
+	normalized := make([]string, len(domains))
+	for i, domain := range domains {
+		normalized[i] = normalizeAllowedDomain(domain)
+	}
+	return normalized
+}
+
+func suppressEquivalentAllowedDomains(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".#") {
+		return old == new
+	}
+	return normalizeAllowedDomain(old) == normalizeAllowedDomain(new)
+}
@@ -4,13 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceWebDeploymentsDeployment(t *testing.T) {
@@ -33,6 +36,9 @@ func TestAccResourceWebDeploymentsDeployment(t *testing.T) {
 					resource.TestCheckResourceAttr(fullResourceName, "allow_all_domains", "true"),
 					resource.TestCheckNoResourceAttr(fullResourceName, "allowed_domains"),
 					resource.TestMatchResourceAttr(fullResourceName, "status", regexp.MustCompile("^(Pending|Active)$")),
+					resource.TestCheckResourceAttrSet(fullResourceName, "snippet"),
+					resource.TestCheckResourceAttrPair(fullResourceName, "deployment_key", fullResourceName, "id"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "environment"),
 				),
 			},
 			{
@@ -78,6 +84,22 @@ func TestAccResourceWebDeploymentsDeployment_AllowedDomains(t *testing.T) {
 					resource.TestCheckResourceAttr(fullResourceName, "allowed_domains.1", secondDomain),
 				),
 			},
+			{
+				// The scheme and trailing slash are removed, so this should not cause a diff
+				Config:   deploymentResourceWithAllowedDomains(t, deploymentName, "https://"+firstDomain+"/", secondDomain),
+				PlanOnly: true,
+			},
+			{
+				Config: deploymentResourceWithAllowedDomains(t, deploymentName, "*."+firstDomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "allowed_domains.#", "1"),
+					resource.TestCheckResourceAttr(fullResourceName, "allowed_domains.0", "*."+firstDomain),
+				),
+			},
+			{
+				Config:      deploymentResourceWithAllowedDomains(t, deploymentName, "www.*.com"),
+				ExpectError: regexp.MustCompile("may only use a wildcard as the leftmost label"),
+			},
 			{
 				ResourceName:            fullResourceName,
 				ImportState:             true,
@@ -130,6 +152,175 @@ func TestAccResourceWebDeploymentsDeployment_Versioning(t *testing.T) {
 	})
 }
 
+func TestAccResourceWebDeploymentsDeployment_PinAndRollback(t *testing.T) {
+	t.Parallel()
+	var (
+		deploymentName             = "Test Deployment " + randString(8)
+		configName                 = "Minimal Config " + uuid.NewString()
+		fullDeploymentResourceName = "genesyscloud_webdeployments_deployment.pinned"
+		fullConfigResourceName     = "genesyscloud_webdeployments_configuration.pinned"
+		fullVersionsDataSourceName = "data.genesyscloud_webdeployments_configuration_versions.pinned"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: pinnedDeploymentResource(configName, "description 1", trueValue, deploymentName, "genesyscloud_webdeployments_configuration.pinned.version"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullConfigResourceName, "version", "1"),
+					resource.TestCheckResourceAttr(fullDeploymentResourceName, "configuration.0.version", "1"),
+				),
+			},
+			{
+				// Publish version 2 and keep the deployment pinned to version 1
+				Config: pinnedDeploymentResource(configName, "description 2", trueValue, deploymentName, strconv.Quote("1")) +
+					pinnedConfigurationVersionsDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullConfigResourceName, "version", "2"),
+					resource.TestCheckResourceAttr(fullDeploymentResourceName, "configuration.0.version", "1"),
+					resource.TestCheckResourceAttr(fullVersionsDataSourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(fullVersionsDataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(fullVersionsDataSourceName, "versions.0.version", "2"),
+					resource.TestCheckResourceAttr(fullVersionsDataSourceName, "versions.1.version", "1"),
+				),
+			},
+			{
+				// Keep changes in the draft and move the deployment to version 2
+				Config: pinnedDeploymentResource(configName, "draft description", falseValue, deploymentName, strconv.Quote("2")) +
+					pinnedConfigurationVersionsDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullConfigResourceName, "version", "2"),
+					resource.TestCheckResourceAttr(fullConfigResourceName, "description", "draft description"),
+					resource.TestCheckResourceAttr(fullDeploymentResourceName, "configuration.0.version", "2"),
+					resource.TestCheckResourceAttr(fullVersionsDataSourceName, "latest_version", "2"),
+				),
+			},
+			{
+				// Roll the deployment back to version 1
+				Config: pinnedDeploymentResource(configName, "draft description", falseValue, deploymentName, strconv.Quote("1")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullDeploymentResourceName, "configuration.0.version", "1"),
+					resource.TestCheckResourceAttr(fullDeploymentResourceName, "pinned_configuration_version", "1"),
+				),
+			},
+			{
+				// Unpin the deployment to move it to the latest published version
+				Config: pinnedDeploymentResource(configName, "draft description", falseValue, deploymentName, nullValue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullDeploymentResourceName, "configuration.0.version", "2"),
+					resource.TestCheckResourceAttr(fullDeploymentResourceName, "pinned_configuration_version", ""),
+				),
+			},
+			{
+				Config:      pinnedDeploymentResource(configName, "draft description", falseValue, deploymentName, strconv.Quote("99")),
+				ExpectError: regexp.MustCompile("Version 99 is not a published version"),
+			},
+		},
+		CheckDestroy: verifyDeploymentDestroyed,
+	})
+}
+
+func pinnedDeploymentResource(configName, configDescription, publish, deploymentName, version string) string {
+	return fmt.Sprintf(`
+	resource "genesyscloud_webdeployments_configuration" "pinned" {
+		name        = "%s"
+		description = "%s"
+		publish     = %s
+	}
+
+	resource "genesyscloud_webdeployments_deployment" "pinned" {
+		name              = "%s"
+		allow_all_domains = true
+		configuration {
+			id      = genesyscloud_webdeployments_configuration.pinned.id
+			version = %s
+		}
+	}
+	`, configName, configDescription, publish, deploymentName, version)
+}
+
+func pinnedConfigurationVersionsDataSource() string {
+	return `
+	data "genesyscloud_webdeployments_configuration_versions" "pinned" {
+		configuration_id = genesyscloud_webdeployments_configuration.pinned.id
+		depends_on       = [genesyscloud_webdeployments_configuration.pinned]
+	}
+	`
+}
+
+func TestValidateAllowedDomains(t *testing.T) {
+	valid := []string{
+		"example.com",
+		"*.example.com",
+		"https://www.example.com/",
+		"http://localhost:8080",
+		"EXAMPLE.com",
+		"10.0.0.1",
+	}
+	for _, domain := range valid {
+		assert.NoError(t, validateAllowedDomain(domain), domain)
+	}
+
+	invalid := []string{
+		"https://",
+		"example.com/path",
+		"example.com?query=1",
+		"example.com:99999",
+		"*",
+		"www.*.com",
+		"*example.com",
+		"exa_mple.com",
+		"-example.com",
+	}
+	for _, domain := range invalid {
+		assert.Error(t, validateAllowedDomain(domain), domain)
+	}
+
+	err := validateAllowedDomains([]string{"example.com", "https://Example.com/", "www.*.com"})
+	assert.EqualError(t, err, "Invalid allowed domains:\n"+
+		"allowed_domains.1: 'https://Example.com/' is a duplicate of 'example.com'\n"+
+		"allowed_domains.2: 'www.*.com' may only use a wildcard as the leftmost label of a domain, e.g. *.example.com")
+}
+
+func TestConfiguredConfigurationVersion(t *testing.T) {
+	rawConfig := func(version cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"configuration": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"id":      cty.StringVal("config-id"),
+				"version": version,
+			})}),
+		})
+	}
+
+	version, known := configuredConfigurationVersion(rawConfig(cty.StringVal("2")))
+	assert.Equal(t, "2", version)
+	assert.True(t, known)
+
+	version, known = configuredConfigurationVersion(rawConfig(cty.NullVal(cty.String)))
+	assert.Equal(t, "", version)
+	assert.True(t, known)
+
+	_, known = configuredConfigurationVersion(rawConfig(cty.UnknownVal(cty.String)))
+	assert.False(t, known)
+}
+
+func TestGetSnippetSetting(t *testing.T) {
+	snippet := `<script type="text/javascript" charset="utf-8">
+  (function (g, e, n, es, ys) {
+    g['_genesysJs'] = e;
+  })(window, 'Genesys', 'https://apps.mypurecloud.ie/genesys-bootstrap/genesys.min.js', {
+    environment: 'prod-euw1',
+    deploymentId: 'd1b8c6d2-1111-2222-3333-444455556666'
+  });
+</script>`
+
+	assert.Equal(t, "prod-euw1", getSnippetSetting(snippet, "environment", ""))
+	assert.Equal(t, "d1b8c6d2-1111-2222-3333-444455556666", getSnippetSetting(snippet, "deploymentId", "fallback"))
+	assert.Equal(t, "fallback", getSnippetSetting("", "deploymentId", "fallback"))
+}
+
 func basicDeploymentResource(name, description string) string {
 	return fmt.Sprintf(`
 	resource "genesyscloud_webdeployments_configuration" "minimal" {
@@ -13,10 +13,11 @@ import (
 )
 
 const (
-	defaultTfJSONFile  = "genesyscloud.tf.json"
-	defaultTfHCLFile   = "genesyscloud.tf"
-	defaultTfVarsFile  = "terraform.tfvars"
-	defaultTfStateFile = "terraform.tfstate"
+	defaultTfJSONFile               = "genesyscloud.tf.json"
+	defaultTfHCLFile                = "genesyscloud.tf"
+	defaultTfVarsFile               = "terraform.tfvars"
+	defaultTfStateFile              = "terraform.tfstate"
+	defaultKnowledgeV1MigrationFile = "knowledge_v1_migration.md"
 )
 
 // Common Exporter interface to abstract away whether we are using HCL or JSON as our exporter
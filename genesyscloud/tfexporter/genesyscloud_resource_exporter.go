@@ -51,6 +51,8 @@ type GenesysCloudResourceExporter struct {
 	exportAsHCL           bool
 	logPermissionErrors   bool
 	includeStateFile      bool
+	sensitiveAsVariables  bool
+	migrateKnowledgeV1    bool
 	version               string
 	provider              *schema.Provider
 	exportFilePath        string
@@ -67,14 +69,16 @@ type GenesysCloudResourceExporter struct {
 
 func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData, meta interface{}) (*GenesysCloudResourceExporter, diag.Diagnostics) {
 	gre := &GenesysCloudResourceExporter{
-		exportAsHCL:         d.Get("export_as_hcl").(bool),
-		logPermissionErrors: d.Get("log_permission_errors").(bool),
-		includeStateFile:    d.Get("include_state_file").(bool),
-		version:             meta.(*gcloud.ProviderMeta).Version,
-		provider:            gcloud.New(meta.(*gcloud.ProviderMeta).Version)(),
-		d:                   d,
-		ctx:                 ctx,
-		meta:                meta,
+		exportAsHCL:          d.Get("export_as_hcl").(bool),
+		logPermissionErrors:  d.Get("log_permission_errors").(bool),
+		includeStateFile:     d.Get("include_state_file").(bool),
+		sensitiveAsVariables: d.Get("sensitive_attributes_as_variables").(bool),
+		migrateKnowledgeV1:   d.Get("migrate_knowledge_v1").(bool),
+		version:              meta.(*gcloud.ProviderMeta).Version,
+		provider:             gcloud.New(meta.(*gcloud.ProviderMeta).Version)(),
+		d:                    d,
+		ctx:                  ctx,
+		meta:                 meta,
 	}
 
 	err := gre.setUpExportFilePaths()
@@ -114,7 +118,15 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 		return diagErr
 	}
 
-	// Step #6 Write the terraform state file along with either the HCL or JSON
+	// Step #6 Add the v2 knowledge resources generated from v1 knowledge objects
+	if g.migrateKnowledgeV1 {
+		diagErr = g.buildKnowledgeV1MigrationConfig()
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	// Step #7 Write the terraform state file along with either the HCL or JSON
 	diagErr = g.generateOutputFiles()
 	if diagErr != nil {
 		return diagErr
@@ -268,6 +280,12 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 
 		exporters := *g.exporters
 		exporter := *exporters[resource.Type]
+
+		if g.sensitiveAsVariables {
+			resourceSchema := g.provider.ResourcesMap[resource.Type].Schema
+			sensitive := replaceSensitiveValues(resource.Type, resource.Name, resourceSchema, jsonResult, "", exporter.UnResolvableAttributes)
+			g.unresolvedAttrs = append(g.unresolvedAttrs, sensitive...)
+		}
 		if resourceFilesWriterFunc := exporter.CustomFileWriter.RetrieveAndWriteFilesFunc; resourceFilesWriterFunc != nil {
 			exportDir, _ := getFilePath(g.d, "")
 			err := resourceFilesWriterFunc(resource.State.ID, exportDir, exporter.CustomFileWriter.SubDirectory, jsonResult, g.meta)
@@ -709,6 +727,62 @@ func sanitizeConfigMap(
 	return unresolvableAttrs, true
 }
 
+// replaceSensitiveValues replaces the values of sensitive attributes with references to variables, so that secrets are not
+// written to the exported config. Empty values and attributes that are already exported as variables are left as they are.
+func replaceSensitiveValues(
+	resourceType string,
+	resourceName string,
+	schemaMap map[string]*schema.Schema,
+	configMap map[string]interface{},
+	prevAttr string,
+	unResolvableAttrs map[string]*schema.Schema) []unresolvableAttributeInfo {
+	sensitiveAttrs := make([]unresolvableAttributeInfo, 0)
+
+	for key, attrSchema := range schemaMap {
+		val := configMap[key]
+		if val == nil {
+			continue
+		}
+		currAttr := key
+		if prevAttr != "" {
+			currAttr = prevAttr + "." + key
+		}
+		if _, ok := attrInUnResolvableAttrs(currAttr, unResolvableAttrs); ok {
+			continue
+		}
+
+		if attrSchema.Sensitive {
+			if strVal, ok := val.(string); ok && strVal == "" {
+				continue
+			}
+			// Nested attributes are named by their path, e.g. config_advanced
+			attrName := strings.ReplaceAll(currAttr, ".", "_")
+			sensitiveAttrs = append(sensitiveAttrs, unresolvableAttributeInfo{
+				ResourceType: resourceType,
+				ResourceName: resourceName,
+				Name:         attrName,
+				Schema:       attrSchema,
+			})
+			configMap[key] = fmt.Sprintf("${var.%s_%s_%s}", resourceType, resourceName, attrName)
+			continue
+		}
+
+		elem, ok := attrSchema.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+		if items, ok := val.([]interface{}); ok {
+			for _, item := range items {
+				if itemMap, ok := item.(map[string]interface{}); ok {
+					sensitiveAttrs = append(sensitiveAttrs, replaceSensitiveValues(resourceType, resourceName, elem.Schema, itemMap, currAttr, unResolvableAttrs)...)
+				}
+			}
+		}
+	}
+
+	return sensitiveAttrs
+}
+
 func attrInUnResolvableAttrs(a string, myMap map[string]*schema.Schema) (*schema.Schema, bool) {
 	for k, v := range myMap {
 		if k == a {
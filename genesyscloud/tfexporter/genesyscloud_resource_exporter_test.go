@@ -3,6 +3,8 @@ package tfexporter
 import (
 	"fmt"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type PostProcessHclBytesTestCase struct {
@@ -88,3 +90,50 @@ func TestPostProcessHclBytesFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestReplaceSensitiveValues(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name":     {Type: schema.TypeString},
+		"password": {Type: schema.TypeString, Sensitive: true},
+		"fields":   {Type: schema.TypeMap, Sensitive: true},
+		"config": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"properties": {Type: schema.TypeString},
+					"advanced":   {Type: schema.TypeString, Sensitive: true},
+				},
+			},
+		},
+	}
+	configMap := map[string]interface{}{
+		"name":     "foo",
+		"password": "",
+		"fields":   "${var.foo_bar_fields}",
+		"config": []interface{}{
+			map[string]interface{}{
+				"properties": "{}",
+				"advanced":   "{\"token\":\"secret\"}",
+			},
+		},
+	}
+	unResolvableAttrs := map[string]*schema.Schema{
+		"fields": resourceSchema["fields"],
+	}
+
+	sensitiveAttrs := replaceSensitiveValues("foo", "bar", resourceSchema, configMap, "", unResolvableAttrs)
+
+	if len(sensitiveAttrs) != 1 || sensitiveAttrs[0].Name != "config_advanced" {
+		t.Fatalf("Expected only config_advanced to be replaced, got %v", sensitiveAttrs)
+	}
+	config := configMap["config"].([]interface{})[0].(map[string]interface{})
+	if config["advanced"] != "${var.foo_bar_config_advanced}" {
+		t.Errorf("Expected advanced to reference a variable, got %v", config["advanced"])
+	}
+	if config["properties"] != "{}" || configMap["name"] != "foo" || configMap["password"] != "" {
+		t.Errorf("Expected attributes that are not sensitive or are empty to be unchanged, got %v", configMap)
+	}
+	if configMap["fields"] != "${var.foo_bar_fields}" {
+		t.Errorf("Expected unresolvable attribute to be unchanged, got %v", configMap["fields"])
+	}
+}
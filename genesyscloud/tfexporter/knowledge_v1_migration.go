@@ -0,0 +1,120 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+   This file contains the logic to add the v2 knowledge resources generated from deprecated v1 knowledge objects to an export,
+   along with a guide describing how to move off the v1 resources.
+*/
+
+const knowledgeV1MigrationSubDirectory = "knowledge_documents"
+
+// buildKnowledgeV1MigrationConfig adds the v2 knowledge resources for the v1 knowledge objects of the org to the exported config
+func (g *GenesysCloudResourceExporter) buildKnowledgeV1MigrationConfig() diag.Diagnostics {
+	log.Printf("Building v2 knowledge config for v1 knowledge objects")
+	exportDir, diagErr := getFilePath(g.d, "")
+	if diagErr != nil {
+		return diagErr
+	}
+
+	nameInUse := func(resourceType, name string) bool {
+		_, ok := g.resourceTypeMaps[resourceType][name]
+		return ok
+	}
+	migration, diagErr := gcloud.GetKnowledgeV1Migration(g.meta, exportDir, knowledgeV1MigrationSubDirectory, nameInUse)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	knowledgeBaseRef := &gcloud.RefAttrSettings{RefType: "genesyscloud_knowledge_knowledgebase"}
+	for _, r := range migration.Resources {
+		// Reference the knowledge base if it is exported. Otherwise keep its ID as the knowledge base already exists.
+		if knowledgeBaseId, ok := r.Config["knowledge_base_id"].(string); ok {
+			r.Config["knowledge_base_id"] = resolveReference(knowledgeBaseRef, knowledgeBaseId, *g.exporters, true)
+		}
+
+		if g.resourceTypeMaps[r.Type] == nil {
+			g.resourceTypeMaps[r.Type] = make(map[string]gcloud.JsonMap)
+		}
+		g.resourceTypeMaps[r.Type][r.Name] = r.Config
+
+		if g.exportAsHCL {
+			g.resourceTypeHCLBlocks = append(g.resourceTypeHCLBlocks, instanceStateToHCLBlock(r.Type, r.Name, r.Config))
+		}
+	}
+	log.Printf("Generated %d v2 knowledge resources for v1 knowledge objects", len(migration.Resources))
+
+	guidePath, diagErr := getFilePath(g.d, defaultKnowledgeV1MigrationFile)
+	if diagErr != nil {
+		return diagErr
+	}
+	return writeToFile([]byte(buildKnowledgeV1MigrationGuide(migration, filepath.Base(g.exportFilePath))), guidePath)
+}
+
+// buildKnowledgeV1MigrationGuide describes how to replace the v1 knowledge resources with the generated v2 resources.
+// Terraform cannot move state between resource types, so the guide uses removed blocks or state commands instead of moved blocks.
+func buildKnowledgeV1MigrationGuide(migration *gcloud.KnowledgeV1Migration, configFileName string) string {
+	var (
+		guide         strings.Builder
+		mapping       strings.Builder
+		removedBlocks strings.Builder
+		stateCommands strings.Builder
+	)
+
+	for _, r := range migration.Resources {
+		if r.V1Type == "" {
+			continue
+		}
+		v1Address := fmt.Sprintf("%s.%s", r.V1Type, r.V1Name)
+		fmt.Fprintf(&mapping, "| `%s` | `%s` | `%s.%s` |\n", v1Address, r.V1Id, r.Type, r.Name)
+		fmt.Fprintf(&removedBlocks, "removed {\n  from = %s\n\n  lifecycle {\n    destroy = false\n  }\n}\n\n", v1Address)
+		fmt.Fprintf(&stateCommands, "terraform state rm '%s'\n", v1Address)
+	}
+
+	guide.WriteString("# Knowledge v1 to v2 migration\n\n")
+	fmt.Fprintf(&guide, "The v2 knowledge resources in `%s` were generated from the v1 knowledge categories and documents of the org. "+
+		"Document content was written to the `%s` directory.\n\n", configFileName, knowledgeV1MigrationSubDirectory)
+	guide.WriteString("Terraform `moved` blocks cannot move state between resource types, so the v2 resources are created as new objects " +
+		"and the v1 resources are removed from the state instead of being moved:\n\n")
+	guide.WriteString("1. Apply the exported config to create the v2 categories, labels, documents and document variations.\n")
+	guide.WriteString("2. Once the v2 documents are verified, delete the v1 resources from your config and add the `removed` blocks below " +
+		"(Terraform 1.7 or later) so the v1 objects are not destroyed. On older versions of Terraform, run the `terraform state rm` commands instead.\n")
+	guide.WriteString("3. Delete the v1 documents and categories when they are no longer used.\n\n")
+	guide.WriteString("The v1 resource names are the names the exporter gives the v1 objects. Adjust them to match your config.\n\n")
+
+	if mapping.Len() == 0 {
+		guide.WriteString("No v1 knowledge categories or documents were found.\n")
+		return guide.String()
+	}
+
+	guide.WriteString("## Resource mapping\n\n")
+	guide.WriteString("| v1 resource | v1 ID | v2 resource |\n")
+	guide.WriteString("|---|---|---|\n")
+	guide.WriteString(mapping.String())
+
+	guide.WriteString("\n## Removed blocks\n\n```hcl\n")
+	guide.WriteString(strings.TrimSuffix(removedBlocks.String(), "\n"))
+	guide.WriteString("```\n")
+
+	guide.WriteString("\n## State commands\n\n```sh\n")
+	guide.WriteString(stateCommands.String())
+	guide.WriteString("```\n")
+
+	if len(migration.Notes) > 0 {
+		guide.WriteString("\n## Notes\n\n")
+		for _, note := range migration.Notes {
+			fmt.Fprintf(&guide, "- %s\n", note)
+		}
+	}
+
+	return guide.String()
+}
@@ -0,0 +1,49 @@
+package tfexporter
+
+import (
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildKnowledgeV1MigrationGuide(t *testing.T) {
+	migration := &gcloud.KnowledgeV1Migration{
+		Resources: []gcloud.KnowledgeV1MigrationResource{
+			{
+				Type:   "genesyscloud_knowledge_category",
+				Name:   "Accounts",
+				V1Type: "genesyscloud_knowledge_v1_category",
+				V1Name: "Accounts",
+				V1Id:   "category-id kb-id en-US",
+			},
+			{
+				Type: "genesyscloud_knowledge_label",
+				Name: "Billing",
+			},
+			{
+				Type:   "genesyscloud_knowledge_document",
+				Name:   "Reset_password",
+				V1Type: "genesyscloud_knowledge_v1_document",
+				V1Name: "Reset_password",
+				V1Id:   "document-id kb-id en-US",
+			},
+		},
+		Notes: []string{"The external URL was not migrated."},
+	}
+
+	guide := buildKnowledgeV1MigrationGuide(migration, defaultTfJSONFile)
+
+	assert.Contains(t, guide, "The v2 knowledge resources in `genesyscloud.tf.json` were generated")
+	assert.Contains(t, guide, "| `genesyscloud_knowledge_v1_category.Accounts` | `category-id kb-id en-US` | `genesyscloud_knowledge_category.Accounts` |\n")
+	assert.Contains(t, guide, "| `genesyscloud_knowledge_v1_document.Reset_password` | `document-id kb-id en-US` | `genesyscloud_knowledge_document.Reset_password` |\n")
+	assert.NotContains(t, guide, "genesyscloud_knowledge_label")
+	assert.Contains(t, guide, "removed {\n  from = genesyscloud_knowledge_v1_document.Reset_password\n\n  lifecycle {\n    destroy = false\n  }\n}\n```")
+	assert.Contains(t, guide, "terraform state rm 'genesyscloud_knowledge_v1_category.Accounts'\n")
+	assert.Contains(t, guide, "## Notes\n\n- The external URL was not migrated.\n")
+
+	emptyGuide := buildKnowledgeV1MigrationGuide(&gcloud.KnowledgeV1Migration{}, defaultTfHCLFile)
+	assert.Contains(t, emptyGuide, "No v1 knowledge categories or documents were found.")
+	assert.NotContains(t, emptyGuide, "## Resource mapping")
+}
@@ -74,6 +74,20 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"sensitive_attributes_as_variables": {
+				Description: "Replace the values of sensitive attributes, such as integration credential fields and integration advanced config, with Terraform variables. The variables are left empty in the 'terraform.tfvars' file so secrets are not written to the config. Sensitive values are still written to the state file when `include_state_file` is true.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"migrate_knowledge_v1": {
+				Description: fmt.Sprintf("Export the documents and categories of the deprecated v1 knowledge APIs as v2 knowledge resources (categories, labels, documents and document variations). Document content is written to the 'knowledge_documents' directory and a '%s' file describes how to move off the v1 resources.", defaultKnowledgeV1MigrationFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"exclude_attributes": {
 				Description: "Attributes to exclude from the config when exporting resources. Each value should be of the form {resource_name}.{attribute}, e.g. 'genesyscloud_user.skills'. Excluded attributes must be optional.",
 				Type:        schema.TypeList,
@@ -128,6 +142,12 @@ func deleteTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) di
 		os.Remove(stateFile)
 	}
 
+	migrationFile, _ := getFilePath(d, defaultKnowledgeV1MigrationFile)
+	if _, err := os.Stat(migrationFile); err == nil {
+		log.Printf("Deleting knowledge v1 migration guide %s", migrationFile)
+		os.Remove(migrationFile)
+	}
+
 	tfVarsFile, _ := getFilePath(d, defaultTfVarsFile)
 	if _, err := os.Stat(tfVarsFile); err == nil {
 		log.Printf("Deleting export vars %s", tfVarsFile)
@@ -740,6 +740,176 @@ func TestAccResourceTfExportUserPromptExportAudioFile(t *testing.T) {
 	})
 }
 
+func TestAccResourceTfExportFlowExportYamlFile(t *testing.T) {
+	var (
+		flowResourceId = "test_flow"
+		flowName       = "Terraform Export Flow " + uuid.NewString()
+		flowConfig     = fmt.Sprintf("inboundCall:\n  name: %s\n  defaultLanguage: en-us\n  startUpRef: ./menus/menu[mainMenu]\n  initialGreeting:\n    tts: Archy says hi!!!\n  menus:\n    - menu:\n        name: Main Menu\n        audio:\n          tts: You are at the Main Menu, press 9 to disconnect.\n        refId: mainMenu\n        choices:\n          - menuDisconnect:\n              name: Disconnect\n              dtmf: digit_9", flowName)
+
+		exportResourceId = "export"
+		exportTestDir    = "../.terraform" + uuid.NewString()
+		flowFileDir      = "../.terraform" + uuid.NewString()
+		flowFilePath     = path.Join(flowFileDir, "flow.yaml")
+	)
+
+	if err := os.MkdirAll(flowFileDir, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(flowFilePath, []byte(flowConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(flowFileDir)
+	defer os.RemoveAll(exportTestDir)
+
+	flowResource := fmt.Sprintf(`resource "genesyscloud_flow" "%s" {
+		filepath = %s
+		file_content_hash = filesha256(%s)
+	}
+	`, flowResourceId, strconv.Quote(flowFilePath), strconv.Quote(flowFilePath))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: flowResource,
+			},
+			{
+				Config: generateTfExportByName(
+					exportResourceId,
+					exportTestDir,
+					falseValue,
+					[]string{strconv.Quote("genesyscloud_flow::" + flowName)},
+					"",
+					falseValue,
+					falseValue,
+				) + flowResource,
+				Check: resource.ComposeTestCheckFunc(
+					testFlowYamlFileExport(exportTestDir+"/"+defaultTfJSONFile, "genesyscloud_flow", exportTestDir),
+				),
+			},
+		},
+		CheckDestroy: testVerifyExportsDestroyedFunc(exportTestDir),
+	})
+}
+
+func TestAccResourceTfExportKnowledgeV1Migration(t *testing.T) {
+	t.Skip("Skipping v1 knowledge tests since the test org is using v2")
+	var (
+		knowledgeBaseResource = "test_knowledgebase"
+		knowledgeBaseName     = "Terraform Knowledge Base " + uuid.NewString()
+		categoryName          = "Terraform Knowledge Category " + uuid.NewString()
+		question              = "How do I reset my password?"
+		exportResourceId      = "export"
+		exportTestDir         = "../.terraform" + uuid.NewString()
+	)
+	defer os.RemoveAll(exportTestDir)
+
+	knowledgeConfig := fmt.Sprintf(`
+	resource "genesyscloud_knowledge_knowledgebase" "%s" {
+		name          = "%s"
+		description   = "Knowledge base migrated from v1"
+		core_language = "en-US"
+	}
+
+	resource "genesyscloud_knowledge_v1_category" "test_category" {
+		knowledge_base_id = genesyscloud_knowledge_knowledgebase.%s.id
+		language_code     = "en-US"
+		knowledge_category {
+			name = "%s"
+		}
+	}
+
+	resource "genesyscloud_knowledge_v1_document" "test_document" {
+		knowledge_base_id = genesyscloud_knowledge_knowledgebase.%s.id
+		language_code     = "en-US"
+		knowledge_document {
+			type       = "Faq"
+			categories = [genesyscloud_knowledge_v1_category.test_category.knowledge_category.0.name]
+			faq {
+				question     = "%s"
+				answer       = "Open the settings page and click reset."
+				alternatives = ["Forgot password"]
+			}
+		}
+	}
+	`, knowledgeBaseResource, knowledgeBaseName, knowledgeBaseResource, categoryName, knowledgeBaseResource, question)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: knowledgeConfig,
+			},
+			{
+				Config: knowledgeConfig + fmt.Sprintf(`
+				resource "genesyscloud_tf_export" "%s" {
+					directory            = "%s"
+					resource_types       = ["genesyscloud_knowledge_knowledgebase::%s"]
+					migrate_knowledge_v1 = true
+				}
+				`, exportResourceId, exportTestDir, knowledgeBaseName),
+				Check: resource.ComposeTestCheckFunc(
+					validateFileCreated(filepath.Join(exportTestDir, defaultKnowledgeV1MigrationFile)),
+					testKnowledgeV1MigrationExport(filepath.Join(exportTestDir, defaultTfJSONFile), exportTestDir, question),
+				),
+			},
+		},
+		CheckDestroy: testVerifyExportsDestroyedFunc(exportTestDir),
+	})
+}
+
+func testKnowledgeV1MigrationExport(filePath, exportDir, title string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		documents, err := getResourceDefinition(filePath, "genesyscloud_knowledge_document")
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for resourceName, r := range documents {
+			var document map[string]interface{}
+			if err := json.Unmarshal(*r, &document); err != nil {
+				return err
+			}
+			documentBody := document["knowledge_document"].([]interface{})[0].(map[string]interface{})
+			if documentBody["title"] != title {
+				continue
+			}
+			found = true
+
+			// The document must reference the exported knowledge base and the migrated category
+			if knowledgeBaseId, _ := document["knowledge_base_id"].(string); !strings.HasPrefix(knowledgeBaseId, "${genesyscloud_knowledge_knowledgebase.") {
+				return fmt.Errorf("expected knowledge_base_id of document %s to reference the knowledge base, got %s", resourceName, knowledgeBaseId)
+			}
+			if categoryName, _ := documentBody["category_name"].(string); !strings.HasPrefix(categoryName, "${genesyscloud_knowledge_category.") {
+				return fmt.Errorf("expected category_name of document %s to reference the migrated category, got %s", resourceName, categoryName)
+			}
+		}
+		if !found {
+			return fmt.Errorf("no migrated document with title %s found", title)
+		}
+
+		variations, err := getResourceDefinition(filePath, "genesyscloud_knowledge_document_variation")
+		if err != nil {
+			return err
+		}
+		for resourceName, r := range variations {
+			var variation map[string]interface{}
+			if err := json.Unmarshal(*r, &variation); err != nil {
+				return err
+			}
+			bodyFile, _ := variation["knowledge_document_variation"].([]interface{})[0].(map[string]interface{})["body_filepath"].(string)
+			if _, err := os.Stat(path.Join(exportDir, bodyFile)); err != nil {
+				return fmt.Errorf("body file of variation %s not found: %v", resourceName, err)
+			}
+		}
+
+		return nil
+	}
+}
+
 func removeTfConfigBlock(export string) string {
 	return strings.Replace(export, terraformHCLBlock, "", -1)
 }
@@ -786,6 +956,36 @@ func testUserPromptAudioFileExport(filePath, resourceType, resourceId, exportDir
 	}
 }
 
+func testFlowYamlFileExport(filePath, resourceType, exportDir string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		raw, err := getResourceDefinition(filePath, resourceType)
+		if err != nil {
+			return err
+		}
+
+		for resourceName, r := range raw {
+			var flow map[string]interface{}
+			if err := json.Unmarshal(*r, &flow); err != nil {
+				return err
+			}
+
+			// The exported filepath must point to the flow YAML written to the flows sub directory
+			flowFile, _ := flow["filepath"].(string)
+			if !strings.HasPrefix(flowFile, "flows/") {
+				return fmt.Errorf("expected filepath of flow %s to be in the flows directory, got %s", resourceName, flowFile)
+			}
+			if _, err := os.Stat(path.Join(exportDir, flowFile)); err != nil {
+				return err
+			}
+			if flow["file_content_hash"] != fmt.Sprintf("${filesha256(\"%s\")}", flowFile) {
+				return fmt.Errorf("unexpected file_content_hash for flow %s: %v", resourceName, flow["file_content_hash"])
+			}
+		}
+
+		return nil
+	}
+}
+
 func testUserExport(filePath, resourceType, resourceName string, expectedUser *UserExport) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		raw, err := getResourceDefinition(filePath, resourceType)
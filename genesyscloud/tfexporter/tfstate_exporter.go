@@ -138,7 +138,7 @@ func generateTfVarsContent(vars map[string]interface{}) string {
 
 func writeTfVars(tfVars map[string]interface{}, path string) diag.Diagnostics {
 	tfVarsStr := generateTfVarsContent(tfVars)
-	tfVarsStr = fmt.Sprintf("// This file has been autogenerated. The following properties could not be retrieved from the API or would not make sense in a different org e.g. Edge IDs"+
+	tfVarsStr = fmt.Sprintf("// This file has been autogenerated. The following properties could not be retrieved from the API, would not make sense in a different org e.g. Edge IDs, or are sensitive"+
 		"\n// The variables contained in this file have been given default values and should be edited as necessary\n\n%s", tfVarsStr)
 
 	log.Printf("Writing export tfvars file to %s", path)
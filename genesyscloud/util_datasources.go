@@ -0,0 +1,94 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceSchemaFromResource builds a data source schema exposing every attribute of a resource as computed.
+// The lookupSchema attributes are used to select the object and replace any resource attributes of the same name.
+// Attributes listed in excludedAttrs (e.g. write-only values such as passwords) are left out of the data source.
+func dataSourceSchemaFromResource(resource *schema.Resource, lookupSchema map[string]*schema.Schema, excludedAttrs ...string) map[string]*schema.Schema {
+	dsSchema := make(map[string]*schema.Schema, len(resource.Schema))
+	for attr, attrSchema := range resource.Schema {
+		if StringInSlice(attr, excludedAttrs) {
+			continue
+		}
+		dsSchema[attr] = computedSchema(attrSchema)
+	}
+	for attr, attrSchema := range lookupSchema {
+		dsSchema[attr] = attrSchema
+	}
+	return dsSchema
+}
+
+// computedSchema returns a copy of a resource attribute schema with all config-only behavior
+// (defaults, validation, diff suppression, etc.) removed so that it can be used as a computed-only attribute
+func computedSchema(attrSchema *schema.Schema) *schema.Schema {
+	computed := &schema.Schema{
+		Type:        attrSchema.Type,
+		Description: attrSchema.Description,
+		Computed:    true,
+		Sensitive:   attrSchema.Sensitive,
+	}
+
+	switch elem := attrSchema.Elem.(type) {
+	case *schema.Resource:
+		nested := make(map[string]*schema.Schema, len(elem.Schema))
+		for attr, nestedSchema := range elem.Schema {
+			nested[attr] = computedSchema(nestedSchema)
+		}
+		computed.Elem = &schema.Resource{Schema: nested}
+	case *schema.Schema:
+		computed.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	if attrSchema.Type == schema.TypeSet {
+		computed.Set = attrSchema.Set
+	}
+	return computed
+}
+
+// nameRegexFilterSchema is the optional name filter shared by the plural data sources
+func nameRegexFilterSchema(objectType string) *schema.Schema {
+	return &schema.Schema{
+		Description:  fmt.Sprintf("Regular expression used to filter %s by name. If not set, all %s are returned.", objectType, objectType),
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+}
+
+// getNameRegexFilter compiles the name_regex filter of a plural data source. Returns nil if the filter is not set.
+func getNameRegexFilter(d *schema.ResourceData) (*regexp.Regexp, error) {
+	nameRegex, ok := d.GetOk("name_regex")
+	if !ok {
+		return nil, nil
+	}
+	return regexp.Compile(nameRegex.(string))
+}
+
+// matchesNameRegexFilter returns true if the name matches the filter or no filter is set
+func matchesNameRegexFilter(filter *regexp.Regexp, name *string) bool {
+	if filter == nil {
+		return true
+	}
+	return name != nil && filter.MatchString(*name)
+}
+
+// pluralDataSourceId builds a stable ID for a plural data source from the values of its filter attributes
+func pluralDataSourceId(d *schema.ResourceData, filterAttrs ...string) string {
+	filterValues := make([]string, len(filterAttrs))
+	for i, attr := range filterAttrs {
+		filterValues[i] = fmt.Sprintf("%s=%v", attr, d.Get(attr))
+	}
+	algorithm := fnv.New32()
+	algorithm.Write([]byte(strings.Join(filterValues, ",")))
+	return strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+}
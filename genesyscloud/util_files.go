@@ -13,19 +13,20 @@ import (
 	"net/url"
 	"os"
 	"path"
-	"strings"
 )
 
 type S3Uploader struct {
-	reader        io.Reader
-	formData      map[string]io.Reader
-	bodyBuf       *bytes.Buffer
-	writer        *multipart.Writer
-	substitutions map[string]interface{}
-	headers       map[string]string
-	httpMethod    string
-	presignedUrl  string
-	client        http.Client
+	reader              io.Reader
+	formData            map[string]io.Reader
+	bodyBuf             *bytes.Buffer
+	writer              *multipart.Writer
+	substitutions       map[string]interface{}
+	typedSubstitutions  map[string]interface{}
+	strictSubstitutions bool
+	headers             map[string]string
+	httpMethod          string
+	presignedUrl        string
+	client              http.Client
 }
 
 func NewS3Uploader(reader io.Reader, formData map[string]io.Reader, substitutions map[string]interface{}, headers map[string]string, method, presignedUrl string) *S3Uploader {
@@ -48,17 +49,18 @@ func NewS3Uploader(reader io.Reader, formData map[string]io.Reader, substitution
 	return s3Uploader
 }
 
-func (s *S3Uploader) substituteValues() {
-	// Attribute specific to the flows resource
-	if s.substitutions != nil && len(s.substitutions) > 0 {
-		fileContents := s.bodyBuf.String()
-		for k, v := range s.substitutions {
-			fileContents = strings.Replace(fileContents, fmt.Sprintf("{{%s}}", k), v.(string), -1)
+func (s *S3Uploader) substituteValues() error {
+	// Attribute specific to the flows and scripts resources
+	if len(s.substitutions) > 0 || len(s.typedSubstitutions) > 0 || s.strictSubstitutions {
+		fileContents, err := renderSubstitutions(s.bodyBuf.String(), s.substitutions, s.typedSubstitutions, s.strictSubstitutions)
+		if err != nil {
+			return err
 		}
 
 		s.bodyBuf.Reset()
 		s.bodyBuf.WriteString(fileContents)
 	}
+	return nil
 }
 
 func (s *S3Uploader) Upload() ([]byte, error) {
@@ -74,7 +76,9 @@ func (s *S3Uploader) Upload() ([]byte, error) {
 		}
 	}
 
-	s.substituteValues()
+	if err := s.substituteValues(); err != nil {
+		return nil, err
+	}
 
 	req, _ := http.NewRequest(s.httpMethod, s.presignedUrl, s.bodyBuf)
 	for key, value := range s.headers {
@@ -222,9 +226,9 @@ func prepareAndUploadFile(filename string, substitutions map[string]interface{},
 
 	// Attribute specific to the flows resource
 	if len(substitutions) > 0 {
-		fileContents := bodyBuf.String()
-		for k, v := range substitutions {
-			fileContents = strings.Replace(fileContents, fmt.Sprintf("{{%s}}", k), v.(string), -1)
+		fileContents, err := renderSubstitutions(bodyBuf.String(), substitutions, nil, false)
+		if err != nil {
+			return nil, err
 		}
 
 		bodyBuf.Reset()
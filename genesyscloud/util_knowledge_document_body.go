@@ -0,0 +1,705 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+/*
+Knowledge document bodies are made of paragraph, image, video and list blocks. This file converts Markdown and HTML
+files to that block model, and converts the block model back to Markdown for the exporter.
+
+Only the formatting supported by the block model is kept:
+  - Text marks: bold (**text**), italic (*text*) and underline (<u>text</u>)
+  - Hyperlinks ([text](url)) and images (![alt](url)), which may be linked ([![alt](url)](link))
+  - Unordered (- item) and ordered (1. item) lists
+  - Videos, written as <video src="url"></video> on their own line
+  - Line breaks, written as <br>
+  - HTML comments on their own line, which end the current list
+
+Headings are converted to bold paragraphs.
+*/
+
+const (
+	documentMarkBold      = "Bold"
+	documentMarkItalic    = "Italic"
+	documentMarkUnderline = "Underline"
+)
+
+var (
+	markdownUnorderedItemRegex = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	markdownOrderedItemRegex   = regexp.MustCompile(`^\s*\d+[.)]\s+(.*)$`)
+	markdownHeadingRegex       = regexp.MustCompile(`^\s*#{1,6}\s+(.*?)\s*#*\s*$`)
+	markdownImageRegex         = regexp.MustCompile(`^!\[[^\]]*\]\(([^)\s]+)\)$`)
+	markdownLinkedImageRegex   = regexp.MustCompile(`^\[!\[[^\]]*\]\(([^)\s]+)\)\]\(([^)\s]+)\)$`)
+	markdownVideoRegex         = regexp.MustCompile(`^<video\s+src=["']([^"']+)["']\s*/?>(\s*</video>)?$`)
+	markdownLineBreakRegex     = regexp.MustCompile(`^<br\s*/?>`)
+	markdownCommentRegex       = regexp.MustCompile(`^<!--.*-->$`)
+	markdownBlockMarkerRegex   = regexp.MustCompile(`^\s*(?:[#+-]|\d+[.)])`)
+	htmlWhitespaceRegex        = regexp.MustCompile(`\s+`)
+)
+
+// documentBodyFromFile reads a Markdown (.md, .markdown) or HTML (.html, .htm) file and converts it to a document body
+func documentBodyFromFile(path string) (*platformclientv2.Documentbody, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document body file %s: %s", path, err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown":
+		return markdownToDocumentBody(string(content)), nil
+	case ".html", ".htm":
+		return htmlToDocumentBody(string(content))
+	default:
+		return nil, fmt.Errorf("document body file %s must be a Markdown (.md, .markdown) or HTML (.html, .htm) file", path)
+	}
+}
+
+// documentInlineBuilder collects the content blocks of a paragraph or list item
+type documentInlineBuilder struct {
+	blocks []platformclientv2.Documentcontentblock
+}
+
+func (b *documentInlineBuilder) addText(text string, marks []string, hyperlink string) {
+	if text == "" {
+		return
+	}
+	if len(b.blocks) > 0 {
+		last := b.blocks[len(b.blocks)-1].Text
+		if last != nil && listsAreEquivalent(*nilToEmptyList(last.Marks), marks) && valueOrEmpty(last.Hyperlink) == hyperlink {
+			combined := *last.Text + text
+			last.Text = &combined
+			return
+		}
+	}
+
+	textOut := platformclientv2.Documenttext{Text: &text}
+	if len(marks) > 0 {
+		marksCopy := append([]string(nil), marks...)
+		textOut.Marks = &marksCopy
+	}
+	if hyperlink != "" {
+		textOut.Hyperlink = &hyperlink
+	}
+	b.blocks = append(b.blocks, platformclientv2.Documentcontentblock{
+		VarType: platformclientv2.String("Text"),
+		Text:    &textOut,
+	})
+}
+
+func (b *documentInlineBuilder) addImage(url, hyperlink string) {
+	b.blocks = append(b.blocks, platformclientv2.Documentcontentblock{
+		VarType: platformclientv2.String("Image"),
+		Image:   buildDocumentBodyImage(url, hyperlink),
+	})
+}
+
+// trim removes the whitespace around the content and reports whether any content is left
+func (b *documentInlineBuilder) trim() bool {
+	for len(b.blocks) > 0 {
+		first := b.blocks[0].Text
+		if first == nil {
+			break
+		}
+		trimmed := strings.TrimLeft(*first.Text, " \t")
+		if trimmed != "" {
+			first.Text = &trimmed
+			break
+		}
+		b.blocks = b.blocks[1:]
+	}
+	for len(b.blocks) > 0 {
+		last := b.blocks[len(b.blocks)-1].Text
+		if last == nil {
+			break
+		}
+		trimmed := strings.TrimRight(*last.Text, " \t")
+		if trimmed != "" {
+			last.Text = &trimmed
+			break
+		}
+		b.blocks = b.blocks[:len(b.blocks)-1]
+	}
+	return len(b.blocks) > 0
+}
+
+// documentBodyBuilder collects the blocks of a document body
+type documentBodyBuilder struct {
+	blocks    []platformclientv2.Documentbodyblock
+	paragraph *documentInlineBuilder
+	listType  string
+	listItems []platformclientv2.Documentbodylistblock
+}
+
+func (b *documentBodyBuilder) currentParagraph() *documentInlineBuilder {
+	b.flushList()
+	if b.paragraph == nil {
+		b.paragraph = &documentInlineBuilder{}
+	}
+	return b.paragraph
+}
+
+func (b *documentBodyBuilder) flushParagraph() {
+	if b.paragraph != nil && b.paragraph.trim() {
+		contentBlocks := b.paragraph.blocks
+		b.blocks = append(b.blocks, platformclientv2.Documentbodyblock{
+			VarType:   platformclientv2.String("Paragraph"),
+			Paragraph: &platformclientv2.Documentbodyparagraph{Blocks: &contentBlocks},
+		})
+	}
+	b.paragraph = nil
+}
+
+func (b *documentBodyBuilder) addListItem(listType string, item *documentInlineBuilder) {
+	b.flushParagraph()
+	if b.listType != listType {
+		b.flushList()
+		b.listType = listType
+	}
+	if !item.trim() {
+		return
+	}
+	contentBlocks := item.blocks
+	b.listItems = append(b.listItems, platformclientv2.Documentbodylistblock{
+		VarType: platformclientv2.String("ListItem"),
+		Blocks:  &contentBlocks,
+	})
+}
+
+func (b *documentBodyBuilder) flushList() {
+	if len(b.listItems) > 0 {
+		listItems := b.listItems
+		b.blocks = append(b.blocks, platformclientv2.Documentbodyblock{
+			VarType: platformclientv2.String(b.listType),
+			List:    &platformclientv2.Documentbodylist{Blocks: &listItems},
+		})
+	}
+	b.listType = ""
+	b.listItems = nil
+}
+
+func (b *documentBodyBuilder) addImage(url, hyperlink string) {
+	b.flush()
+	b.blocks = append(b.blocks, platformclientv2.Documentbodyblock{
+		VarType: platformclientv2.String("Image"),
+		Image:   buildDocumentBodyImage(url, hyperlink),
+	})
+}
+
+func (b *documentBodyBuilder) addVideo(url string) {
+	b.flush()
+	b.blocks = append(b.blocks, platformclientv2.Documentbodyblock{
+		VarType: platformclientv2.String("Video"),
+		Video:   &platformclientv2.Documentbodyvideo{Url: &url},
+	})
+}
+
+func (b *documentBodyBuilder) flush() {
+	b.flushParagraph()
+	b.flushList()
+}
+
+func (b *documentBodyBuilder) body() *platformclientv2.Documentbody {
+	b.flush()
+	blocks := b.blocks
+	if blocks == nil {
+		blocks = make([]platformclientv2.Documentbodyblock, 0)
+	}
+	return &platformclientv2.Documentbody{Blocks: &blocks}
+}
+
+func buildDocumentBodyImage(url, hyperlink string) *platformclientv2.Documentbodyimage {
+	image := platformclientv2.Documentbodyimage{Url: &url}
+	if hyperlink != "" {
+		image.Hyperlink = &hyperlink
+	}
+	return &image
+}
+
+// markdownToDocumentBody converts Markdown to a document body
+func markdownToDocumentBody(markdown string) *platformclientv2.Documentbody {
+	builder := &documentBodyBuilder{}
+
+	var listItem *documentInlineBuilder
+	var listItemType, listItemText string
+	flushListItem := func() {
+		if listItem != nil {
+			parseMarkdownInline(listItemText, listItem, nil, "")
+			builder.addListItem(listItemType, listItem)
+		}
+		listItem = nil
+	}
+	var paragraphLines []string
+	flushParagraph := func() {
+		if len(paragraphLines) > 0 {
+			parseMarkdownInline(strings.Join(paragraphLines, " "), builder.currentParagraph(), nil, "")
+			builder.flushParagraph()
+		}
+		paragraphLines = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "" {
+			flushListItem()
+			flushParagraph()
+			continue
+		}
+		if markdownCommentRegex.MatchString(trimmed) {
+			// Comments separate adjacent lists of the same type
+			flushListItem()
+			flushParagraph()
+			builder.flushList()
+			continue
+		}
+		if match := markdownUnorderedItemRegex.FindStringSubmatch(line); match != nil {
+			flushListItem()
+			flushParagraph()
+			listItem, listItemType, listItemText = &documentInlineBuilder{}, "UnorderedList", match[1]
+			continue
+		}
+		if match := markdownOrderedItemRegex.FindStringSubmatch(line); match != nil {
+			flushListItem()
+			flushParagraph()
+			listItem, listItemType, listItemText = &documentInlineBuilder{}, "OrderedList", match[1]
+			continue
+		}
+		if listItem != nil && line != trimmed {
+			// Indented lines continue the current list item
+			listItemText += " " + trimmed
+			continue
+		}
+		flushListItem()
+
+		if match := markdownHeadingRegex.FindStringSubmatch(line); match != nil {
+			flushParagraph()
+			parseMarkdownInline(match[1], builder.currentParagraph(), []string{documentMarkBold}, "")
+			builder.flushParagraph()
+			continue
+		}
+		if len(paragraphLines) == 0 {
+			if match := markdownVideoRegex.FindStringSubmatch(trimmed); match != nil {
+				builder.addVideo(match[1])
+				continue
+			}
+			if match := markdownImageRegex.FindStringSubmatch(trimmed); match != nil {
+				builder.addImage(match[1], "")
+				continue
+			}
+			if match := markdownLinkedImageRegex.FindStringSubmatch(trimmed); match != nil {
+				builder.addImage(match[1], match[2])
+				continue
+			}
+		}
+		paragraphLines = append(paragraphLines, trimmed)
+	}
+	flushListItem()
+	flushParagraph()
+
+	return builder.body()
+}
+
+// parseMarkdownInline adds the text, marks, links and images of a line of Markdown to b
+func parseMarkdownInline(text string, b *documentInlineBuilder, marks []string, hyperlink string) {
+	activeMarks := append([]string(nil), marks...)
+	var buf strings.Builder
+	flush := func() {
+		b.addText(buf.String(), activeMarks, hyperlink)
+		buf.Reset()
+	}
+	toggleMark := func(mark string) {
+		flush()
+		activeMarks = toggleDocumentMark(activeMarks, mark)
+	}
+
+	for i := 0; i < len(text); i++ {
+		rest := text[i:]
+		switch {
+		case rest[0] == '\\' && len(rest) > 1:
+			buf.WriteByte(rest[1])
+			i++
+		case strings.HasPrefix(rest, "**") || strings.HasPrefix(rest, "__"):
+			toggleMark(documentMarkBold)
+			i++
+		case rest[0] == '*' || (rest[0] == '_' && isMarkdownUnderscoreDelimiter(text, i, StringInSlice(documentMarkItalic, activeMarks))):
+			toggleMark(documentMarkItalic)
+		case strings.HasPrefix(rest, "<u>"):
+			toggleMark(documentMarkUnderline)
+			i += len("<u>") - 1
+		case strings.HasPrefix(rest, "</u>"):
+			toggleMark(documentMarkUnderline)
+			i += len("</u>") - 1
+		case markdownLineBreakRegex.MatchString(rest):
+			buf.WriteString("\n")
+			i += len(markdownLineBreakRegex.FindString(rest)) - 1
+		case strings.HasPrefix(rest, "!["):
+			if url, length, ok := parseMarkdownLinkTarget(rest[1:]); ok {
+				flush()
+				b.addImage(url, hyperlink)
+				i += length
+			} else {
+				buf.WriteByte(rest[0])
+			}
+		case rest[0] == '[':
+			if url, length, ok := parseMarkdownLinkTarget(rest); ok {
+				flush()
+				label := rest[1:strings.LastIndex(rest[:length], "](")]
+				parseMarkdownInline(label, b, activeMarks, url)
+				i += length - 1
+			} else {
+				buf.WriteByte(rest[0])
+			}
+		default:
+			buf.WriteByte(rest[0])
+		}
+	}
+	flush()
+}
+
+// parseMarkdownLinkTarget parses [label](url) at the start of text and returns the url and the length of the link
+func parseMarkdownLinkTarget(text string) (string, int, bool) {
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				if !strings.HasPrefix(text[i+1:], "(") {
+					return "", 0, false
+				}
+				end := strings.IndexByte(text[i+2:], ')')
+				if end < 0 {
+					return "", 0, false
+				}
+				url := strings.TrimSpace(text[i+2 : i+2+end])
+				return url, i + 2 + end + 1, url != ""
+			}
+		}
+	}
+	return "", 0, false
+}
+
+// isMarkdownUnderscoreDelimiter reports whether the underscore at i opens or closes italic text rather than being part of a word
+func isMarkdownUnderscoreDelimiter(text string, i int, closing bool) bool {
+	isWordChar := func(c byte) bool {
+		return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+	}
+	if closing {
+		return i+1 >= len(text) || !isWordChar(text[i+1])
+	}
+	return i == 0 || !isWordChar(text[i-1])
+}
+
+// toggleDocumentMark adds or removes mark, keeping marks in a stable order
+func toggleDocumentMark(marks []string, mark string) []string {
+	enabled := make(map[string]bool)
+	for _, m := range marks {
+		enabled[m] = true
+	}
+	enabled[mark] = !enabled[mark]
+
+	var marksOut []string
+	for _, m := range []string{documentMarkBold, documentMarkItalic, documentMarkUnderline} {
+		if enabled[m] {
+			marksOut = append(marksOut, m)
+		}
+	}
+	return marksOut
+}
+
+// htmlToDocumentBody converts an HTML document or fragment to a document body
+func htmlToDocumentBody(content string) (*platformclientv2.Documentbody, error) {
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML document body: %s", err)
+	}
+
+	root := findHtmlElement(doc, atom.Body)
+	if root == nil {
+		root = doc
+	}
+
+	builder := &documentBodyBuilder{}
+	parseHtmlBlocks(root, builder)
+	return builder.body(), nil
+}
+
+func findHtmlElement(node *html.Node, a atom.Atom) *html.Node {
+	if node.Type == html.ElementNode && node.DataAtom == a {
+		return node
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if found := findHtmlElement(child, a); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func getHtmlAttr(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func parseHtmlBlocks(parent *html.Node, builder *documentBodyBuilder) {
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		if node.Type != html.ElementNode {
+			if node.Type == html.TextNode && (builder.paragraph != nil || strings.TrimSpace(node.Data) != "") {
+				parseHtmlInline(node, builder.currentParagraph(), nil, "")
+			}
+			continue
+		}
+
+		switch node.DataAtom {
+		case atom.P:
+			builder.flushParagraph()
+			parseHtmlInlineChildren(node, builder.currentParagraph(), nil, "")
+			builder.flushParagraph()
+		case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+			builder.flushParagraph()
+			parseHtmlInlineChildren(node, builder.currentParagraph(), []string{documentMarkBold}, "")
+			builder.flushParagraph()
+		case atom.Ul, atom.Ol:
+			listType := "UnorderedList"
+			if node.DataAtom == atom.Ol {
+				listType = "OrderedList"
+			}
+			builder.flushList()
+			for item := node.FirstChild; item != nil; item = item.NextSibling {
+				if item.Type == html.ElementNode && item.DataAtom == atom.Li {
+					listItem := &documentInlineBuilder{}
+					parseHtmlInlineChildren(item, listItem, nil, "")
+					builder.addListItem(listType, listItem)
+				}
+			}
+			builder.flushList()
+		case atom.Img:
+			builder.addImage(getHtmlAttr(node, "src"), "")
+		case atom.Video, atom.Iframe:
+			url := getHtmlAttr(node, "src")
+			if url == "" {
+				if source := findHtmlElement(node, atom.Source); source != nil {
+					url = getHtmlAttr(source, "src")
+				}
+			}
+			if url != "" {
+				builder.addVideo(url)
+			}
+		case atom.A:
+			if img := node.FirstChild; img != nil && img.NextSibling == nil && img.Type == html.ElementNode && img.DataAtom == atom.Img && builder.paragraph == nil {
+				builder.addImage(getHtmlAttr(img, "src"), getHtmlAttr(node, "href"))
+			} else {
+				parseHtmlInline(node, builder.currentParagraph(), nil, "")
+			}
+		case atom.Div, atom.Section, atom.Article, atom.Main, atom.Header, atom.Footer, atom.Blockquote:
+			builder.flushParagraph()
+			parseHtmlBlocks(node, builder)
+			builder.flushParagraph()
+		case atom.Script, atom.Style, atom.Head:
+			continue
+		default:
+			parseHtmlInline(node, builder.currentParagraph(), nil, "")
+		}
+	}
+}
+
+func parseHtmlInlineChildren(parent *html.Node, b *documentInlineBuilder, marks []string, hyperlink string) {
+	for node := parent.FirstChild; node != nil; node = node.NextSibling {
+		parseHtmlInline(node, b, marks, hyperlink)
+	}
+}
+
+func parseHtmlInline(node *html.Node, b *documentInlineBuilder, marks []string, hyperlink string) {
+	switch node.Type {
+	case html.TextNode:
+		b.addText(htmlWhitespaceRegex.ReplaceAllString(node.Data, " "), marks, hyperlink)
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	switch node.DataAtom {
+	case atom.Strong, atom.B:
+		marks = addDocumentMark(marks, documentMarkBold)
+	case atom.Em, atom.I:
+		marks = addDocumentMark(marks, documentMarkItalic)
+	case atom.U:
+		marks = addDocumentMark(marks, documentMarkUnderline)
+	case atom.A:
+		if href := getHtmlAttr(node, "href"); href != "" {
+			hyperlink = href
+		}
+	case atom.Img:
+		b.addImage(getHtmlAttr(node, "src"), hyperlink)
+		return
+	case atom.Br:
+		b.addText("\n", marks, hyperlink)
+		return
+	case atom.Script, atom.Style:
+		return
+	}
+	parseHtmlInlineChildren(node, b, marks, hyperlink)
+}
+
+func addDocumentMark(marks []string, mark string) []string {
+	if StringInSlice(mark, marks) {
+		return marks
+	}
+	return toggleDocumentMark(marks, mark)
+}
+
+// documentBodyToMarkdown converts a document body to Markdown that converts back to the same body
+func documentBodyToMarkdown(body *platformclientv2.Documentbody) string {
+	if body == nil || body.Blocks == nil {
+		return ""
+	}
+
+	var sections []string
+	previousListType := ""
+	for _, block := range *body.Blocks {
+		listType := ""
+		switch {
+		case block.Paragraph != nil:
+			if block.Paragraph.Blocks != nil {
+				sections = append(sections, escapeMarkdownBlockMarker(documentContentBlocksToMarkdown(*block.Paragraph.Blocks)))
+			}
+		case block.Image != nil:
+			sections = append(sections, documentImageToMarkdown(*block.Image))
+		case block.Video != nil:
+			sections = append(sections, fmt.Sprintf(`<video src="%s"></video>`, valueOrEmpty(block.Video.Url)))
+		case block.List != nil:
+			if block.List.Blocks == nil {
+				continue
+			}
+			listType = valueOrEmpty(block.VarType)
+			if listType == previousListType {
+				// Adjacent lists of the same type would otherwise be read back as one list
+				sections = append(sections, "<!-- -->")
+			}
+			var items []string
+			for i, item := range *block.List.Blocks {
+				prefix := "- "
+				if valueOrEmpty(block.VarType) == "OrderedList" {
+					prefix = fmt.Sprintf("%d. ", i+1)
+				}
+				content := ""
+				if item.Blocks != nil {
+					content = documentContentBlocksToMarkdown(*item.Blocks)
+				}
+				items = append(items, prefix+content)
+			}
+			sections = append(sections, strings.Join(items, "\n"))
+		}
+		previousListType = listType
+	}
+	if len(sections) == 0 {
+		return ""
+	}
+	return strings.Join(sections, "\n\n") + "\n"
+}
+
+func documentContentBlocksToMarkdown(blocks []platformclientv2.Documentcontentblock) string {
+	var sb strings.Builder
+	for _, block := range blocks {
+		if block.Image != nil {
+			sb.WriteString(documentImageToMarkdown(*block.Image))
+			continue
+		}
+		if block.Text == nil || block.Text.Text == nil {
+			continue
+		}
+
+		marks := *nilToEmptyList(block.Text.Marks)
+		var open, close strings.Builder
+		for _, mark := range []string{documentMarkBold, documentMarkItalic, documentMarkUnderline} {
+			if !StringInSlice(mark, marks) {
+				continue
+			}
+			switch mark {
+			case documentMarkBold:
+				open.WriteString("**")
+			case documentMarkItalic:
+				open.WriteString("*")
+			case documentMarkUnderline:
+				open.WriteString("<u>")
+			}
+		}
+		for i := len(marks) - 1; i >= 0; i-- {
+			switch marks[i] {
+			case documentMarkBold:
+				close.WriteString("**")
+			case documentMarkItalic:
+				close.WriteString("*")
+			case documentMarkUnderline:
+				close.WriteString("</u>")
+			}
+		}
+
+		text := open.String() + escapeMarkdown(*block.Text.Text) + close.String()
+		if hyperlink := valueOrEmpty(block.Text.Hyperlink); hyperlink != "" {
+			text = fmt.Sprintf("[%s](%s)", text, hyperlink)
+		}
+		sb.WriteString(text)
+	}
+	return sb.String()
+}
+
+func documentImageToMarkdown(image platformclientv2.Documentbodyimage) string {
+	markdown := fmt.Sprintf("![](%s)", valueOrEmpty(image.Url))
+	if hyperlink := valueOrEmpty(image.Hyperlink); hyperlink != "" {
+		markdown = fmt.Sprintf("[%s](%s)", markdown, hyperlink)
+	}
+	return markdown
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`!`, `\!`,
+	`<`, `\<`,
+	"\n", "<br>",
+)
+
+func escapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// escapeMarkdownBlockMarker escapes a heading or list marker at the start of a paragraph of Markdown, so the paragraph
+// is not read back as a heading or list
+func escapeMarkdownBlockMarker(paragraph string) string {
+	loc := markdownBlockMarkerRegex.FindStringIndex(paragraph)
+	if loc == nil {
+		return paragraph
+	}
+	// Escape the punctuation of the marker, as only punctuation can be escaped in Markdown
+	punctuation := loc[1] - 1
+	return paragraph[:punctuation] + `\` + paragraph[punctuation:]
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,192 @@
+package genesyscloud
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func documentBodyJson(t *testing.T, body *platformclientv2.Documentbody) string {
+	bodyJson, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(bodyJson)
+}
+
+func TestMarkdownToDocumentBody(t *testing.T) {
+	markdown := `# Resetting your password
+
+Open the **Settings** page and select *Security*.
+Then click <u>Reset</u>, or see [the guide](https://example.com/guide).
+
+![](https://example.com/reset.png)
+
+- First step
+- Second **step**
+
+1. One
+2. Two
+
+<video src="https://example.com/reset.mp4"></video>
+`
+	expected := `{"blocks":[` +
+		`{"type":"Paragraph","paragraph":{"blocks":[{"type":"Text","text":{"text":"Resetting your password","marks":["Bold"]}}]}},` +
+		`{"type":"Paragraph","paragraph":{"blocks":[` +
+		`{"type":"Text","text":{"text":"Open the "}},` +
+		`{"type":"Text","text":{"text":"Settings","marks":["Bold"]}},` +
+		`{"type":"Text","text":{"text":" page and select "}},` +
+		`{"type":"Text","text":{"text":"Security","marks":["Italic"]}},` +
+		`{"type":"Text","text":{"text":". Then click "}},` +
+		`{"type":"Text","text":{"text":"Reset","marks":["Underline"]}},` +
+		`{"type":"Text","text":{"text":", or see "}},` +
+		`{"type":"Text","text":{"text":"the guide","hyperlink":"https://example.com/guide"}},` +
+		`{"type":"Text","text":{"text":"."}}]}},` +
+		`{"type":"Image","image":{"url":"https://example.com/reset.png"}},` +
+		`{"type":"UnorderedList","list":{"blocks":[` +
+		`{"type":"ListItem","blocks":[{"type":"Text","text":{"text":"First step"}}]},` +
+		`{"type":"ListItem","blocks":[{"type":"Text","text":{"text":"Second "}},{"type":"Text","text":{"text":"step","marks":["Bold"]}}]}]}},` +
+		`{"type":"OrderedList","list":{"blocks":[` +
+		`{"type":"ListItem","blocks":[{"type":"Text","text":{"text":"One"}}]},` +
+		`{"type":"ListItem","blocks":[{"type":"Text","text":{"text":"Two"}}]}]}},` +
+		`{"type":"Video","video":{"url":"https://example.com/reset.mp4"}}]}`
+
+	assert.JSONEq(t, expected, documentBodyJson(t, markdownToDocumentBody(markdown)))
+}
+
+func TestMarkdownToDocumentBodyInline(t *testing.T) {
+	body := markdownToDocumentBody(`Use snake_case names, ***both*** marks, \*literal\* and [![](https://example.com/logo.png)](https://example.com)`)
+	expected := `{"blocks":[{"type":"Paragraph","paragraph":{"blocks":[` +
+		`{"type":"Text","text":{"text":"Use snake_case names, "}},` +
+		`{"type":"Text","text":{"text":"both","marks":["Bold","Italic"]}},` +
+		`{"type":"Text","text":{"text":" marks, *literal* and "}},` +
+		`{"type":"Image","image":{"url":"https://example.com/logo.png","hyperlink":"https://example.com"}}]}}]}`
+
+	assert.JSONEq(t, expected, documentBodyJson(t, body))
+}
+
+func TestHtmlToDocumentBody(t *testing.T) {
+	content := `<html><head><title>Ignored</title></head><body>
+<h2>Resetting your password</h2>
+<p>Open the <strong>Settings</strong>   page
+and select <em>Security</em>.<br>Then click <a href="https://example.com/reset"><u>Reset</u></a>.</p>
+<a href="https://example.com"><img src="https://example.com/reset.png"></a>
+<ul><li>First step</li><li>Second <b>step</b></li></ul>
+<ol><li>One</li></ol>
+<video><source src="https://example.com/reset.mp4"></video>
+</body></html>`
+
+	expected := `{"blocks":[` +
+		`{"type":"Paragraph","paragraph":{"blocks":[{"type":"Text","text":{"text":"Resetting your password","marks":["Bold"]}}]}},` +
+		`{"type":"Paragraph","paragraph":{"blocks":[` +
+		`{"type":"Text","text":{"text":"Open the "}},` +
+		`{"type":"Text","text":{"text":"Settings","marks":["Bold"]}},` +
+		`{"type":"Text","text":{"text":" page and select "}},` +
+		`{"type":"Text","text":{"text":"Security","marks":["Italic"]}},` +
+		`{"type":"Text","text":{"text":".\nThen click "}},` +
+		`{"type":"Text","text":{"text":"Reset","marks":["Underline"],"hyperlink":"https://example.com/reset"}},` +
+		`{"type":"Text","text":{"text":"."}}]}},` +
+		`{"type":"Image","image":{"url":"https://example.com/reset.png","hyperlink":"https://example.com"}},` +
+		`{"type":"UnorderedList","list":{"blocks":[` +
+		`{"type":"ListItem","blocks":[{"type":"Text","text":{"text":"First step"}}]},` +
+		`{"type":"ListItem","blocks":[{"type":"Text","text":{"text":"Second "}},{"type":"Text","text":{"text":"step","marks":["Bold"]}}]}]}},` +
+		`{"type":"OrderedList","list":{"blocks":[` +
+		`{"type":"ListItem","blocks":[{"type":"Text","text":{"text":"One"}}]}]}},` +
+		`{"type":"Video","video":{"url":"https://example.com/reset.mp4"}}]}`
+
+	body, err := htmlToDocumentBody(content)
+	assert.NoError(t, err)
+	assert.JSONEq(t, expected, documentBodyJson(t, body))
+}
+
+func TestDocumentBodyToMarkdownRoundTrip(t *testing.T) {
+	markdown := `**Bold *and italic*** text with a [**link**](https://example.com) and a \*star\*<br>on two lines
+
+[![](https://example.com/image.png)](https://example.com)
+
+- Item with <u>underline</u>
+- Item with ![](https://example.com/inline.png)
+
+1. First
+2. Second
+
+<video src="https://example.com/video.mp4"></video>
+`
+	body := markdownToDocumentBody(markdown)
+	exported := documentBodyToMarkdown(body)
+
+	assert.JSONEq(t, documentBodyJson(t, body), documentBodyJson(t, markdownToDocumentBody(exported)))
+	assert.Contains(t, exported, `\*star\*<br>on two lines`)
+	assert.Contains(t, exported, "1. First\n2. Second")
+}
+
+func TestDocumentBodyToMarkdownRoundTripFromBody(t *testing.T) {
+	paragraph := func(text string) platformclientv2.Documentbodyblock {
+		return platformclientv2.Documentbodyblock{
+			VarType: platformclientv2.String("Paragraph"),
+			Paragraph: &platformclientv2.Documentbodyparagraph{Blocks: &[]platformclientv2.Documentcontentblock{
+				{VarType: platformclientv2.String("Text"), Text: &platformclientv2.Documenttext{Text: platformclientv2.String(text)}},
+			}},
+		}
+	}
+	list := func(listType string, items ...string) platformclientv2.Documentbodyblock {
+		listItems := make([]platformclientv2.Documentbodylistblock, 0, len(items))
+		for _, item := range items {
+			listItems = append(listItems, platformclientv2.Documentbodylistblock{
+				VarType: platformclientv2.String("ListItem"),
+				Blocks: &[]platformclientv2.Documentcontentblock{
+					{VarType: platformclientv2.String("Text"), Text: &platformclientv2.Documenttext{Text: platformclientv2.String(item)}},
+				},
+			})
+		}
+		return platformclientv2.Documentbodyblock{
+			VarType: platformclientv2.String(listType),
+			List:    &platformclientv2.Documentbodylist{Blocks: &listItems},
+		}
+	}
+
+	// Paragraphs starting with block markers and adjacent lists of the same type keep their structure
+	body := &platformclientv2.Documentbody{Blocks: &[]platformclientv2.Documentbodyblock{
+		paragraph("# Not a heading"),
+		paragraph("- Not a list item"),
+		paragraph("+ Not a list item"),
+		paragraph("1. Not an ordered list item"),
+		paragraph("2) Not an ordered list item"),
+		paragraph("#hashtag and 3 - 2 = 1"),
+		list("UnorderedList", "First list", "- Dash item"),
+		list("UnorderedList", "Second list"),
+		list("OrderedList", "First ordered list"),
+		list("OrderedList", "Second ordered list"),
+		paragraph("*Stars* and <u>tags</u>\nacross lines"),
+	}}
+
+	exported := documentBodyToMarkdown(body)
+	assert.JSONEq(t, documentBodyJson(t, body), documentBodyJson(t, markdownToDocumentBody(exported)))
+	assert.Contains(t, exported, "\\# Not a heading")
+	assert.Contains(t, exported, "1\\. Not an ordered list item")
+}
+
+func TestDocumentBodyFromFile(t *testing.T) {
+	dir := t.TempDir()
+
+	markdownPath := filepath.Join(dir, "article.md")
+	assert.NoError(t, os.WriteFile(markdownPath, []byte("Hello **world**"), 0644))
+	body, err := documentBodyFromFile(markdownPath)
+	assert.NoError(t, err)
+	assert.Len(t, *body.Blocks, 1)
+
+	htmlPath := filepath.Join(dir, "article.HTML")
+	assert.NoError(t, os.WriteFile(htmlPath, []byte("<p>Hello <b>world</b></p>"), 0644))
+	htmlBody, err := documentBodyFromFile(htmlPath)
+	assert.NoError(t, err)
+	assert.JSONEq(t, documentBodyJson(t, body), documentBodyJson(t, htmlBody))
+
+	textPath := filepath.Join(dir, "article.txt")
+	assert.NoError(t, os.WriteFile(textPath, []byte("Hello"), 0644))
+	_, err = documentBodyFromFile(textPath)
+	assert.ErrorContains(t, err, "must be a Markdown")
+}
@@ -0,0 +1,252 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+// Kinds of contact list columns that an attribute can reference
+const (
+	contactListColumnAny   = "column"
+	contactListColumnPhone = "phone column"
+	contactListColumnEmail = "email column"
+)
+
+// contactListColumnRef is a contact list column referenced by the attribute at path
+type contactListColumnRef struct {
+	path   string
+	column string
+	kind   string
+}
+
+// contactListColumns holds the columns of a contact list by kind
+type contactListColumns map[string][]string
+
+// plannedContactListColumns holds the planned columns of the contact lists with pending column changes by ID.
+// Contact lists are planned before the resources referencing them, so these resources can validate against
+// columns added in the same apply. A nil value means the planned columns are not known yet.
+var plannedContactListColumns sync.Map
+
+// customizeContactListColumnsDiff returns a CustomizeDiffFunc that checks at plan time that all the columns
+// returned by getRefs exist on the contact list referenced by contactListAttr. The planned columns are used
+// when the contact list has pending column changes. The check is skipped when the contact list is created
+// or replaced in the same apply, or its planned columns are not known yet.
+func customizeContactListColumnsDiff(contactListAttr string, getRefs func(diff *schema.ResourceDiff) []contactListColumnRef, watchedAttrs ...string) schema.CustomizeDiffFunc {
+	watchedAttrs = append([]string{contactListAttr}, watchedAttrs...)
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() != "" && !diff.HasChanges(watchedAttrs...) {
+			return nil
+		}
+		if !diff.NewValueKnown(contactListAttr) {
+			return nil
+		}
+		contactListId := diff.Get(contactListAttr).(string)
+		if contactListId == "" {
+			return nil
+		}
+		refs := getRefs(diff)
+		if len(refs) == 0 {
+			return nil
+		}
+
+		if planned, ok := plannedContactListColumns.Load(contactListId); ok {
+			if planned == nil {
+				return nil
+			}
+			return validateContactListColumnRefs(refs, planned.(contactListColumns), contactListId)
+		}
+
+		sdkConfig := meta.(*ProviderMeta).ClientConfig
+		outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+
+		contactList, resp, getErr := outboundApi.GetOutboundContactlist(contactListId, false, false)
+		if getErr != nil {
+			if isStatus404(resp) {
+				// The contact list is gone. Let the API report it on apply.
+				return nil
+			}
+			return fmt.Errorf("Failed to read contact list %s to validate columns: %s", contactListId, getErr)
+		}
+
+		return validateContactListColumnRefs(refs, flattenContactListColumns(contactList), contactListId)
+	}
+}
+
+// customizeContactListOwnColumnsDiff checks that the columns used by the other attributes of a contact list
+// are part of its column_names and records the planned columns of an existing contact list
+func customizeContactListOwnColumnsDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	recordPlannedContactListColumns(diff)
+	if !diff.NewValueKnown("column_names") {
+		return nil
+	}
+	columns := contactListColumns{
+		contactListColumnAny: InterfaceListToStrings(diff.Get("column_names").([]interface{})),
+	}
+
+	var refs []contactListColumnRef
+	refs = appendContactListColumnRef(refs, "preview_mode_column_name", diff.Get("preview_mode_column_name"), contactListColumnAny)
+	refs = appendContactListColumnRef(refs, "zip_code_column_name", diff.Get("zip_code_column_name"), contactListColumnAny)
+	for _, phoneColumn := range diff.Get("phone_columns").(*schema.Set).List() {
+		phoneColumnMap := phoneColumn.(map[string]interface{})
+		refs = appendContactListColumnRef(refs, "phone_columns.column_name", phoneColumnMap["column_name"], contactListColumnAny)
+		refs = appendContactListColumnRef(refs, "phone_columns.callable_time_column", phoneColumnMap["callable_time_column"], contactListColumnAny)
+	}
+	for _, emailColumn := range diff.Get("email_columns").(*schema.Set).List() {
+		emailColumnMap := emailColumn.(map[string]interface{})
+		refs = appendContactListColumnRef(refs, "email_columns.column_name", emailColumnMap["column_name"], contactListColumnAny)
+		refs = appendContactListColumnRef(refs, "email_columns.contactable_time_column", emailColumnMap["contactable_time_column"], contactListColumnAny)
+	}
+	for i, specification := range diff.Get("column_data_type_specifications").([]interface{}) {
+		specificationMap := specification.(map[string]interface{})
+		refs = appendContactListColumnRef(refs, fmt.Sprintf("column_data_type_specifications.%d.column_name", i), specificationMap["column_name"], contactListColumnAny)
+	}
+
+	return validateContactListColumnRefs(refs, columns, "")
+}
+
+// recordPlannedContactListColumns stores the planned columns of an existing contact list in plannedContactListColumns
+// when they change, and forgets them otherwise
+func recordPlannedContactListColumns(diff *schema.ResourceDiff) {
+	if diff.Id() == "" {
+		return
+	}
+	if !diff.HasChanges("column_names", "phone_columns", "email_columns") {
+		plannedContactListColumns.Delete(diff.Id())
+		return
+	}
+	if !diff.NewValueKnown("column_names") || !diff.NewValueKnown("phone_columns") || !diff.NewValueKnown("email_columns") {
+		plannedContactListColumns.Store(diff.Id(), nil)
+		return
+	}
+	plannedContactListColumns.Store(diff.Id(), buildPlannedContactListColumns(
+		diff.Get("column_names").([]interface{}),
+		diff.Get("phone_columns").(*schema.Set).List(),
+		diff.Get("email_columns").(*schema.Set).List(),
+	))
+}
+
+func buildPlannedContactListColumns(columnNames []interface{}, phoneColumns []interface{}, emailColumns []interface{}) contactListColumns {
+	columns := contactListColumns{
+		contactListColumnAny: InterfaceListToStrings(columnNames),
+	}
+	for _, phoneColumn := range phoneColumns {
+		if columnName, _ := phoneColumn.(map[string]interface{})["column_name"].(string); columnName != "" {
+			columns[contactListColumnPhone] = append(columns[contactListColumnPhone], columnName)
+		}
+	}
+	for _, emailColumn := range emailColumns {
+		if columnName, _ := emailColumn.(map[string]interface{})["column_name"].(string); columnName != "" {
+			columns[contactListColumnEmail] = append(columns[contactListColumnEmail], columnName)
+		}
+	}
+	return columns
+}
+
+// appendContactListColumnRef adds a reference to column if it is set. Unknown values are read as empty strings.
+func appendContactListColumnRef(refs []contactListColumnRef, path string, column interface{}, kind string) []contactListColumnRef {
+	if columnStr, ok := column.(string); ok && columnStr != "" {
+		return append(refs, contactListColumnRef{path: path, column: columnStr, kind: kind})
+	}
+	return refs
+}
+
+// appendContactListColumnRefs adds a reference to each column of a list or set attribute
+func appendContactListColumnRefs(refs []contactListColumnRef, path string, columns interface{}, kind string) []contactListColumnRef {
+	var columnList []interface{}
+	switch columnsVal := columns.(type) {
+	case []interface{}:
+		columnList = columnsVal
+	case *schema.Set:
+		columnList = columnsVal.List()
+	}
+	for i, column := range columnList {
+		refs = appendContactListColumnRef(refs, fmt.Sprintf("%s.%d", path, i), column, kind)
+	}
+	return refs
+}
+
+// appendContactListColumnMappingRefs adds a reference to the contact column of each contact_column_to_data_action_field_mappings element
+func appendContactListColumnMappingRefs(refs []contactListColumnRef, path string, mappings interface{}) []contactListColumnRef {
+	if mappingSet, ok := mappings.(*schema.Set); ok {
+		for _, mapping := range mappingSet.List() {
+			refs = appendContactListColumnRef(refs, path+".contact_column_name", mapping.(map[string]interface{})["contact_column_name"], contactListColumnAny)
+		}
+	}
+	return refs
+}
+
+// appendContactListColumnKeyRefs adds a reference to each key of a map attribute keyed by contact column
+func appendContactListColumnKeyRefs(refs []contactListColumnRef, path string, columnMap interface{}) []contactListColumnRef {
+	if columnMapVal, ok := columnMap.(map[string]interface{}); ok {
+		keys := make([]string, 0, len(columnMapVal))
+		for key := range columnMapVal {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			refs = appendContactListColumnRef(refs, path, key, contactListColumnAny)
+		}
+	}
+	return refs
+}
+
+// appendContactSortColumnRefs adds a reference to the field of each contact_sorts element
+func appendContactSortColumnRefs(refs []contactListColumnRef, contactSorts []interface{}) []contactListColumnRef {
+	for i, contactSort := range contactSorts {
+		contactSortMap, _ := contactSort.(map[string]interface{})
+		refs = appendContactListColumnRef(refs, fmt.Sprintf("contact_sorts.%d.field_name", i), contactSortMap["field_name"], contactListColumnAny)
+	}
+	return refs
+}
+
+func flattenContactListColumns(contactList *platformclientv2.Contactlist) contactListColumns {
+	columns := make(contactListColumns)
+	if contactList.ColumnNames != nil {
+		columns[contactListColumnAny] = *contactList.ColumnNames
+	}
+	if contactList.PhoneColumns != nil {
+		for _, phoneColumn := range *contactList.PhoneColumns {
+			if phoneColumn.ColumnName != nil {
+				columns[contactListColumnPhone] = append(columns[contactListColumnPhone], *phoneColumn.ColumnName)
+			}
+		}
+	}
+	if contactList.EmailColumns != nil {
+		for _, emailColumn := range *contactList.EmailColumns {
+			if emailColumn.ColumnName != nil {
+				columns[contactListColumnEmail] = append(columns[contactListColumnEmail], *emailColumn.ColumnName)
+			}
+		}
+	}
+	return columns
+}
+
+// validateContactListColumnRefs returns an error naming every reference to a column that is not on the contact list
+func validateContactListColumnRefs(refs []contactListColumnRef, columns contactListColumns, contactListId string) error {
+	contactListName := "the contact list"
+	if contactListId != "" {
+		contactListName = fmt.Sprintf("contact list %s", contactListId)
+	}
+
+	var problems []string
+	for _, ref := range refs {
+		if StringInSlice(ref.column, columns[ref.kind]) {
+			continue
+		}
+		available := "none"
+		if len(columns[ref.kind]) > 0 {
+			available = strings.Join(columns[ref.kind], ", ")
+		}
+		problems = append(problems, fmt.Sprintf("%s: %s '%s' does not exist on %s (available: %s)", ref.path, ref.kind, ref.column, contactListName, available))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("Invalid contact list column references:\n%s", strings.Join(problems, "\n"))
+	}
+	return nil
+}
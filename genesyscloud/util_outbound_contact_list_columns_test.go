@@ -0,0 +1,73 @@
+package genesyscloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateContactListColumnRefs(t *testing.T) {
+	columns := contactListColumns{
+		contactListColumnAny:   {"Cell", "Email", "Zipcode"},
+		contactListColumnPhone: {"Cell"},
+		contactListColumnEmail: {"Email"},
+	}
+
+	testCases := []struct {
+		name     string
+		refs     []contactListColumnRef
+		expected string
+	}{
+		{"no refs", nil, ""},
+		{"valid", []contactListColumnRef{
+			{path: "contact_sorts.0.field_name", column: "Zipcode", kind: contactListColumnAny},
+			{path: "phone_columns.0.column_name", column: "Cell", kind: contactListColumnPhone},
+			{path: "email_config.email_columns.0", column: "Email", kind: contactListColumnEmail},
+		}, ""},
+		{"unknown column", []contactListColumnRef{
+			{path: "clauses.0.predicates.0.column", column: "Zip", kind: contactListColumnAny},
+		}, "Invalid contact list column references:\nclauses.0.predicates.0.column: column 'Zip' does not exist on contact list list-id (available: Cell, Email, Zipcode)"},
+		{"wrong kind", []contactListColumnRef{
+			{path: "phone_columns.0.column_name", column: "Email", kind: contactListColumnPhone},
+			{path: "email_config.email_columns.0", column: "Cell", kind: contactListColumnEmail},
+		}, "Invalid contact list column references:\n" +
+			"phone_columns.0.column_name: phone column 'Email' does not exist on contact list list-id (available: Cell)\n" +
+			"email_config.email_columns.0: email column 'Cell' does not exist on contact list list-id (available: Email)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateContactListColumnRefs(tc.refs, columns, "list-id")
+			if tc.expected == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValidateContactListColumnRefsNoColumns(t *testing.T) {
+	err := validateContactListColumnRefs([]contactListColumnRef{
+		{path: "sms_config.phone_column", column: "Cell", kind: contactListColumnPhone},
+	}, contactListColumns{}, "")
+	assert.EqualError(t, err, "Invalid contact list column references:\nsms_config.phone_column: phone column 'Cell' does not exist on the contact list (available: none)")
+}
+
+func TestBuildPlannedContactListColumns(t *testing.T) {
+	columns := buildPlannedContactListColumns(
+		[]interface{}{"Cell", "Email", "Zipcode"},
+		[]interface{}{map[string]interface{}{"column_name": "Cell", "type": "cell"}},
+		[]interface{}{map[string]interface{}{"column_name": "Email", "type": "work"}},
+	)
+	assert.Equal(t, contactListColumns{
+		contactListColumnAny:   {"Cell", "Email", "Zipcode"},
+		contactListColumnPhone: {"Cell"},
+		contactListColumnEmail: {"Email"},
+	}, columns)
+
+	// A column added to the contact list in the same apply is valid
+	assert.NoError(t, validateContactListColumnRefs([]contactListColumnRef{
+		{path: "clauses.0.predicates.0.column", column: "Zipcode", kind: contactListColumnAny},
+	}, columns, "list-id"))
+}
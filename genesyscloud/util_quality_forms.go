@@ -0,0 +1,124 @@
+package genesyscloud
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v99/platformclientv2"
+)
+
+var qualityFormVersionResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the published version.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the form in this version.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified_date": {
+			Description: "The date this version was last modified in ISO-8601 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// qualityFormVersion holds the version details shared by evaluation and survey forms
+type qualityFormVersion struct {
+	Id           string
+	Name         string
+	Published    bool
+	ModifiedDate *time.Time
+}
+
+func getEvaluationFormVersions(qualityAPI *platformclientv2.QualityApi, formId string) ([]qualityFormVersion, *platformclientv2.APIResponse, error) {
+	var versions []qualityFormVersion
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		formVersions, resp, err := qualityAPI.GetQualityFormsEvaluationVersions(formId, pageSize, pageNum, "desc")
+		if err != nil {
+			return nil, resp, err
+		}
+		if formVersions.Entities == nil || len(*formVersions.Entities) == 0 {
+			return versions, resp, nil
+		}
+		for _, form := range *formVersions.Entities {
+			versions = append(versions, qualityFormVersion{
+				Id:           *form.Id,
+				Name:         valueOrEmpty(form.Name),
+				Published:    form.Published != nil && *form.Published,
+				ModifiedDate: form.ModifiedDate,
+			})
+		}
+	}
+}
+
+func getSurveyFormVersions(qualityAPI *platformclientv2.QualityApi, formId string) ([]qualityFormVersion, *platformclientv2.APIResponse, error) {
+	var versions []qualityFormVersion
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		formVersions, resp, err := qualityAPI.GetQualityFormsSurveyVersions(formId, pageSize, pageNum)
+		if err != nil {
+			return nil, resp, err
+		}
+		if formVersions.Entities == nil || len(*formVersions.Entities) == 0 {
+			return versions, resp, nil
+		}
+		for _, form := range *formVersions.Entities {
+			versions = append(versions, qualityFormVersion{
+				Id:           *form.Id,
+				Name:         valueOrEmpty(form.Name),
+				Published:    form.Published != nil && *form.Published,
+				ModifiedDate: form.ModifiedDate,
+			})
+		}
+	}
+}
+
+// publishedQualityFormVersions returns the published versions of a form, newest first
+func publishedQualityFormVersions(versions []qualityFormVersion) []qualityFormVersion {
+	published := make([]qualityFormVersion, 0, len(versions))
+	for _, v := range versions {
+		if v.Published {
+			published = append(published, v)
+		}
+	}
+	sort.SliceStable(published, func(i, j int) bool {
+		if published[i].ModifiedDate == nil || published[j].ModifiedDate == nil {
+			return published[j].ModifiedDate == nil && published[i].ModifiedDate != nil
+		}
+		return published[i].ModifiedDate.After(*published[j].ModifiedDate)
+	})
+	return published
+}
+
+func flattenQualityFormVersions(versions []qualityFormVersion) []interface{} {
+	versionList := make([]interface{}, 0, len(versions))
+	for _, v := range versions {
+		versionMap := map[string]interface{}{
+			"id":   v.Id,
+			"name": v.Name,
+		}
+		if v.ModifiedDate != nil {
+			versionMap["modified_date"] = v.ModifiedDate.UTC().Format(time.RFC3339)
+		}
+		versionList = append(versionList, versionMap)
+	}
+	return versionList
+}
+
+// setQualityFormPublishedVersions sets the published version attributes shared by the evaluation and survey form resources
+func setQualityFormPublishedVersions(d *schema.ResourceData, versions []qualityFormVersion) {
+	published := publishedQualityFormVersions(versions)
+	if len(published) > 0 {
+		d.Set("published_version_id", published[0].Id)
+	} else {
+		d.Set("published_version_id", nil)
+	}
+	d.Set("published_versions", flattenQualityFormVersions(published))
+}
@@ -0,0 +1,36 @@
+package genesyscloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishedQualityFormVersions(t *testing.T) {
+	date := func(day int) *time.Time {
+		d := time.Date(2023, time.March, day, 12, 0, 0, 0, time.UTC)
+		return &d
+	}
+	versions := []qualityFormVersion{
+		{Id: "draft", Name: "Form", ModifiedDate: date(4)},
+		{Id: "first", Name: "Form", Published: true, ModifiedDate: date(1)},
+		{Id: "third", Name: "Renamed form", Published: true, ModifiedDate: date(3)},
+		{Id: "second", Name: "Form", Published: true, ModifiedDate: date(2)},
+		{Id: "unknown", Name: "Form", Published: true},
+	}
+
+	published := publishedQualityFormVersions(versions)
+	var ids []string
+	for _, v := range published {
+		ids = append(ids, v.Id)
+	}
+	assert.Equal(t, []string{"third", "second", "first", "unknown"}, ids)
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": "third", "name": "Renamed form", "modified_date": "2023-03-03T12:00:00Z"},
+		map[string]interface{}{"id": "unknown", "name": "Form"},
+	}, flattenQualityFormVersions([]qualityFormVersion{published[0], published[3]}))
+
+	assert.Empty(t, publishedQualityFormVersions(versions[:1]))
+}
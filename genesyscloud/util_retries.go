@@ -111,6 +111,16 @@ func isStatus404(resp *platformclientv2.APIResponse, additionalCodes ...int) boo
 	return false
 }
 
+func isStatus429(resp *platformclientv2.APIResponse, additionalCodes ...int) bool {
+	if resp != nil {
+		if resp.StatusCode == http.StatusTooManyRequests ||
+			isAdditionalCode(resp.StatusCode, additionalCodes...) {
+			return true
+		}
+	}
+	return false
+}
+
 func isStatus400(resp *platformclientv2.APIResponse, additionalCodes ...int) bool {
 	if resp != nil {
 		if resp.StatusCode == http.StatusBadRequest ||
@@ -0,0 +1,277 @@
+package genesyscloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Matches the {{key}} and {{key | json}} placeholders of typed substitutions. A placeholder preceded by a backslash is escaped
+// and left in the file without the backslash. Keys of the substitutions attribute are matched exactly as {{key}} instead.
+var substitutionPlaceholderRegex = regexp.MustCompile(`(\\)?\{\{([^{}|]+?)(\|\s*json\s*)?\}\}`)
+
+// substitutionsSchema returns the attributes used to substitute values into flow and script files
+func substitutionsSchema(forceNew bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"substitutions": {
+			Description: "A substitution is a key value pair where the key is the value you want to replace, and the value is the value to substitute in its place. The key is replaced wherever it appears in the file exactly as {{key}}.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    forceNew,
+		},
+		"substitutions_json": {
+			Description: "JSON object of typed substitutions, e.g. jsonencode({ timeout = 30, queues = [\"Sales\", \"Support\"] }). Strings are inserted as is, while numbers, booleans, lists and objects are rendered as JSON, which is also valid YAML. " +
+				"Nested values can be referenced with a dotted path such as {{queue.name}} or {{queues.0}} and placeholders may contain whitespace such as {{ key }}. Use {{key | json}} to always render a value as JSON, including quoting and escaping strings, and \\{{key}} to leave a placeholder unchanged. " +
+				"Keys must not also be set in `substitutions`, whose keys are only matched exactly as {{key}}.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         forceNew,
+			ValidateFunc:     validateSubstitutionsJson,
+			DiffSuppressFunc: suppressEquivalentJsonDiffs,
+		},
+		"substitutions_strict": {
+			Description: "If true, the plan and apply fail when a placeholder in the file has no substitution or a substitution is not used in the file. Placeholders escaped as \\{{key}} are not reported.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    forceNew,
+		},
+		"rendered_content_hash": {
+			Description: "Hash value of the file content after substitutions are applied. Used to detect changes to the rendered file.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func validateSubstitutionsJson(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	var substitutions map[string]interface{}
+	if err := json.Unmarshal([]byte(v), &substitutions); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a JSON object: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// getSubstitutions returns the values of the substitutions attribute and the typed values of the substitutions_json attribute
+func getSubstitutions(d interface{ Get(string) interface{} }) (map[string]interface{}, map[string]interface{}, error) {
+	substitutions := make(map[string]interface{})
+	if stringSubstitutions, ok := d.Get("substitutions").(map[string]interface{}); ok {
+		for k, v := range stringSubstitutions {
+			substitutions[k] = v
+		}
+	}
+
+	substitutionsJson, _ := d.Get("substitutions_json").(string)
+	if substitutionsJson == "" {
+		return substitutions, nil, nil
+	}
+
+	var typedSubstitutions map[string]interface{}
+	if err := json.Unmarshal([]byte(substitutionsJson), &typedSubstitutions); err != nil {
+		return nil, nil, fmt.Errorf("substitutions_json must be a JSON object: %v", err)
+	}
+	for k := range typedSubstitutions {
+		if _, exists := substitutions[k]; exists {
+			return nil, nil, fmt.Errorf("substitution %s is set in both substitutions and substitutions_json", k)
+		}
+	}
+	return substitutions, typedSubstitutions, nil
+}
+
+// renderSubstitutions replaces the placeholders in content with their substitution values. The string substitutions are
+// replaced wherever {{key}} appears exactly. The typed substitutions are then replaced in the placeholders matched by
+// substitutionPlaceholderRegex, which also unescapes \{{key}} placeholders. This second pass only runs when there are
+// typed substitutions or in strict mode, so files using only string substitutions render as they always have.
+// In strict mode an error is returned if a placeholder has no substitution or a substitution is unused.
+// Otherwise placeholders without a substitution are left unchanged.
+func renderSubstitutions(content string, substitutions map[string]interface{}, typedSubstitutions map[string]interface{}, strict bool) (string, error) {
+	var (
+		unresolved []string
+		renderErr  error
+		used       = make(map[string]bool)
+	)
+
+	for k, v := range substitutions {
+		placeholder := fmt.Sprintf("{{%s}}", k)
+		if strings.Contains(content, placeholder) {
+			used[k] = true
+			content = strings.Replace(content, placeholder, v.(string), -1)
+		}
+	}
+	if len(typedSubstitutions) == 0 && !strict {
+		return content, nil
+	}
+
+	rendered := substitutionPlaceholderRegex.ReplaceAllStringFunc(content, func(placeholder string) string {
+		match := substitutionPlaceholderRegex.FindStringSubmatch(placeholder)
+		if match[1] != "" {
+			// Escaped placeholder
+			return strings.TrimPrefix(placeholder, `\`)
+		}
+
+		key := strings.TrimSpace(match[2])
+		value, rootKey, found := lookupSubstitution(typedSubstitutions, key)
+		if !found {
+			unresolved = append(unresolved, key)
+			return placeholder
+		}
+		used[rootKey] = true
+
+		renderedValue, err := renderSubstitutionValue(value, match[3] != "")
+		if err != nil && renderErr == nil {
+			renderErr = fmt.Errorf("failed to render substitution %s: %v", key, err)
+		}
+		return renderedValue
+	})
+	if renderErr != nil {
+		return "", renderErr
+	}
+
+	if strict {
+		var unused []string
+		for _, keys := range []map[string]interface{}{substitutions, typedSubstitutions} {
+			for k := range keys {
+				if !used[k] {
+					unused = append(unused, k)
+				}
+			}
+		}
+		sort.Strings(unused)
+
+		var errs []string
+		if len(unresolved) > 0 {
+			errs = append(errs, fmt.Sprintf("placeholders without a substitution: %s", strings.Join(unresolved, ", ")))
+		}
+		if len(unused) > 0 {
+			errs = append(errs, fmt.Sprintf("substitutions not used in the file: %s", strings.Join(unused, ", ")))
+		}
+		if len(errs) > 0 {
+			return "", fmt.Errorf("strict substitution failed. %s", strings.Join(errs, "; "))
+		}
+	}
+
+	return rendered, nil
+}
+
+// lookupSubstitution finds the value for a placeholder key. Keys are first matched exactly, then as a dotted path
+// into nested objects and lists. Returns the value and the top-level substitution key it was found under.
+func lookupSubstitution(substitutions map[string]interface{}, key string) (interface{}, string, bool) {
+	if value, ok := substitutions[key]; ok {
+		return value, key, true
+	}
+
+	path := strings.Split(key, ".")
+	value, ok := substitutions[path[0]]
+	if !ok {
+		return nil, "", false
+	}
+	for _, segment := range path[1:] {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if value, ok = v[segment]; !ok {
+				return nil, "", false
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, "", false
+			}
+			value = v[index]
+		default:
+			return nil, "", false
+		}
+	}
+	return value, path[0], true
+}
+
+func renderSubstitutionValue(value interface{}, asJson bool) (string, error) {
+	if s, ok := value.(string); ok && !asJson {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// hashRenderedFileContent hashes the content of the file after substitutions are applied
+func hashRenderedFileContent(filepath string, substitutions map[string]interface{}, typedSubstitutions map[string]interface{}, strict bool) (string, error) {
+	content, err := readFileContent(filepath)
+	if err != nil {
+		return "", err
+	}
+	return hashRenderedContent(content, substitutions, typedSubstitutions, strict)
+}
+
+func hashRenderedContent(content string, substitutions map[string]interface{}, typedSubstitutions map[string]interface{}, strict bool) (string, error) {
+	rendered, err := renderSubstitutions(content, substitutions, typedSubstitutions, strict)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256([]byte(rendered))
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func readFileContent(filepath string) (string, error) {
+	reader, file, err := downloadOrOpenFile(filepath)
+	if err != nil {
+		return "", err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// customizeRenderedContentHashDiff renders the file at plan time so changes to the rendered output are detected
+// and strict substitution errors are reported before apply
+func customizeRenderedContentHashDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.Get("rendered_content_hash").(string) == "" {
+		// Resource created or imported before the rendered hash was tracked. It is recorded on the next update.
+		return nil
+	}
+
+	if !diff.NewValueKnown("filepath") || !diff.NewValueKnown("substitutions") || !diff.NewValueKnown("substitutions_json") {
+		// Values not yet in final state. Render during apply.
+		return diff.SetNewComputed("rendered_content_hash")
+	}
+
+	content, err := readFileContent(diff.Get("filepath").(string))
+	if err != nil {
+		// The file may be created during apply
+		return diff.SetNewComputed("rendered_content_hash")
+	}
+
+	substitutions, typedSubstitutions, err := getSubstitutions(diff)
+	if err != nil {
+		return err
+	}
+	renderedHash, err := hashRenderedContent(content, substitutions, typedSubstitutions, diff.Get("substitutions_strict").(bool))
+	if err != nil {
+		return err
+	}
+
+	if diff.Get("rendered_content_hash").(string) != renderedHash {
+		return diff.SetNew("rendered_content_hash", renderedHash)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package genesyscloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderSubstitutions(t *testing.T) {
+	substitutions := map[string]interface{}{
+		"name":    "SimpleFinancialIvr",
+		"timeout": float64(30),
+		"enabled": true,
+		"queues":  []interface{}{"Sales", "Support"},
+		"queue":   map[string]interface{}{"name": "Sales", "priority": float64(2)},
+		"quoted":  `say "hi"`,
+	}
+
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"string", "name: {{name}}", "name: SimpleFinancialIvr"},
+		{"whitespace", "name: {{ name }}", "name: SimpleFinancialIvr"},
+		{"number", "timeout: {{timeout}}", "timeout: 30"},
+		{"bool", "enabled: {{enabled}}", "enabled: true"},
+		{"list", "queues: {{queues}}", `queues: ["Sales","Support"]`},
+		{"object", "queue: {{queue}}", `queue: {"name":"Sales","priority":2}`},
+		{"nested object", "queue: {{queue.name}}", "queue: Sales"},
+		{"list index", "queue: {{queues.1}}", "queue: Support"},
+		{"json string", "text: {{quoted | json}}", `text: "say \"hi\""`},
+		{"escaped", `name: \{{name}}`, "name: {{name}}"},
+		{"unresolved", "name: {{missing}}", "name: {{missing}}"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rendered, err := renderSubstitutions(tc.content, nil, substitutions, false)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, rendered)
+		})
+	}
+}
+
+func TestRenderSubstitutionsStrict(t *testing.T) {
+	substitutions := map[string]interface{}{
+		"name":  "SimpleFinancialIvr",
+		"queue": map[string]interface{}{"name": "Sales"},
+	}
+
+	rendered, err := renderSubstitutions("name: {{name}}\nqueue: {{queue.name}}", nil, substitutions, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "name: SimpleFinancialIvr\nqueue: Sales", rendered)
+
+	_, err = renderSubstitutions("name: {{name}}\ndescription: {{description}}", nil, substitutions, true)
+	assert.ErrorContains(t, err, "placeholders without a substitution: description")
+	assert.ErrorContains(t, err, "substitutions not used in the file: queue")
+
+	// Escaped placeholders do not need a substitution
+	_, err = renderSubstitutions(`name: {{name}} \{{literal}} {{queue.name}}`, nil, substitutions, true)
+	assert.NoError(t, err)
+
+	// String substitutions are only matched exactly
+	_, err = renderSubstitutions("greeting: {{greeting}}\nname: {{ name }}", map[string]interface{}{"greeting": "Hello", "name": "SimpleFinancialIvr"}, nil, true)
+	assert.ErrorContains(t, err, "placeholders without a substitution: name")
+	assert.ErrorContains(t, err, "substitutions not used in the file: name")
+}
+
+func TestRenderStringSubstitutions(t *testing.T) {
+	substitutions := map[string]interface{}{
+		"name":        "SimpleFinancialIvr",
+		"my greeting": "Hello",
+		"queue.name":  "Sales",
+	}
+
+	// String substitutions replace {{key}} exactly as written, without whitespace trimming, escapes or dotted paths
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"string", "name: {{name}}", "name: SimpleFinancialIvr"},
+		{"key with spaces", "greeting: {{my greeting}}", "greeting: Hello"},
+		{"dotted key", "queue: {{queue.name}}", "queue: Sales"},
+		{"whitespace not matched", "name: {{ name }}", "name: {{ name }}"},
+		{"backslash not an escape", `name: \{{name}}`, `name: \SimpleFinancialIvr`},
+		{"unresolved", `name: {{missing}} \{{missing}}`, `name: {{missing}} \{{missing}}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rendered, err := renderSubstitutions(tc.content, substitutions, nil, false)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, rendered)
+		})
+	}
+
+	// String and typed substitutions together
+	rendered, err := renderSubstitutions(`name: {{name}} {{ timeout }} \{{timeout}}`, substitutions, map[string]interface{}{"timeout": float64(30)}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "name: SimpleFinancialIvr 30 {{timeout}}", rendered)
+}
+
+func TestGetSubstitutions(t *testing.T) {
+	d := resourceFlow().TestResourceData()
+	d.Set("substitutions", map[string]interface{}{"name": "SimpleFinancialIvr"})
+	d.Set("substitutions_json", `{"timeout": 30, "queues": ["Sales"]}`)
+
+	substitutions, typedSubstitutions, err := getSubstitutions(d)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "SimpleFinancialIvr"}, substitutions)
+	assert.Equal(t, map[string]interface{}{
+		"timeout": float64(30),
+		"queues":  []interface{}{"Sales"},
+	}, typedSubstitutions)
+
+	d.Set("substitutions_json", `{"name": "Duplicate"}`)
+	_, _, err = getSubstitutions(d)
+	assert.ErrorContains(t, err, "substitution name is set in both substitutions and substitutions_json")
+}
@@ -186,3 +186,18 @@ func ValidateSubStringInSlice(valid []string) schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+// Validates a string is a PEM or base64 DER encoded X.509 certificate that has not expired
+func validateCertificate(certificate interface{}, _ cty.Path) diag.Diagnostics {
+	if certificateStr, ok := certificate.(string); ok {
+		cert, err := parseCertificate(certificateStr)
+		if err != nil {
+			return diag.Errorf("Failed to parse certificate: %s", err)
+		}
+		if time.Now().After(cert.NotAfter) {
+			return diag.Errorf("Certificate %s expired on %s", cert.Subject, cert.NotAfter.UTC().Format(time.RFC3339))
+		}
+		return nil
+	}
+	return diag.Errorf("Certificate %v is not a string", certificate)
+}
@@ -13,7 +13,9 @@ require (
 	github.com/mypurecloud/platform-client-sdk-go/v99 v99.0.0
 	github.com/nyaruka/phonenumbers v1.1.7
 	github.com/zclconf/go-cty v1.13.2
+	golang.org/x/net v0.9.0
 	gonum.org/v1/gonum v0.13.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -21,7 +23,6 @@ require (
 	github.com/cloudflare/circl v1.3.3 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 require (
@@ -85,7 +86,6 @@ require (
 	github.com/vmihailenco/tagparser v0.1.1 // indirect
 	golang.org/x/crypto v0.8.0 // indirect
 	golang.org/x/mod v0.10.0 // indirect
-	golang.org/x/net v0.9.0 // indirect
 	golang.org/x/sys v0.7.0 // indirect
 	golang.org/x/text v0.9.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect